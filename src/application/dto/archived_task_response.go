@@ -0,0 +1,18 @@
+package dto
+
+import "clean-architecture-golang/domain/entities"
+
+// ArchivedTaskResponse represents an archived task together with its status history.
+type ArchivedTaskResponse struct {
+	TaskResponse
+	History []StatusChangeResponse
+}
+
+// ToArchivedTaskResponse converts an archived domain Task entity to an ArchivedTaskResponse DTO.
+func ToArchivedTaskResponse(t *entities.Task, response TaskResponse) ArchivedTaskResponse {
+	history := make([]StatusChangeResponse, 0, len(t.History))
+	for _, change := range t.History {
+		history = append(history, ToStatusChangeResponse(change))
+	}
+	return ArchivedTaskResponse{TaskResponse: response, History: history}
+}
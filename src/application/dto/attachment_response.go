@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"time"
+)
+
+// AttachmentResponse represents the output data for attachment operations.
+type AttachmentResponse struct {
+	ID          string
+	Name        string
+	ContentType string
+	Size        int64
+	Digest      string
+	UploadedAt  string
+}
+
+// ToAttachmentResponse converts a domain Attachment to an AttachmentResponse DTO.
+func ToAttachmentResponse(a entities.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:          string(a.ID),
+		Name:        a.Name,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Digest:      a.Digest,
+		UploadedAt:  a.UploadedAt.Format(time.RFC3339),
+	}
+}
+
+func attachmentResponses(attachments []entities.Attachment) []AttachmentResponse {
+	result := make([]AttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		result[i] = ToAttachmentResponse(attachment)
+	}
+	return result
+}
@@ -0,0 +1,27 @@
+package dto
+
+// BulkCreateRequest represents the input data for creating several tasks at once.
+type BulkCreateRequest struct {
+	Mode  string
+	Tasks []CreateTaskRequest
+}
+
+// BulkUpdateStatusRequest represents the input data for changing the status of several tasks at once.
+type BulkUpdateStatusRequest struct {
+	Mode    string
+	Updates []UpdateStatusRequest
+}
+
+// BulkDeleteRequest represents the input data for deleting several tasks at once.
+type BulkDeleteRequest struct {
+	Mode    string
+	TaskIDs []string
+}
+
+// BulkItemResult is the outcome of the item at Index in a bulk request.
+// TaskID is the ID of the affected task, if known, and Err is nil when the item succeeded.
+type BulkItemResult struct {
+	Index  int
+	TaskID string
+	Err    error
+}
@@ -0,0 +1,28 @@
+package dto
+
+import "clean-architecture-golang/domain/entities"
+
+// ChecklistItemResponse represents the output data for checklist operations.
+type ChecklistItemResponse struct {
+	ID      string
+	Text    string
+	Checked bool
+}
+
+// ToChecklistItemResponse converts a domain ChecklistItem to a ChecklistItemResponse DTO.
+func ToChecklistItemResponse(item entities.ChecklistItem) ChecklistItemResponse {
+	return ChecklistItemResponse{
+		ID:      string(item.ID),
+		Text:    item.Text,
+		Checked: item.Checked,
+	}
+}
+
+// ToChecklistResponse converts the checklist of a task to ChecklistItemResponse DTOs, in order.
+func ToChecklistResponse(items []entities.ChecklistItem) []ChecklistItemResponse {
+	result := make([]ChecklistItemResponse, len(items))
+	for i, item := range items {
+		result[i] = ToChecklistItemResponse(item)
+	}
+	return result
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"time"
+)
+
+// CommentResponse represents the output data for comment operations.
+type CommentResponse struct {
+	ID        string
+	TaskID    string
+	Author    string
+	Body      string
+	CreatedAt string
+	UpdatedAt string
+}
+
+// ToCommentResponse converts a domain Comment entity to a CommentResponse DTO.
+func ToCommentResponse(c *entities.Comment) CommentResponse {
+	response := CommentResponse{
+		ID:        string(c.ID),
+		TaskID:    string(c.TaskID),
+		Author:    c.Author,
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt.Format(time.RFC3339),
+	}
+	if c.UpdatedAt != nil {
+		response.UpdatedAt = c.UpdatedAt.Format(time.RFC3339)
+	}
+	return response
+}
@@ -6,4 +6,15 @@ package dto
 type CreateTaskRequest struct {
 	Title       string
 	Description string
+	// ProjectID optionally creates the task in a project; subtasks default to the project of their parent.
+	ProjectID string
+	// ParentID optionally makes the new task a subtask of an existing task.
+	ParentID string
+	// DueDate is an optional RFC 3339 timestamp, expressed in Timezone (an IANA name) when given.
+	DueDate  string
+	Timezone string
+	// Recurrence is an optional RFC 5545 RRULE; it requires a due date.
+	Recurrence string
+	// Priority is optional and defaults to value_objects.DefaultPriority.
+	Priority string
 }
@@ -0,0 +1,54 @@
+package dto
+
+// FlowAnalyticsRequest represents the date range of a flow analytics report.
+type FlowAnalyticsRequest struct {
+	// From and To are inclusive dates (YYYY-MM-DD) in Timezone, an IANA name defaulting to UTC.
+	// To defaults to today and From to four weeks before To.
+	From     string
+	To       string
+	Timezone string
+}
+
+// DurationStats summarizes a set of durations.
+type DurationStats struct {
+	Count          int
+	AverageSeconds int64
+	MedianSeconds  int64
+	// P85Seconds is the 85th percentile, a common service level expectation in flow metrics.
+	P85Seconds int64
+}
+
+// TaskFlowResponse is the flow data of one task completed in the range.
+type TaskFlowResponse struct {
+	TaskID      string
+	Title       string
+	CreatedAt   string
+	StartedAt   string
+	CompletedAt string
+	// CycleTimeSeconds is zero when the task was never in doing.
+	LeadTimeSeconds  int64
+	CycleTimeSeconds int64
+}
+
+// WeeklyThroughput is the number of tasks completed in the week starting on WeekStart (a Monday).
+type WeeklyThroughput struct {
+	WeekStart string
+	Completed int
+}
+
+// CumulativeFlowPoint counts the tasks in each status at the end of Date.
+type CumulativeFlowPoint struct {
+	Date   string
+	Counts map[string]int
+}
+
+// FlowAnalyticsResponse represents lead time, cycle time, throughput and cumulative flow over a range.
+type FlowAnalyticsResponse struct {
+	From           string
+	To             string
+	LeadTime       DurationStats
+	CycleTime      DurationStats
+	Completed      []TaskFlowResponse
+	Throughput     []WeeklyThroughput
+	CumulativeFlow []CumulativeFlowPoint
+}
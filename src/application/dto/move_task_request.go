@@ -0,0 +1,15 @@
+package dto
+
+// MoveTaskRequest represents the input data for moving a task on the board.
+type MoveTaskRequest struct {
+	TaskID string
+	// Status is the target column; empty keeps the current status.
+	Status string
+	// AfterID and BeforeID name the tasks the moved task goes between in the target column.
+	// Either may be empty; with both empty the task goes to the bottom of the column.
+	AfterID  string
+	BeforeID string
+	// Actor is the user making the change; StartTimer starts a timer for Actor when the task moves to doing.
+	Actor      string
+	StartTimer bool
+}
@@ -0,0 +1,8 @@
+package dto
+
+// NextTaskResponse represents an open task in the "what can I work on next" list.
+// Ready is true when none of the task's blockers is still open.
+type NextTaskResponse struct {
+	Task  TaskResponse
+	Ready bool
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"time"
+)
+
+// ProjectRequest represents the input data for creating or updating a project.
+type ProjectRequest struct {
+	// ID identifies the project to update; it is ignored on creation.
+	ID          string
+	Name        string
+	Description string
+	DefaultTags []string
+	StartTimers bool
+}
+
+// ProjectSettingsResponse represents the default workflow settings of a project.
+type ProjectSettingsResponse struct {
+	DefaultTags []string
+	StartTimers bool
+}
+
+// ProjectResponse represents the output data for project operations.
+type ProjectResponse struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedAt   string
+	Settings    ProjectSettingsResponse
+	TaskCount   int
+}
+
+// ToProjectResponse converts a domain Project entity to a ProjectResponse DTO.
+func ToProjectResponse(p *entities.Project, taskCount int) ProjectResponse {
+	return ProjectResponse{
+		ID:          string(p.ID),
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt.Format(time.RFC3339),
+		Settings: ProjectSettingsResponse{
+			DefaultTags: tagStrings(p.Settings.DefaultTags),
+			StartTimers: p.Settings.StartTimers,
+		},
+		TaskCount: taskCount,
+	}
+}
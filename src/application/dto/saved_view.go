@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"time"
+)
+
+// SavedViewRequest represents the input data for saving a view.
+type SavedViewRequest struct {
+	Owner  string
+	Name   string
+	Filter string
+	Sort   string
+}
+
+// SavedViewResponse represents the output data for saved view operations.
+// Filter and Sort are in their canonical syntax.
+type SavedViewResponse struct {
+	Name      string
+	Filter    string
+	Sort      string
+	CreatedAt string
+	UpdatedAt string
+}
+
+// ToSavedViewResponse converts a domain SavedView entity to a SavedViewResponse DTO.
+func ToSavedViewResponse(v *entities.SavedView) SavedViewResponse {
+	return SavedViewResponse{
+		Name:      v.Name,
+		Filter:    v.Filter.String(),
+		Sort:      v.Sort.String(),
+		CreatedAt: v.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: v.UpdatedAt.Format(time.RFC3339),
+	}
+}
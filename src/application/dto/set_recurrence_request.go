@@ -0,0 +1,11 @@
+package dto
+
+// SetRecurrenceRequest represents the input data for changing the schedule of a task.
+type SetRecurrenceRequest struct {
+	// DueDate is an RFC 3339 timestamp, expressed in Timezone (an IANA name) when given.
+	// An empty DueDate removes the due date.
+	DueDate  string
+	Timezone string
+	// Recurrence is an RFC 5545 RRULE; an empty value stops the task from recurring.
+	Recurrence string
+}
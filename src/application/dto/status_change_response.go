@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"time"
+)
+
+// StatusChangeResponse represents one entry of a task's status history.
+type StatusChangeResponse struct {
+	From  string
+	To    string
+	At    string
+	Actor string
+}
+
+// ToStatusChangeResponse converts a domain StatusChange to a StatusChangeResponse DTO.
+func ToStatusChangeResponse(c entities.StatusChange) StatusChangeResponse {
+	return StatusChangeResponse{
+		From:  c.From.String(),
+		To:    c.To.String(),
+		At:    c.At.Format(time.RFC3339),
+		Actor: c.Actor,
+	}
+}
@@ -0,0 +1,7 @@
+package dto
+
+// StatusCountResponse represents how many tasks are in a given status column.
+type StatusCountResponse struct {
+	Status string
+	Count  int
+}
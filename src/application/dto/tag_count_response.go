@@ -0,0 +1,7 @@
+package dto
+
+// TagCountResponse represents how many tasks carry a given tag.
+type TagCountResponse struct {
+	Tag   string
+	Count int
+}
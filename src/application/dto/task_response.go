@@ -2,6 +2,7 @@ package dto
 
 import (
 	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
 	"time"
 )
 
@@ -11,17 +12,83 @@ type TaskResponse struct {
 	ID          string
 	Title       string
 	Status      string
+	Priority    string
 	Description string
 	CreatedAt   string
+	Tags        []string
+	ProjectID   string
+	ParentID    string
+	BlockedBy   []string
+	DueDate     string
+	Recurrence  string
+	Attachments []AttachmentResponse
+	Checklist   []ChecklistItemResponse
+	// StartedAt and CompletedAt are derived from the status history; see entities.Task.StartedAt.
+	StartedAt   string
+	CompletedAt string
+	// Rank orders the task within its status column.
+	Rank string
+	// DeletedAt is set for tasks in the trash.
+	DeletedAt string
+	// ArchivedAt is set for archived tasks.
+	ArchivedAt string
+	// CompletionPercent is rolled up from subtasks; see entities.Task.CompletionPercent.
+	CompletionPercent int
+	CommentCount      int
 }
 
 // ToTaskResponse converts a domain Task entity to a TaskResponse DTO.
 func ToTaskResponse(t *entities.Task) TaskResponse {
-	return TaskResponse{
+	response := TaskResponse{
 		ID:          string(t.ID),
 		Title:       t.Title,
 		Status:      t.Status.String(),
+		Priority:    t.Priority.String(),
 		Description: t.Description,
 		CreatedAt:   t.CreatedAt.Format(time.RFC3339),
+		Tags:        tagStrings(t.Tags),
+		ProjectID:   string(t.ProjectID),
+		ParentID:    string(t.ParentID),
+		BlockedBy:   idStrings(t.BlockedBy),
+		Attachments: attachmentResponses(t.Attachments),
+		Checklist:   ToChecklistResponse(t.Checklist),
+		Rank:        t.Rank.String(),
+		// Without access to subtasks only the task's own status is known.
+		CompletionPercent: t.CompletionPercent(nil),
 	}
+	if t.DueDate != nil {
+		response.DueDate = t.DueDate.Format(time.RFC3339)
+	}
+	if t.Recurrence != nil {
+		response.Recurrence = t.Recurrence.String()
+	}
+	if startedAt := t.StartedAt(); startedAt != nil {
+		response.StartedAt = startedAt.Format(time.RFC3339)
+	}
+	if completedAt := t.CompletedAt(); completedAt != nil {
+		response.CompletedAt = completedAt.Format(time.RFC3339)
+	}
+	if t.DeletedAt != nil {
+		response.DeletedAt = t.DeletedAt.Format(time.RFC3339)
+	}
+	if t.ArchivedAt != nil {
+		response.ArchivedAt = t.ArchivedAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func tagStrings(tags []value_objects.Tag) []string {
+	result := make([]string, len(tags))
+	for i, tag := range tags {
+		result[i] = tag.String()
+	}
+	return result
+}
+
+func idStrings(ids []value_objects.TaskId) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = string(id)
+	}
+	return result
 }
@@ -0,0 +1,10 @@
+package dto
+
+// TaskSearchResponse represents a task matching a full-text query.
+// HighlightedTitle and Snippet are HTML-escaped with the matched words wrapped in <mark> tags.
+type TaskSearchResponse struct {
+	TaskResponse
+	Score            float64
+	HighlightedTitle string
+	Snippet          string
+}
@@ -0,0 +1,85 @@
+package dto
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"time"
+)
+
+// LogWorkRequest represents the input data for logging work done without a timer.
+type LogWorkRequest struct {
+	TaskID string
+	User   string
+	// Start and End are RFC 3339 timestamps.
+	Start string
+	End   string
+	Note  string
+}
+
+// UpdateStatusRequest represents the input data for changing the status of a task.
+type UpdateStatusRequest struct {
+	TaskID    string
+	NewStatus string
+	// Actor is the user making the change.
+	Actor string
+	// StartTimer starts a timer for Actor when the task moves to doing.
+	StartTimer bool
+}
+
+// TimeReportRequest represents the filters of a time report.
+type TimeReportRequest struct {
+	// User restricts the report to one user; empty reports everybody's time.
+	User string
+	// From and To are optional inclusive dates (YYYY-MM-DD) in Timezone, an IANA name defaulting to UTC.
+	From     string
+	To       string
+	Timezone string
+}
+
+// WorkEntryResponse represents the output data for time tracking operations.
+type WorkEntryResponse struct {
+	ID     string
+	TaskID string
+	User   string
+	Start  string
+	// End is empty while the timer is running.
+	End             string
+	Note            string
+	DurationSeconds int64
+}
+
+// TaskTimeResponse is the time logged on one task.
+type TaskTimeResponse struct {
+	TaskID  string
+	Title   string
+	Seconds int64
+}
+
+// DayTimeResponse is the time logged on one calendar day.
+type DayTimeResponse struct {
+	Date    string
+	Seconds int64
+}
+
+// TimeReportResponse sums logged time per task and per day.
+type TimeReportResponse struct {
+	TotalSeconds int64
+	ByTask       []TaskTimeResponse
+	ByDay        []DayTimeResponse
+}
+
+// ToWorkEntryResponse converts a domain WorkEntry entity to a WorkEntryResponse DTO.
+// Running timers report their duration up to now.
+func ToWorkEntryResponse(e *entities.WorkEntry, now time.Time) WorkEntryResponse {
+	response := WorkEntryResponse{
+		ID:              string(e.ID),
+		TaskID:          string(e.TaskID),
+		User:            e.User,
+		Start:           e.Start.Format(time.RFC3339),
+		Note:            e.Note,
+		DurationSeconds: int64(e.Duration(now) / time.Second),
+	}
+	if e.End != nil {
+		response.End = e.End.Format(time.RFC3339)
+	}
+	return response
+}
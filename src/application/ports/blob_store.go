@@ -0,0 +1,33 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"io"
+)
+
+// BlobInfo describes content written to a BlobStore.
+type BlobInfo struct {
+	// Digest is the hex-encoded SHA-256 of the content and the key it is stored under.
+	Digest      string
+	Size        int64
+	ContentType string
+}
+
+// BlobStore defines the contract for storing file contents.
+// Stores are content-addressed: writing the same bytes twice yields the same digest
+// and keeps a single copy.
+type BlobStore interface {
+	// Put reads content until EOF and stores it, sniffing its MIME type.
+	Put(content io.Reader) (BlobInfo, error)
+	// Open returns a seekable reader over the content stored under digest.
+	Open(digest string) (io.ReadSeekCloser, error)
+	// Delete removes the content stored under digest; it succeeds if there is none.
+	Delete(digest string) error
+}
+
+// TaskAttachmentIndex defines queries over the blobs referenced by task attachments.
+type TaskAttachmentIndex interface {
+	// IsBlobReferenced reports whether any stored task other than except still has an attachment
+	// with the given digest. An empty except counts every task.
+	IsBlobReferenced(digest string, except value_objects.TaskId) (bool, error)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// CommentRepository defines the contract for comment persistence operations.
+type CommentRepository interface {
+	Save(comment *entities.Comment) error
+	FindById(id value_objects.CommentId) (*entities.Comment, error)
+	// FindByTask returns the comments of a task, oldest first.
+	FindByTask(taskID value_objects.TaskId) ([]*entities.Comment, error)
+	CountByTask(taskID value_objects.TaskId) (int, error)
+	Delete(id value_objects.CommentId) error
+	// DeleteByTask removes every comment of a task; it succeeds if there are none.
+	DeleteByTask(taskID value_objects.TaskId) error
+}
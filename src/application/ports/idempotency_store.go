@@ -0,0 +1,24 @@
+package ports
+
+import "time"
+
+// IdempotentResponse is a response stored for replay under an idempotency key.
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// IdempotencyStore keeps the responses of requests made with an idempotency key for a limited time.
+// Keys are scoped by the caller, for instance by prefixing them with the principal.
+type IdempotencyStore interface {
+	// Reserve claims key for a request whose payload has the given fingerprint. It returns nil when
+	// the key was free, and the stored response when an earlier request with the same fingerprint
+	// completed. Reusing a key with another fingerprint, or while its first request is still running,
+	// fails. Keys expire a fixed time after they were reserved.
+	Reserve(key, fingerprint string, now time.Time) (*IdempotentResponse, error)
+	// Complete stores the response of the request holding key.
+	Complete(key string, response IdempotentResponse) error
+	// Release frees a reserved key whose request did not complete, so it can be retried.
+	Release(key string) error
+}
@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+)
+
+// ErrProjectNotFound is returned by FindById and Delete when a project does not exist.
+var ErrProjectNotFound = errors.New("project not found")
+
+// ProjectRepository defines the contract for project persistence operations.
+type ProjectRepository interface {
+	Save(project *entities.Project) error
+	FindById(id value_objects.ProjectId) (*entities.Project, error)
+	// FindAll returns every project ordered by name.
+	FindAll() ([]*entities.Project, error)
+	Delete(id value_objects.ProjectId) error
+}
+
+// TaskProjectIndex defines queries over the tasks of a project.
+type TaskProjectIndex interface {
+	// FindByProject returns the active tasks of a project ordered by creation time.
+	FindByProject(projectID value_objects.ProjectId) ([]*entities.Task, error)
+	// CountByProject returns the number of active tasks of a project.
+	// Trashed and archived tasks are not counted.
+	CountByProject(projectID value_objects.ProjectId) (int, error)
+}
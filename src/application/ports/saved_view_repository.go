@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"errors"
+)
+
+// ErrViewNotFound is returned by FindByName and Delete when a user has no saved view with the
+// requested name.
+var ErrViewNotFound = errors.New("view not found")
+
+// SavedViewRepository defines persistence operations for the saved views of users.
+// Views are identified by their owner and name.
+type SavedViewRepository interface {
+	Save(view *entities.SavedView) error
+	FindByName(owner, name string) (*entities.SavedView, error)
+	// FindByOwner returns the views of a user ordered by name.
+	FindByOwner(owner string) ([]*entities.SavedView, error)
+	Delete(owner, name string) error
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// TaskArchive defines the storage of archived tasks.
+// Archived tasks are kept in full but are not returned by TaskRepository or the other task queries;
+// their attachments still count as references to their blobs.
+type TaskArchive interface {
+	// MoveToArchive replaces an active task with its archived version (see entities.Task.Archive).
+	MoveToArchive(task *entities.Task) error
+	// FindArchived returns the archived tasks, most recently archived first.
+	FindArchived() ([]*entities.Task, error)
+	// FindArchivedById returns a task from the archive.
+	FindArchivedById(id value_objects.TaskId) (*entities.Task, error)
+}
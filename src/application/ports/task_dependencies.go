@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// TaskDependencies defines queries over the blocked-by relationship between tasks.
+type TaskDependencies interface {
+	// FindDependents returns the tasks that declare the given task as a blocker.
+	FindDependents(blockerID value_objects.TaskId) ([]*entities.Task, error)
+}
@@ -0,0 +1,14 @@
+package ports
+
+import "clean-architecture-golang/domain/entities"
+
+// TaskEventLog is the feed of domain events of the active tasks, in commit order.
+// Sequence numbers start at 1 and grow by one per event; only committed changes appear in the feed.
+type TaskEventLog interface {
+	// EventsSince returns up to limit events following the one numbered after.
+	// Implementations may drop old events and return an error when some of them are gone;
+	// readers then start over from ActiveTasks.
+	EventsSince(after uint64, limit int) ([]entities.TaskEvent, error)
+	// ActiveTasks returns the active tasks and the sequence number of the last event they reflect.
+	ActiveTasks() ([]*entities.Task, uint64, error)
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// TaskHierarchy defines queries over the parent/child relationship between tasks.
+type TaskHierarchy interface {
+	// FindChildren returns the direct subtasks of the given task.
+	FindChildren(parentID value_objects.TaskId) ([]*entities.Task, error)
+	// FindDescendants returns the subtasks of the given task at every depth, each after its parent.
+	FindDescendants(rootID value_objects.TaskId) ([]*entities.Task, error)
+}
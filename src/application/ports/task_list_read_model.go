@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// TaskListReadModel is the query side of the status columns, a projection of the TaskEventLog.
+// It is eventually consistent: a change shows up once the projection has caught up with the event
+// that recorded it, so a read right after a write may not reflect it yet.
+type TaskListReadModel interface {
+	// ListByStatus returns the tasks of a status column ordered by rank, like TaskRepository.FindByStatus.
+	ListByStatus(status value_objects.TaskStatus) ([]*entities.Task, error)
+	// CountByStatus returns the number of tasks in every status column.
+	CountByStatus() (map[value_objects.TaskStatus]int, error)
+	// Rebuild discards the projection and builds it again from the current tasks.
+	Rebuild() error
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// TaskQuery executes parsed filter expressions. The filter is a plain expression tree, so
+// backends can narrow it with their own indexes before checking candidates with
+// entities.Task.Matches, or translate it into their query language (see persistence.TaskFilterSQL).
+type TaskQuery interface {
+	// FindMatching returns the active tasks satisfying the filter, ordered by creation time.
+	FindMatching(filter value_objects.TaskFilter) ([]*entities.Task, error)
+}
@@ -5,13 +5,18 @@ package ports
 import (
 	"clean-architecture-golang/domain/entities"
 	"clean-architecture-golang/domain/value_objects"
+	"errors"
 )
 
+// ErrTaskNotFound is returned by FindById and Delete when no active task has the requested ID.
+var ErrTaskNotFound = errors.New("task not found")
+
 // TaskRepository defines the contract for task persistence operations.
 // Implementations of this interface are provided by the infrastructure layer.
 type TaskRepository interface {
 	Save(task *entities.Task) error
 	FindById(id value_objects.TaskId) (*entities.Task, error)
+	// FindByStatus returns the tasks of a status column ordered by rank.
 	FindByStatus(status value_objects.TaskStatus) ([]*entities.Task, error)
 	Delete(id value_objects.TaskId) error
 }
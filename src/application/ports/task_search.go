@@ -0,0 +1,19 @@
+package ports
+
+import "clean-architecture-golang/domain/value_objects"
+
+// TaskSearchHit is an active task matching a full-text query.
+type TaskSearchHit struct {
+	TaskID value_objects.TaskId
+	Score  float64
+	// Title is the HTML-escaped title with the matched words wrapped in <mark> tags;
+	// Snippet is an excerpt of the description highlighted the same way.
+	Title   string
+	Snippet string
+}
+
+// TaskSearch defines full-text search over task titles and descriptions.
+type TaskSearch interface {
+	// Search returns at most limit tasks matching every word of the query, best match first.
+	Search(query string, limit int) ([]TaskSearchHit, error)
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// TaskTagIndex defines tag-based queries over tasks.
+// Implementations are expected to answer them from an index instead of scanning every task.
+type TaskTagIndex interface {
+	// FindByTags returns tasks carrying all (matchAll) or any of the given tags.
+	FindByTags(tags []value_objects.Tag, matchAll bool) ([]*entities.Task, error)
+	// CountTags returns how many tasks carry each tag in use.
+	CountTags() (map[value_objects.Tag]int, error)
+}
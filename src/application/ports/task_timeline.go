@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"time"
+)
+
+// TaskTimeline defines temporal storage of the active tasks: past versions are kept so tasks can be
+// read as they were at an earlier time. A zero time reads the current state.
+type TaskTimeline interface {
+	// FindByIdAsOf returns the task as it was at the given time; it is not found if it did not exist
+	// then, or was in the trash or the archive.
+	FindByIdAsOf(id value_objects.TaskId, at time.Time) (*entities.Task, error)
+	// FindByStatusAsOf returns the tasks that had the status at the given time, ordered by rank.
+	FindByStatusAsOf(status value_objects.TaskStatus, at time.Time) ([]*entities.Task, error)
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// TaskTrash defines the storage of deleted tasks until they are purged.
+// Trashed tasks are not returned by TaskRepository or the other task queries,
+// but their attachments still count as references to their blobs.
+type TaskTrash interface {
+	// MoveToTrash replaces an active task with its trashed version (see entities.Task.MoveToTrash).
+	MoveToTrash(task *entities.Task) error
+	// FindTrashed returns the trashed tasks, most recently deleted first.
+	FindTrashed() ([]*entities.Task, error)
+	// FindTrashedById returns a task from the trash.
+	FindTrashedById(id value_objects.TaskId) (*entities.Task, error)
+	// Restore takes a task out of the trash and saves it as an active task.
+	Restore(task *entities.Task) error
+	// Purge permanently deletes a task from the trash.
+	Purge(id value_objects.TaskId) error
+}
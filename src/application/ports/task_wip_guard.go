@@ -0,0 +1,11 @@
+package ports
+
+import "clean-architecture-golang/domain/entities"
+
+// TaskWIPGuard is implemented by repositories that can enforce work-in-progress limits.
+type TaskWIPGuard interface {
+	// SaveWithinLimits checks the first task against limits and the current tasks of its status
+	// column, then runs beforeSave and saves the tasks, all in one atomic step so concurrent moves
+	// cannot overfill a column. Nothing is saved if the check or beforeSave fails.
+	SaveWithinLimits(limits entities.WIPLimits, beforeSave func() error, tasks ...*entities.Task) error
+}
@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"time"
+)
+
+// UndoableUnitOfWork is a unit of work that can record what it commits so the change can be undone.
+type UndoableUnitOfWork interface {
+	UnitOfWork
+	// DoUndoable runs fn like Do. When fn commits changes, it returns a token with which Undo can
+	// revert them until the token expires; otherwise the token is empty.
+	DoUndoable(fn func(stores Stores) error, now time.Time) (string, error)
+	// Undo restores the tasks and work entries changed under token exactly as they were before, and
+	// returns a token for redoing the change. It changes nothing if the token is unknown or expired,
+	// or if any of those records changed since. Once restored, the tasks are given to check, when not
+	// nil, with stores bound to the unit of work; the undo is rolled back if check fails. A token can
+	// be used once.
+	Undo(token string, now time.Time, check func(stores Stores, reverted []RevertedTask) error) (string, error)
+}
+
+// RevertedTask is a task restored by Undo. Current is the active task the undone change left and
+// Restored the active task put back; either is nil when the task was not active at that point.
+type RevertedTask struct {
+	Current  *entities.Task
+	Restored *entities.Task
+}
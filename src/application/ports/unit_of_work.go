@@ -0,0 +1,30 @@
+package ports
+
+// TaskStore groups the task ports a unit of work offers to the use cases running inside it.
+type TaskStore interface {
+	TaskRepository
+	TaskHierarchy
+	TaskDependencies
+	TaskProjectIndex
+	TaskAttachmentIndex
+	TaskWIPGuard
+	TaskTrash
+	TaskArchive
+}
+
+// Stores are the repositories bound to a unit of work. WorkLog is nil when the unit of work does
+// not cover tracked work.
+type Stores struct {
+	Tasks   TaskStore
+	WorkLog WorkLogRepository
+}
+
+// UnitOfWork runs a series of reads and writes across repositories as one atomic step.
+// An in-memory implementation can work on copies of the data; a SQL one maps to a database transaction.
+type UnitOfWork interface {
+	// Do runs fn with stores bound to the unit of work. Their writes become visible all at once
+	// when fn returns nil and are rolled back when it returns an error, which Do then returns.
+	// Other writes wait until the unit of work has finished, so fn must not call the repositories
+	// directly, nor start another unit of work.
+	Do(fn func(stores Stores) error) error
+}
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// WorkLogRepository defines the contract for persisting tracked work.
+type WorkLogRepository interface {
+	// Save stores an entry. It must check entities.CheckNoOverlap against the user's other
+	// entries and store the entry in one atomic step, so concurrent timers cannot slip past the rule.
+	Save(entry *entities.WorkEntry) error
+	// FindRunning returns the running timer of a user, or nil if there is none.
+	FindRunning(user string) (*entities.WorkEntry, error)
+	// FindByTask returns the entries of a task ordered by start time.
+	FindByTask(taskID value_objects.TaskId) ([]*entities.WorkEntry, error)
+	// FindByUser returns the entries of a user ordered by start time; an empty user returns every entry.
+	FindByUser(user string) ([]*entities.WorkEntry, error)
+	// DeleteByTask removes every entry of a task; it succeeds if there are none.
+	DeleteByTask(taskID value_objects.TaskId) error
+}
@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// AddChecklistItemUseCase handles appending an item to the checklist of a task.
+// When UnitOfWork is set, the change can be undone.
+type AddChecklistItemUseCase struct {
+	Repo       ports.TaskRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute appends an unchecked item with the given text and returns it.
+func (uc *AddChecklistItemUseCase) Execute(taskIdStr string, text string) (dto.ChecklistItemResponse, error) {
+	response, _, err := uc.ExecuteUndoable(taskIdStr, text)
+	return response, err
+}
+
+// ExecuteUndoable appends the item like Execute and also returns a token for undoing the change,
+// which is empty unless UnitOfWork can record it.
+func (uc *AddChecklistItemUseCase) ExecuteUndoable(taskIdStr string, text string) (dto.ChecklistItemResponse, string, error) {
+	if uc.UnitOfWork != nil {
+		var response dto.ChecklistItemResponse
+		token, err := runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			var err error
+			response, err = uc.within(stores).Execute(taskIdStr, text)
+			return err
+		})
+		return response, token, err
+	}
+	taskId, err := value_objects.ParseTaskId(taskIdStr)
+	if err != nil {
+		return dto.ChecklistItemResponse{}, "", ErrInvalidID
+	}
+	task, err := uc.Repo.FindById(taskId)
+	if err != nil {
+		return dto.ChecklistItemResponse{}, "", err
+	}
+	item, err := task.AddChecklistItem(text)
+	if err != nil {
+		return dto.ChecklistItemResponse{}, "", err
+	}
+	if err := uc.Repo.Save(task); err != nil {
+		return dto.ChecklistItemResponse{}, "", err
+	}
+	return dto.ToChecklistItemResponse(*item), "", nil
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *AddChecklistItemUseCase) within(stores ports.Stores) *AddChecklistItemUseCase {
+	add := *uc
+	add.UnitOfWork = nil
+	add.Repo = stores.Tasks
+	return &add
+}
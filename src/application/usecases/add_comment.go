@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+)
+
+// ErrCommentNotOnTask is returned when a comment is addressed through a task it does not belong to.
+var ErrCommentNotOnTask = errors.New("comment does not belong to task")
+
+// AddCommentUseCase handles adding a comment to a task.
+// When UnitOfWork is set, the task is checked and the comment saved in a unit of work, so the task
+// cannot be deleted in between. Comments themselves are not covered by the unit of work.
+type AddCommentUseCase struct {
+	Repo       ports.TaskRepository
+	Comments   ports.CommentRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute adds a comment by author to the task identified by its string ID.
+// Returns the created comment or an error if the task is not found or the body is invalid.
+func (uc *AddCommentUseCase) Execute(taskIdStr string, author string, body string) (*dto.CommentResponse, error) {
+	if uc.UnitOfWork != nil {
+		var response *dto.CommentResponse
+		err := uc.UnitOfWork.Do(func(stores ports.Stores) error {
+			var err error
+			response, err = uc.within(stores).Execute(taskIdStr, author, body)
+			return err
+		})
+		return response, err
+	}
+	taskId, err := value_objects.ParseTaskId(taskIdStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	if _, err := uc.Repo.FindById(taskId); err != nil {
+		return nil, err
+	}
+	comment, err := entities.NewComment(taskId, author, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Comments.Save(comment); err != nil {
+		return nil, err
+	}
+	response := dto.ToCommentResponse(comment)
+	return &response, nil
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *AddCommentUseCase) within(stores ports.Stores) *AddCommentUseCase {
+	add := *uc
+	add.UnitOfWork = nil
+	add.Repo = stores.Tasks
+	return &add
+}
+
+// findTaskComment loads a comment and checks that it belongs to the given task.
+func findTaskComment(comments ports.CommentRepository, taskIdStr, commentIdStr string) (*entities.Comment, error) {
+	taskId, err := value_objects.ParseTaskId(taskIdStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	commentId, err := value_objects.ParseCommentId(commentIdStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	comment, err := comments.FindById(commentId)
+	if err != nil {
+		return nil, err
+	}
+	if comment.TaskID != taskId {
+		return nil, ErrCommentNotOnTask
+	}
+	return comment, nil
+}
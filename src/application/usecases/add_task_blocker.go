@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+)
+
+// AddTaskBlockerUseCase handles declaring that a task is blocked by another task.
+// When UnitOfWork is set, the change can be undone.
+type AddTaskBlockerUseCase struct {
+	Repo       ports.TaskRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute declares that the task identified by idStr is blocked by blockerIdStr.
+// Returns an error if either task is not found or the dependency would create a cycle.
+func (uc *AddTaskBlockerUseCase) Execute(idStr string, blockerIdStr string) error {
+	_, err := uc.ExecuteUndoable(idStr, blockerIdStr)
+	return err
+}
+
+// ExecuteUndoable adds the blocker like Execute and returns a token for undoing the change, which is
+// empty unless UnitOfWork can record it.
+func (uc *AddTaskBlockerUseCase) ExecuteUndoable(idStr string, blockerIdStr string) (string, error) {
+	if uc.UnitOfWork != nil {
+		return runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			return uc.within(stores).Execute(idStr, blockerIdStr)
+		})
+	}
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return "", ErrInvalidID
+	}
+	blockerId, err := value_objects.ParseTaskId(blockerIdStr)
+	if err != nil {
+		return "", ErrInvalidID
+	}
+	task, err := uc.Repo.FindById(parsedId)
+	if err != nil {
+		return "", err
+	}
+	blocker, err := uc.Repo.FindById(blockerId)
+	if err != nil {
+		return "", err
+	}
+	upstream, err := uc.upstream(blocker)
+	if err != nil {
+		return "", err
+	}
+	if err := task.AddBlocker(blocker, upstream); err != nil {
+		return "", err
+	}
+	return "", uc.Repo.Save(task)
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *AddTaskBlockerUseCase) within(stores ports.Stores) *AddTaskBlockerUseCase {
+	add := *uc
+	add.UnitOfWork = nil
+	add.Repo = stores.Tasks
+	return &add
+}
+
+// upstream returns the IDs of every task the given task transitively depends on.
+// Blockers that no longer exist, such as purged tasks, cannot close a cycle and are skipped.
+func (uc *AddTaskBlockerUseCase) upstream(task *entities.Task) ([]value_objects.TaskId, error) {
+	var ids []value_objects.TaskId
+	visited := map[value_objects.TaskId]bool{task.ID: true}
+	stack := append([]value_objects.TaskId(nil), task.BlockedBy...)
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		ids = append(ids, id)
+		current, err := uc.Repo.FindById(id)
+		if errors.Is(err, ports.ErrTaskNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		stack = append(stack, current.BlockedBy...)
+	}
+	return ids, nil
+}
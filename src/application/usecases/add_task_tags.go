@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// AddTaskTagsUseCase handles attaching tags to an existing task.
+// When UnitOfWork is set, the change can be undone.
+type AddTaskTagsUseCase struct {
+	Repo       ports.TaskRepository
+	Responses  *ResponseBuilder
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute normalizes the given tags and attaches them to the task identified by its string ID.
+// Tags the task already carries are ignored.
+// Returns the updated task or an error if the task is not found or a tag is invalid.
+func (uc *AddTaskTagsUseCase) Execute(idStr string, tags []string) (*dto.TaskResponse, error) {
+	response, _, err := uc.ExecuteUndoable(idStr, tags)
+	return response, err
+}
+
+// ExecuteUndoable attaches the tags like Execute and also returns a token for undoing the change,
+// which is empty unless UnitOfWork can record it.
+func (uc *AddTaskTagsUseCase) ExecuteUndoable(idStr string, tags []string) (*dto.TaskResponse, string, error) {
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return nil, "", ErrInvalidID
+	}
+	var (
+		task  *entities.Task
+		token string
+	)
+	if uc.UnitOfWork != nil {
+		token, err = runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			task, err = addTags(stores.Tasks, parsedId, tags)
+			return err
+		})
+	} else {
+		task, err = addTags(uc.Repo, parsedId, tags)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	response, err := uc.Responses.Build(task)
+	if err != nil {
+		return nil, "", err
+	}
+	return &response, token, nil
+}
+
+func addTags(repo ports.TaskRepository, id value_objects.TaskId, tags []string) (*entities.Task, error) {
+	task, err := repo.FindById(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := task.AddTags(tags...); err != nil {
+		return nil, err
+	}
+	return task, repo.Save(task)
+}
@@ -0,0 +1,85 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"time"
+)
+
+// DefaultArchiveAfter is how long tasks stay in done before they are archived automatically
+// unless configured otherwise.
+const DefaultArchiveAfter = 30 * 24 * time.Hour
+
+// ArchiveDoneTasksUseCase archives the top-level tasks that have been done for longer than After,
+// together with their subtasks (see ArchiveTaskUseCase).
+// When UnitOfWork is set, each task is archived with its subtasks in a unit of work of its own.
+type ArchiveDoneTasksUseCase struct {
+	Repo         ports.TaskRepository
+	Hierarchy    ports.TaskHierarchy
+	Dependencies ports.TaskDependencies
+	Archive      ports.TaskArchive
+	UnitOfWork   ports.UnitOfWork
+	After        time.Duration
+}
+
+// Execute archives the due tasks as of now and returns how many top-level tasks were archived.
+func (uc *ArchiveDoneTasksUseCase) Execute(now time.Time) (int, error) {
+	tasks, err := uc.Repo.FindByStatus(value_objects.StatusDone)
+	if err != nil {
+		return 0, err
+	}
+	archived := 0
+	for _, task := range tasks {
+		if task.IsSubtask() || !task.ArchiveDue(uc.After, now) {
+			continue
+		}
+		done := true
+		if uc.UnitOfWork != nil {
+			err = uc.UnitOfWork.Do(func(stores ports.Stores) error {
+				done, err = uc.within(stores).archive(task.ID, now)
+				return err
+			})
+		} else {
+			err = archiveTree(uc.Repo, uc.Hierarchy, uc.Dependencies, uc.Archive, task, now)
+		}
+		if err != nil {
+			return archived, err
+		}
+		if done {
+			archived++
+		}
+	}
+	return archived, nil
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *ArchiveDoneTasksUseCase) within(stores ports.Stores) *ArchiveDoneTasksUseCase {
+	archive := *uc
+	archive.UnitOfWork = nil
+	archive.Repo = stores.Tasks
+	if archive.Hierarchy != nil {
+		archive.Hierarchy = stores.Tasks
+	}
+	if archive.Dependencies != nil {
+		archive.Dependencies = stores.Tasks
+	}
+	archive.Archive = stores.Tasks
+	return &archive
+}
+
+// archive archives the task with the given ID and its subtasks. The task is read again as it may
+// have changed since the done column was listed; it is skipped if it is gone or no longer due.
+func (uc *ArchiveDoneTasksUseCase) archive(id value_objects.TaskId, now time.Time) (bool, error) {
+	task, err := uc.Repo.FindById(id)
+	if errors.Is(err, ports.ErrTaskNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if task.IsSubtask() || !task.ArchiveDue(uc.After, now) {
+		return false, nil
+	}
+	return true, archiveTree(uc.Repo, uc.Hierarchy, uc.Dependencies, uc.Archive, task, now)
+}
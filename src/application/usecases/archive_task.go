@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"time"
+)
+
+// ArchiveTaskUseCase handles moving a done top-level task to the archive.
+// When Hierarchy is set, its subtasks are archived with it; they must all be done.
+// When Dependencies is set, the archived tasks are removed from the blockers of other tasks.
+// When UnitOfWork is set, the change can be undone.
+type ArchiveTaskUseCase struct {
+	Repo         ports.TaskRepository
+	Hierarchy    ports.TaskHierarchy
+	Dependencies ports.TaskDependencies
+	Archive      ports.TaskArchive
+	UnitOfWork   ports.UnitOfWork
+}
+
+// Execute archives the task identified by its string ID.
+// Returns ErrArchiveSubtask for subtasks and ErrArchiveNotDone if a task of the tree is not done.
+func (uc *ArchiveTaskUseCase) Execute(idStr string) error {
+	_, err := uc.ExecuteUndoable(idStr)
+	return err
+}
+
+// ExecuteUndoable archives the task like Execute and returns a token for undoing the change, which is
+// empty unless UnitOfWork can record it.
+func (uc *ArchiveTaskUseCase) ExecuteUndoable(idStr string) (string, error) {
+	if uc.UnitOfWork != nil {
+		return runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			return uc.within(stores).Execute(idStr)
+		})
+	}
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return "", ErrInvalidID
+	}
+	task, err := uc.Repo.FindById(parsedId)
+	if err != nil {
+		return "", err
+	}
+	if task.IsSubtask() {
+		return "", entities.ErrArchiveSubtask
+	}
+	return "", archiveTree(uc.Repo, uc.Hierarchy, uc.Dependencies, uc.Archive, task, time.Now())
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *ArchiveTaskUseCase) within(stores ports.Stores) *ArchiveTaskUseCase {
+	archive := *uc
+	archive.UnitOfWork = nil
+	archive.Repo = stores.Tasks
+	if archive.Hierarchy != nil {
+		archive.Hierarchy = stores.Tasks
+	}
+	if archive.Dependencies != nil {
+		archive.Dependencies = stores.Tasks
+	}
+	archive.Archive = stores.Tasks
+	return &archive
+}
+
+// archiveTree archives a top-level task together with its subtasks. Every task of the tree is checked
+// and every dependent is read before the first write; callers run it in a unit of work to make the
+// writes themselves atomic.
+func archiveTree(repo ports.TaskRepository, hierarchy ports.TaskHierarchy, dependencies ports.TaskDependencies,
+	archive ports.TaskArchive, root *entities.Task, now time.Time) error {
+	tree, err := taskTree(hierarchy, root)
+	if err != nil {
+		return err
+	}
+	inTree := make(map[value_objects.TaskId]bool, len(tree))
+	for _, task := range tree {
+		if err := task.Archive(now); err != nil {
+			return err
+		}
+		inTree[task.ID] = true
+	}
+	// A dependent blocked by several tasks of the tree is read once and loses all of them.
+	var unblocked []*entities.Task
+	byID := make(map[value_objects.TaskId]*entities.Task)
+	if dependencies != nil {
+		for _, task := range tree {
+			dependents, err := dependencies.FindDependents(task.ID)
+			if err != nil {
+				return err
+			}
+			for _, dependent := range dependents {
+				if inTree[dependent.ID] {
+					continue
+				}
+				if byID[dependent.ID] == nil {
+					byID[dependent.ID] = dependent
+					unblocked = append(unblocked, dependent)
+				}
+				byID[dependent.ID].RemoveBlocker(task.ID)
+			}
+		}
+	}
+	for _, dependent := range unblocked {
+		if err := repo.Save(dependent); err != nil {
+			return err
+		}
+	}
+	for _, task := range tree {
+		if err := archive.MoveToArchive(task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+)
+
+// ErrAttachmentNotFound is returned when a task has no attachment with the requested ID.
+var ErrAttachmentNotFound = errors.New("attachment not found")
+
+// findTaskAttachment loads a task and one of its attachments.
+func findTaskAttachment(repo ports.TaskRepository, taskIdStr, attachmentIdStr string) (*entities.Task, *entities.Attachment, error) {
+	taskId, err := value_objects.ParseTaskId(taskIdStr)
+	if err != nil {
+		return nil, nil, ErrInvalidID
+	}
+	attachmentId, err := value_objects.ParseAttachmentId(attachmentIdStr)
+	if err != nil {
+		return nil, nil, ErrInvalidID
+	}
+	task, err := repo.FindById(taskId)
+	if err != nil {
+		return nil, nil, err
+	}
+	attachment := task.FindAttachment(attachmentId)
+	if attachment == nil {
+		return nil, nil, ErrAttachmentNotFound
+	}
+	return task, attachment, nil
+}
+
+// collectBlobs deletes the given blobs unless a stored task other than except still references them.
+// It must run after the referencing task has been saved or deleted, or is about to be deleted as except.
+func collectBlobs(index ports.TaskAttachmentIndex, blobs ports.BlobStore, except value_objects.TaskId, digests []string) error {
+	for _, digest := range digests {
+		referenced, err := index.IsBlobReferenced(digest, except)
+		if err != nil {
+			return err
+		}
+		if referenced {
+			continue
+		}
+		if err := blobs.Delete(digest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
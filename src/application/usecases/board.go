@@ -0,0 +1,104 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+)
+
+// ErrInvalidPosition is returned when a move references tasks that are not adjacent in the target column.
+var ErrInvalidPosition = errors.New("position must reference adjacent tasks in the target column")
+
+// placeInColumn ranks the task within its status column, directly after the task afterID
+// and/or before the task beforeID; without either it goes to the bottom of the column.
+// When the neighbours leave no room the column is renumbered; the renumbered tasks are
+// returned and must be saved along with the task.
+func placeInColumn(repo ports.TaskRepository, task *entities.Task, afterID, beforeID string) ([]*entities.Task, error) {
+	found, err := repo.FindByStatus(task.Status)
+	if err != nil {
+		return nil, err
+	}
+	column := make([]*entities.Task, 0, len(found))
+	for _, other := range found {
+		if other.ID != task.ID {
+			column = append(column, other)
+		}
+	}
+	lower, upper, err := neighbours(column, afterID, beforeID)
+	if err != nil {
+		return nil, err
+	}
+	err = task.PlaceBetween(lower, upper)
+	if !errors.Is(err, value_objects.ErrInvalidRank) {
+		return nil, err
+	}
+	entities.RebalanceRanks(column)
+	if err := task.PlaceBetween(lower, upper); err != nil {
+		return nil, err
+	}
+	return column, nil
+}
+
+// neighbours resolves the tasks a placed task goes between.
+func neighbours(column []*entities.Task, afterID, beforeID string) (*entities.Task, *entities.Task, error) {
+	indexOf := func(id string) int {
+		for i, task := range column {
+			if string(task.ID) == id {
+				return i
+			}
+		}
+		return -1
+	}
+	switch {
+	case afterID != "":
+		i := indexOf(afterID)
+		if i < 0 {
+			return nil, nil, ErrInvalidPosition
+		}
+		var upper *entities.Task
+		if i+1 < len(column) {
+			upper = column[i+1]
+		}
+		if beforeID != "" && (upper == nil || string(upper.ID) != beforeID) {
+			return nil, nil, ErrInvalidPosition
+		}
+		return column[i], upper, nil
+	case beforeID != "":
+		j := indexOf(beforeID)
+		if j < 0 {
+			return nil, nil, ErrInvalidPosition
+		}
+		if j == 0 {
+			return nil, column[0], nil
+		}
+		return column[j-1], column[j], nil
+	case len(column) > 0:
+		return column[len(column)-1], nil, nil
+	default:
+		return nil, nil, nil
+	}
+}
+
+// saveInColumn saves a task placed in its column together with the tasks renumbered to make room
+// for it. When wip is set and there are limits, the task is checked against the limits of its column
+// and saved in one atomic step.
+func saveInColumn(repo ports.TaskRepository, wip ports.TaskWIPGuard, limits entities.WIPLimits, task *entities.Task, renumbered []*entities.Task) error {
+	if wip != nil && len(limits) > 0 {
+		return wip.SaveWithinLimits(limits, nil, append([]*entities.Task{task}, renumbered...)...)
+	}
+	if err := saveAll(repo, renumbered...); err != nil {
+		return err
+	}
+	return repo.Save(task)
+}
+
+// saveAll persists the given tasks in order.
+func saveAll(repo ports.TaskRepository, tasks ...*entities.Task) error {
+	for _, task := range tasks {
+		if err := repo.Save(task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"errors"
+	"fmt"
+)
+
+// Modes of bulk requests.
+const (
+	// BulkAtomic applies every item of a request or none of them. It is the default.
+	BulkAtomic = "atomic"
+	// BulkBestEffort applies each item on its own and reports the items that failed.
+	BulkBestEffort = "best-effort"
+)
+
+// MaxBulkItems is the maximum number of items in a bulk request.
+const MaxBulkItems = 100
+
+// Errors for bulk requests
+var (
+	ErrInvalidBulkMode   = errors.New("bulk mode must be atomic or best-effort")
+	ErrInvalidBulkSize   = fmt.Errorf("bulk requests must hold 1 to %d items", MaxBulkItems)
+	ErrAtomicUnsupported = errors.New("atomic bulk requests require a unit of work")
+	// ErrNotApplied is reported for the other items of an atomic request when one item fails.
+	ErrNotApplied = errors.New("not applied because another item of the request failed")
+)
+
+// runBulk runs apply for each of the n items of a bulk request.
+// In atomic mode the items run in one unit of work, whose stores are given to apply, that stops at
+// the first failure; every other item is then reported with ErrNotApplied. In best-effort mode apply
+// gets nil stores and every item runs.
+func runBulk(mode string, n int, unitOfWork ports.UnitOfWork, apply func(i int, stores *ports.Stores) dto.BulkItemResult) ([]dto.BulkItemResult, error) {
+	if n == 0 || n > MaxBulkItems {
+		return nil, ErrInvalidBulkSize
+	}
+	results := make([]dto.BulkItemResult, n)
+	switch mode {
+	case BulkBestEffort:
+		for i := range results {
+			results[i] = apply(i, nil)
+		}
+		return results, nil
+	case BulkAtomic, "":
+	default:
+		return nil, ErrInvalidBulkMode
+	}
+	if unitOfWork == nil {
+		return nil, ErrAtomicUnsupported
+	}
+	failed := -1
+	err := unitOfWork.Do(func(stores ports.Stores) error {
+		for i := range results {
+			results[i] = apply(i, &stores)
+			if results[i].Err != nil {
+				failed = i
+				return results[i].Err
+			}
+		}
+		return nil
+	})
+	if failed < 0 {
+		return results, err
+	}
+	for i := range results {
+		if i != failed {
+			results[i] = dto.BulkItemResult{Index: i, Err: ErrNotApplied}
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+)
+
+// BulkCreateTasksUseCase handles creating several tasks in one request with the rules of Create.
+// Atomic requests require UnitOfWork.
+type BulkCreateTasksUseCase struct {
+	Create     *CreateTaskUseCase
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute creates the tasks in order and reports the ID of each created task.
+func (uc *BulkCreateTasksUseCase) Execute(req dto.BulkCreateRequest) ([]dto.BulkItemResult, error) {
+	return runBulk(req.Mode, len(req.Tasks), uc.UnitOfWork, func(i int, stores *ports.Stores) dto.BulkItemResult {
+		result := dto.BulkItemResult{Index: i}
+		response, err := uc.creator(stores).Execute(req.Tasks[i])
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		result.TaskID = response.ID
+		return result
+	})
+}
+
+// creator returns the create use case working on the stores, if any.
+func (uc *BulkCreateTasksUseCase) creator(stores *ports.Stores) *CreateTaskUseCase {
+	if stores == nil {
+		return uc.Create
+	}
+	return uc.Create.within(*stores)
+}
@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// BulkDeleteTasksUseCase handles deleting several tasks in one request like DeleteTaskUseCase.
+// Atomic requests require UnitOfWork.
+type BulkDeleteTasksUseCase struct {
+	Delete     *DeleteTaskUseCase
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute deletes the tasks in order. The comments, work log and attachments of tasks deleted
+// for good are only deleted once every task of an atomic request has been deleted.
+func (uc *BulkDeleteTasksUseCase) Execute(req dto.BulkDeleteRequest) ([]dto.BulkItemResult, error) {
+	ids := make([]value_objects.TaskId, len(req.TaskIDs))
+	removed := make([]*entities.Task, len(req.TaskIDs))
+	results, err := runBulk(req.Mode, len(req.TaskIDs), uc.UnitOfWork, func(i int, stores *ports.Stores) dto.BulkItemResult {
+		result := dto.BulkItemResult{Index: i, TaskID: req.TaskIDs[i]}
+		id, err := value_objects.ParseTaskId(req.TaskIDs[i])
+		if err != nil {
+			result.Err = ErrInvalidID
+			return result
+		}
+		ids[i] = id
+		removed[i], result.Err = uc.deleter(stores).remove(id)
+		return result
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range results {
+		if result.Err == nil {
+			results[i].Err = uc.Delete.deleteData(ids[i], removed[i])
+		}
+	}
+	return results, nil
+}
+
+// deleter returns the delete use case working on the stores, if any.
+func (uc *BulkDeleteTasksUseCase) deleter(stores *ports.Stores) *DeleteTaskUseCase {
+	if stores == nil {
+		return uc.Delete
+	}
+	return uc.Delete.within(*stores)
+}
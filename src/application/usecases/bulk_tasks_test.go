@@ -0,0 +1,132 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+)
+
+func TestBulkCreate_AtomicRollsBackAndBestEffortReports(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	bulk := &BulkCreateTasksUseCase{Create: &CreateTaskUseCase{Repo: repo}, UnitOfWork: repositories.NewInMemoryCopyOnCommitUnitOfWork(repo, nil, repositories.DefaultUndoWindow)}
+	tasks := []dto.CreateTaskRequest{{Title: "paint"}, {Title: ""}, {Title: "mow"}}
+
+	results, err := bulk.Execute(dto.BulkCreateRequest{Mode: BulkAtomic, Tasks: tasks})
+	if err != nil {
+		t.Fatalf("bulk create failed: %v", err)
+	}
+	if !errors.Is(results[1].Err, entities.ErrEmptyTitle) || !errors.Is(results[0].Err, ErrNotApplied) ||
+		!errors.Is(results[2].Err, ErrNotApplied) {
+		t.Fatalf("expected the empty title to fail the request, got %+v", results)
+	}
+	if todo, _ := repo.FindByStatus(value_objects.StatusTodo); len(todo) != 0 {
+		t.Fatalf("expected no task to be created, got %d", len(todo))
+	}
+
+	results, _ = bulk.Execute(dto.BulkCreateRequest{Mode: BulkBestEffort, Tasks: tasks})
+	if results[0].Err != nil || results[0].TaskID == "" || !errors.Is(results[1].Err, entities.ErrEmptyTitle) || results[2].Err != nil {
+		t.Fatalf("expected only the empty title to fail, got %+v", results)
+	}
+	todo, _ := repo.FindByStatus(value_objects.StatusTodo)
+	if len(todo) != 2 || todo[0].Title != "paint" || todo[1].Title != "mow" {
+		t.Fatalf("expected the valid tasks in request order, got %v", todo)
+	}
+
+	if _, err := bulk.Execute(dto.BulkCreateRequest{Mode: "some", Tasks: tasks}); !errors.Is(err, ErrInvalidBulkMode) {
+		t.Errorf("expected ErrInvalidBulkMode, got %v", err)
+	}
+	if _, err := bulk.Execute(dto.BulkCreateRequest{}); !errors.Is(err, ErrInvalidBulkSize) {
+		t.Errorf("expected ErrInvalidBulkSize, got %v", err)
+	}
+	bulk.UnitOfWork = nil
+	if _, err := bulk.Execute(dto.BulkCreateRequest{Tasks: tasks}); !errors.Is(err, ErrAtomicUnsupported) {
+		t.Errorf("expected ErrAtomicUnsupported, got %v", err)
+	}
+}
+
+func TestBulkUpdateStatus_AtomicAppliesAllOrNothing(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	parent := createTask(t, repo, "parent", nil)
+	child := createTask(t, repo, "child", parent)
+	bulk := &BulkUpdateStatusUseCase{UpdateStatus: &UpdateTaskStatusUseCase{Repo: repo, Hierarchy: repo}, UnitOfWork: repositories.NewInMemoryCopyOnCommitUnitOfWork(repo, nil, repositories.DefaultUndoWindow)}
+	status := func(id string) value_objects.TaskStatus {
+		task, _ := repo.FindById(value_objects.TaskId(id))
+		return task.Status
+	}
+
+	// The parent cannot be completed while the child is open, which the request only changes later.
+	results, _ := bulk.Execute(dto.BulkUpdateStatusRequest{Updates: []dto.UpdateStatusRequest{
+		{TaskID: parent.ID, NewStatus: "doing"},
+		{TaskID: parent.ID, NewStatus: "done"},
+		{TaskID: child.ID, NewStatus: "doing"},
+	}})
+	if !errors.Is(results[1].Err, entities.ErrOpenChildren) {
+		t.Fatalf("expected ErrOpenChildren, got %+v", results)
+	}
+	if status(parent.ID) != value_objects.StatusTodo {
+		t.Fatalf("expected the first move to be rolled back, got %s", status(parent.ID))
+	}
+
+	// Moves later in a request see the earlier ones.
+	results, _ = bulk.Execute(dto.BulkUpdateStatusRequest{Updates: []dto.UpdateStatusRequest{
+		{TaskID: child.ID, NewStatus: "doing"},
+		{TaskID: child.ID, NewStatus: "done"},
+		{TaskID: parent.ID, NewStatus: "doing"},
+		{TaskID: parent.ID, NewStatus: "done"},
+	}})
+	for _, result := range results {
+		if result.Err != nil {
+			t.Fatalf("unexpected failure: %+v", results)
+		}
+	}
+	if status(parent.ID) != value_objects.StatusDone || status(child.ID) != value_objects.StatusDone {
+		t.Errorf("expected both tasks to be done")
+	}
+}
+
+func TestBulkDelete_RollsBackOnMissingTask(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	a := createTask(t, repo, "a", nil)
+	b := createTask(t, repo, "b", nil)
+	bulk := &BulkDeleteTasksUseCase{Delete: &DeleteTaskUseCase{Repo: repo, Trash: repo}, UnitOfWork: repositories.NewInMemoryCopyOnCommitUnitOfWork(repo, nil, repositories.DefaultUndoWindow)}
+
+	results, _ := bulk.Execute(dto.BulkDeleteRequest{TaskIDs: []string{a.ID, "not-an-id", b.ID}})
+	if !errors.Is(results[1].Err, ErrInvalidID) {
+		t.Fatalf("expected ErrInvalidID, got %+v", results)
+	}
+	if trash, _ := repo.FindTrashed(); len(trash) != 0 {
+		t.Fatalf("expected nothing in the trash, got %v", trash)
+	}
+
+	results, _ = bulk.Execute(dto.BulkDeleteRequest{TaskIDs: []string{a.ID, b.ID}})
+	if results[0].Err != nil || results[1].Err != nil {
+		t.Fatalf("unexpected failure: %+v", results)
+	}
+	if trash, _ := repo.FindTrashed(); len(trash) != 2 {
+		t.Errorf("expected both tasks in the trash, got %v", trash)
+	}
+}
+
+func TestBulkUpdateStatus_RollsBackTimers(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	workLog := repositories.NewInMemoryWorkLogRepository()
+	task := createTask(t, repo, "paint", nil)
+	bulk := &BulkUpdateStatusUseCase{
+		UpdateStatus: &UpdateTaskStatusUseCase{Repo: repo, WorkLog: workLog},
+		UnitOfWork:   repositories.NewInMemoryCopyOnCommitUnitOfWork(repo, workLog, repositories.DefaultUndoWindow),
+	}
+
+	results, _ := bulk.Execute(dto.BulkUpdateStatusRequest{Updates: []dto.UpdateStatusRequest{
+		{TaskID: task.ID, NewStatus: "doing", Actor: "alice", StartTimer: true},
+		{TaskID: task.ID, NewStatus: "bogus", Actor: "alice"},
+	}})
+	if !errors.Is(results[1].Err, entities.ErrInvalidStatus) {
+		t.Fatalf("expected ErrInvalidStatus, got %+v", results)
+	}
+	if running, _ := workLog.FindRunning("alice"); running != nil {
+		t.Errorf("expected the timer to be rolled back with the move, got %+v", running)
+	}
+}
@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+)
+
+// BulkUpdateStatusUseCase handles changing the status of several tasks in one request with the
+// rules of UpdateStatus. Atomic requests require UnitOfWork.
+type BulkUpdateStatusUseCase struct {
+	UpdateStatus *UpdateTaskStatusUseCase
+	UnitOfWork   ports.UnitOfWork
+}
+
+// Execute applies the status changes in order.
+func (uc *BulkUpdateStatusUseCase) Execute(req dto.BulkUpdateStatusRequest) ([]dto.BulkItemResult, error) {
+	return runBulk(req.Mode, len(req.Updates), uc.UnitOfWork, func(i int, stores *ports.Stores) dto.BulkItemResult {
+		update := req.Updates[i]
+		return dto.BulkItemResult{Index: i, TaskID: update.TaskID, Err: uc.updater(stores).ExecuteRequest(update)}
+	})
+}
+
+// updater returns the status use case working on the stores, if any.
+func (uc *BulkUpdateStatusUseCase) updater(stores *ports.Stores) *UpdateTaskStatusUseCase {
+	if stores == nil {
+		return uc.UpdateStatus
+	}
+	return uc.UpdateStatus.within(*stores)
+}
@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+)
+
+// CheckChecklistItemUseCase handles checking and unchecking an item of a task checklist.
+// When UnitOfWork is set, the change can be undone.
+type CheckChecklistItemUseCase struct {
+	Repo       ports.TaskRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute sets the checked flag of the item and returns it.
+func (uc *CheckChecklistItemUseCase) Execute(taskIdStr, itemIdStr string, checked bool) (dto.ChecklistItemResponse, error) {
+	response, _, err := uc.ExecuteUndoable(taskIdStr, itemIdStr, checked)
+	return response, err
+}
+
+// ExecuteUndoable sets the checked flag like Execute and also returns a token for undoing the change,
+// which is empty unless UnitOfWork can record it.
+func (uc *CheckChecklistItemUseCase) ExecuteUndoable(taskIdStr, itemIdStr string, checked bool) (dto.ChecklistItemResponse, string, error) {
+	if uc.UnitOfWork != nil {
+		var response dto.ChecklistItemResponse
+		token, err := runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			var err error
+			response, err = uc.within(stores).Execute(taskIdStr, itemIdStr, checked)
+			return err
+		})
+		return response, token, err
+	}
+	task, item, err := findTaskChecklistItem(uc.Repo, taskIdStr, itemIdStr)
+	if err != nil {
+		return dto.ChecklistItemResponse{}, "", err
+	}
+	item = task.CheckChecklistItem(item.ID, checked)
+	if err := uc.Repo.Save(task); err != nil {
+		return dto.ChecklistItemResponse{}, "", err
+	}
+	return dto.ToChecklistItemResponse(*item), "", nil
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *CheckChecklistItemUseCase) within(stores ports.Stores) *CheckChecklistItemUseCase {
+	check := *uc
+	check.UnitOfWork = nil
+	check.Repo = stores.Tasks
+	return &check
+}
@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+)
+
+// ErrChecklistItemNotFound is returned when a task has no checklist item with the requested ID.
+var ErrChecklistItemNotFound = errors.New("checklist item not found")
+
+// findTaskChecklistItem loads a task and one of its checklist items.
+func findTaskChecklistItem(repo ports.TaskRepository, taskIdStr, itemIdStr string) (*entities.Task, *entities.ChecklistItem, error) {
+	taskId, err := value_objects.ParseTaskId(taskIdStr)
+	if err != nil {
+		return nil, nil, ErrInvalidID
+	}
+	itemId, err := value_objects.ParseChecklistItemId(itemIdStr)
+	if err != nil {
+		return nil, nil, ErrInvalidID
+	}
+	task, err := repo.FindById(taskId)
+	if err != nil {
+		return nil, nil, err
+	}
+	item := task.FindChecklistItem(itemId)
+	if item == nil {
+		return nil, nil, ErrChecklistItemNotFound
+	}
+	return task, item, nil
+}
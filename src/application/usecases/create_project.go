@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+)
+
+// CreateProjectUseCase handles the creation of projects.
+type CreateProjectUseCase struct {
+	Projects ports.ProjectRepository
+}
+
+// Execute creates a new project with its default workflow settings.
+func (uc *CreateProjectUseCase) Execute(req dto.ProjectRequest) (*dto.ProjectResponse, error) {
+	settings, err := entities.NewProjectSettings(req.DefaultTags, req.StartTimers)
+	if err != nil {
+		return nil, err
+	}
+	project, err := entities.NewProject(req.Name, req.Description, settings)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Projects.Save(project); err != nil {
+		return nil, err
+	}
+	response := dto.ToProjectResponse(project, 0)
+	return &response, nil
+}
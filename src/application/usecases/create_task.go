@@ -6,24 +6,102 @@ import (
 	"clean-architecture-golang/application/dto"
 	"clean-architecture-golang/application/ports"
 	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
 )
 
 // CreateTaskUseCase handles the creation of new tasks.
+// When Projects is set, tasks can be created in a project and receive its default tags.
+// When WIP is set, a task cannot be created in a todo column that already holds the number of tasks
+// allowed by Limits.
+// When UnitOfWork is set, the task and the tasks renumbered to make room for it are saved atomically.
 type CreateTaskUseCase struct {
-	Repo ports.TaskRepository
+	Repo       ports.TaskRepository
+	Projects   ports.ProjectRepository
+	WIP        ports.TaskWIPGuard
+	Limits     entities.WIPLimits
+	UnitOfWork ports.UnitOfWork
 }
 
-// Execute creates a new task and persists it.
+// Execute creates a new task and persists it at the bottom of the todo column.
+// When req.ParentID is set the task is created as a subtask of that task.
+// A due date and recurrence rule can optionally be given.
 // Returns the created task as a DTO or an error if creation fails.
 func (uc *CreateTaskUseCase) Execute(req dto.CreateTaskRequest) (*dto.TaskResponse, error) {
+	if uc.UnitOfWork != nil {
+		var response *dto.TaskResponse
+		err := uc.UnitOfWork.Do(func(stores ports.Stores) error {
+			var err error
+			response, err = uc.within(stores).Execute(req)
+			return err
+		})
+		return response, err
+	}
 	task, err := entities.NewTask(req.Title, req.Description)
 	if err != nil {
 		return nil, err
 	}
-	err = uc.Repo.Save(task)
+	if req.ParentID != "" {
+		parentId, err := value_objects.ParseTaskId(req.ParentID)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		parent, err := uc.Repo.FindById(parentId)
+		if err != nil {
+			return nil, err
+		}
+		if err := task.SetParent(parent, nil); err != nil {
+			return nil, err
+		}
+		if req.ProjectID == "" {
+			req.ProjectID = string(parent.ProjectID)
+		} else if req.ProjectID != string(parent.ProjectID) {
+			return nil, entities.ErrSubtaskProject
+		}
+	}
+	if err := uc.applyProject(task, req.ProjectID); err != nil {
+		return nil, err
+	}
+	if err := applySchedule(task, req.DueDate, req.Timezone, req.Recurrence); err != nil {
+		return nil, err
+	}
+	if req.Priority != "" {
+		priority, err := value_objects.ParsePriority(req.Priority)
+		if err != nil {
+			return nil, err
+		}
+		task.SetPriority(priority)
+	}
+	renumbered, err := placeInColumn(uc.Repo, task, "", "")
 	if err != nil {
 		return nil, err
 	}
+	if err := saveInColumn(uc.Repo, uc.WIP, uc.Limits, task, renumbered); err != nil {
+		return nil, err
+	}
 	response := dto.ToTaskResponse(task)
 	return &response, nil
 }
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *CreateTaskUseCase) within(stores ports.Stores) *CreateTaskUseCase {
+	create := *uc
+	create.UnitOfWork = nil
+	create.Repo = stores.Tasks
+	if create.WIP != nil {
+		create.WIP = stores.Tasks
+	}
+	return &create
+}
+
+// applyProject adds the task to the project and applies the project defaults.
+func (uc *CreateTaskUseCase) applyProject(task *entities.Task, projectIdStr string) error {
+	if uc.Projects == nil || projectIdStr == "" {
+		return nil
+	}
+	project, err := findProject(uc.Projects, projectIdStr)
+	if err != nil {
+		return err
+	}
+	task.MoveToProject(project)
+	return project.ApplyDefaults(task)
+}
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+)
+
+// DeleteAttachmentUseCase handles removing a file from a task.
+// When UnitOfWork is set, the task is read and saved in a unit of work.
+type DeleteAttachmentUseCase struct {
+	Repo        ports.TaskRepository
+	Blobs       ports.BlobStore
+	Attachments ports.TaskAttachmentIndex
+	UnitOfWork  ports.UnitOfWork
+}
+
+// Execute detaches the attachment from the task and deletes its blob once nothing references it.
+func (uc *DeleteAttachmentUseCase) Execute(taskIdStr string, attachmentIdStr string) error {
+	var removed entities.Attachment
+	var err error
+	if uc.UnitOfWork != nil {
+		err = uc.UnitOfWork.Do(func(stores ports.Stores) error {
+			removed, err = uc.within(stores).detach(taskIdStr, attachmentIdStr)
+			return err
+		})
+	} else {
+		removed, err = uc.detach(taskIdStr, attachmentIdStr)
+	}
+	if err != nil {
+		return err
+	}
+	return collectBlobs(uc.Attachments, uc.Blobs, "", []string{removed.Digest})
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *DeleteAttachmentUseCase) within(stores ports.Stores) *DeleteAttachmentUseCase {
+	remove := *uc
+	remove.UnitOfWork = nil
+	remove.Repo = stores.Tasks
+	return &remove
+}
+
+// detach removes the attachment from its task and saves the task.
+func (uc *DeleteAttachmentUseCase) detach(taskIdStr string, attachmentIdStr string) (entities.Attachment, error) {
+	task, attachment, err := findTaskAttachment(uc.Repo, taskIdStr, attachmentIdStr)
+	if err != nil {
+		return entities.Attachment{}, err
+	}
+	removed, _ := task.RemoveAttachment(attachment.ID)
+	return removed, uc.Repo.Save(task)
+}
@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+)
+
+// DeleteCommentUseCase handles removing a comment from a task.
+type DeleteCommentUseCase struct {
+	Comments ports.CommentRepository
+}
+
+// Execute deletes a comment on the given task.
+// Returns an error if the comment is not found on that task.
+func (uc *DeleteCommentUseCase) Execute(taskIdStr string, commentIdStr string) error {
+	comment, err := findTaskComment(uc.Comments, taskIdStr, commentIdStr)
+	if err != nil {
+		return err
+	}
+	return uc.Comments.Delete(comment.ID)
+}
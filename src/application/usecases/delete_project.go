@@ -0,0 +1,84 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+)
+
+// ErrProjectNotEmpty is returned when deleting a project that still has tasks.
+var ErrProjectNotEmpty = errors.New("project still has tasks")
+
+// DeleteProjectUseCase handles the deletion of projects.
+// Only empty projects can be deleted; tasks must be moved or deleted first.
+// When Trash or Archive is set, the trashed or archived tasks of the project keep it from being deleted too.
+// When UnitOfWork is set, the project is checked and deleted in a unit of work, so no task can join it in
+// between.
+type DeleteProjectUseCase struct {
+	Projects   ports.ProjectRepository
+	Tasks      ports.TaskProjectIndex
+	Trash      ports.TaskTrash
+	Archive    ports.TaskArchive
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute deletes the project identified by its string ID.
+// Returns ErrProjectNotEmpty if tasks still belong to it.
+func (uc *DeleteProjectUseCase) Execute(idStr string) error {
+	project, err := findProject(uc.Projects, idStr)
+	if err != nil {
+		return err
+	}
+	if uc.UnitOfWork != nil {
+		return uc.UnitOfWork.Do(func(stores ports.Stores) error {
+			return uc.within(stores).delete(project.ID)
+		})
+	}
+	return uc.delete(project.ID)
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *DeleteProjectUseCase) within(stores ports.Stores) *DeleteProjectUseCase {
+	del := *uc
+	del.UnitOfWork = nil
+	del.Tasks = stores.Tasks
+	if del.Trash != nil {
+		del.Trash = stores.Tasks
+	}
+	if del.Archive != nil {
+		del.Archive = stores.Tasks
+	}
+	return &del
+}
+
+func (uc *DeleteProjectUseCase) delete(id value_objects.ProjectId) error {
+	count, err := uc.Tasks.CountByProject(id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrProjectNotEmpty
+	}
+	var shelved []*entities.Task
+	if uc.Trash != nil {
+		trashed, err := uc.Trash.FindTrashed()
+		if err != nil {
+			return err
+		}
+		shelved = append(shelved, trashed...)
+	}
+	if uc.Archive != nil {
+		archived, err := uc.Archive.FindArchived()
+		if err != nil {
+			return err
+		}
+		shelved = append(shelved, archived...)
+	}
+	for _, task := range shelved {
+		if task.ProjectID == id {
+			return ErrProjectNotEmpty
+		}
+	}
+	return uc.Projects.Delete(id)
+}
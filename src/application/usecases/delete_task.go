@@ -2,20 +2,194 @@ package usecases
 
 import (
 	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
 	"clean-architecture-golang/domain/value_objects"
+	"time"
 )
 
 // DeleteTaskUseCase handles the deletion of tasks.
+// When Hierarchy is set, subtasks of the deleted task become top-level tasks.
+// When Dependencies is set, the deleted task is removed from the blockers of other tasks.
+// When Comments is set, the comment thread of the task is deleted with it.
+// When WorkLog is set, the work logged on the task is deleted with it.
+// When Blobs and Attachments are set, attachment blobs no other task references are deleted.
+// When Trash is set, the task is moved to the trash instead; its comments, work log and
+// attachments are kept until PurgeTrashUseCase deletes it for good.
+// When UnitOfWork is set, the task is removed and detached from the other tasks atomically; its comments,
+// work log and attachments are deleted once that is committed.
 type DeleteTaskUseCase struct {
-	Repo ports.TaskRepository
+	Repo         ports.TaskRepository
+	Hierarchy    ports.TaskHierarchy
+	Dependencies ports.TaskDependencies
+	Comments     ports.CommentRepository
+	WorkLog      ports.WorkLogRepository
+	Blobs        ports.BlobStore
+	Attachments  ports.TaskAttachmentIndex
+	Trash        ports.TaskTrash
+	UnitOfWork   ports.UnitOfWork
 }
 
 // Execute deletes a task by its string ID.
 // Returns an error if the task is not found or deletion fails.
 func (uc *DeleteTaskUseCase) Execute(idStr string) error {
+	_, err := uc.ExecuteUndoable(idStr)
+	return err
+}
+
+// ExecuteUndoable deletes a task like Execute and returns a token for undoing the deletion, which is
+// empty unless UnitOfWork can record it. Only moves to the trash can be undone, as the data of tasks
+// deleted for good is gone.
+func (uc *DeleteTaskUseCase) ExecuteUndoable(idStr string) (string, error) {
 	parsedId, err := value_objects.ParseTaskId(idStr)
 	if err != nil {
-		return ErrInvalidID
+		return "", ErrInvalidID
+	}
+	var (
+		task  *entities.Task
+		token string
+	)
+	remove := func(stores ports.Stores) error {
+		task, err = uc.within(stores).remove(parsedId)
+		return err
+	}
+	switch {
+	case uc.UnitOfWork != nil && uc.Trash != nil:
+		token, err = runUndoable(uc.UnitOfWork, remove)
+	case uc.UnitOfWork != nil:
+		err = uc.UnitOfWork.Do(remove)
+	default:
+		task, err = uc.remove(parsedId)
+	}
+	if err != nil {
+		return "", err
+	}
+	return token, uc.deleteData(parsedId, task)
+}
+
+// within returns a copy of the use case removing tasks through the stores of a unit of work.
+func (uc *DeleteTaskUseCase) within(stores ports.Stores) *DeleteTaskUseCase {
+	del := *uc
+	del.UnitOfWork = nil
+	del.Repo = stores.Tasks
+	if del.Hierarchy != nil {
+		del.Hierarchy = stores.Tasks
+	}
+	if del.Dependencies != nil {
+		del.Dependencies = stores.Tasks
+	}
+	if del.Attachments != nil {
+		del.Attachments = stores.Tasks
+	}
+	if del.Trash != nil {
+		del.Trash = stores.Tasks
+	}
+	return &del
+}
+
+// remove moves the task to the trash or deletes it, and detaches it from the other tasks.
+// The returned task is the deleted one when its attachments may need collecting, nil otherwise.
+func (uc *DeleteTaskUseCase) remove(id value_objects.TaskId) (*entities.Task, error) {
+	if uc.Trash != nil {
+		return nil, uc.moveToTrash(id)
+	}
+	var task *entities.Task
+	if uc.Blobs != nil && uc.Attachments != nil {
+		var err error
+		if task, err = uc.Repo.FindById(id); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.Repo.Delete(id); err != nil {
+		return nil, err
+	}
+	if err := uc.detachChildren(id); err != nil {
+		return nil, err
+	}
+	return task, uc.unblockDependents(id)
+}
+
+// deleteData deletes the data kept outside the task of a task removed for good.
+func (uc *DeleteTaskUseCase) deleteData(id value_objects.TaskId, task *entities.Task) error {
+	if uc.Trash != nil {
+		return nil
+	}
+	return deleteTaskData(uc.Comments, uc.WorkLog, uc.Blobs, uc.Attachments, id, task)
+}
+
+// moveToTrash detaches the task from the other tasks like a permanent deletion and moves it to the trash.
+func (uc *DeleteTaskUseCase) moveToTrash(id value_objects.TaskId) error {
+	task, err := uc.Repo.FindById(id)
+	if err != nil {
+		return err
+	}
+	task.MoveToTrash(time.Now())
+	if err := uc.Trash.MoveToTrash(task); err != nil {
+		return err
+	}
+	if err := uc.detachChildren(id); err != nil {
+		return err
+	}
+	return uc.unblockDependents(id)
+}
+
+// deleteTaskData deletes the comments, work log and unreferenced attachment blobs of a task that has
+// been or is about to be deleted; its own attachments do not count as references to its blobs.
+// Each kind of data is skipped when its port is not set; blobs are skipped when task is nil.
+func deleteTaskData(comments ports.CommentRepository, workLog ports.WorkLogRepository, blobs ports.BlobStore,
+	attachments ports.TaskAttachmentIndex, id value_objects.TaskId, task *entities.Task) error {
+	if comments != nil {
+		if err := comments.DeleteByTask(id); err != nil {
+			return err
+		}
+	}
+	if workLog != nil {
+		if err := workLog.DeleteByTask(id); err != nil {
+			return err
+		}
+	}
+	if task == nil || blobs == nil || attachments == nil {
+		return nil
+	}
+	var digests []string
+	for _, attachment := range task.Attachments {
+		digests = append(digests, attachment.Digest)
+	}
+	if len(digests) > 0 {
+		return collectBlobs(attachments, blobs, id, digests)
+	}
+	return nil
+}
+
+func (uc *DeleteTaskUseCase) detachChildren(id value_objects.TaskId) error {
+	if uc.Hierarchy == nil {
+		return nil
+	}
+	children, err := uc.Hierarchy.FindChildren(id)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		child.SetParent(nil, nil)
+		if err := uc.Repo.Save(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *DeleteTaskUseCase) unblockDependents(id value_objects.TaskId) error {
+	if uc.Dependencies == nil {
+		return nil
+	}
+	dependents, err := uc.Dependencies.FindDependents(id)
+	if err != nil {
+		return err
+	}
+	for _, dependent := range dependents {
+		dependent.RemoveBlocker(id)
+		if err := uc.Repo.Save(dependent); err != nil {
+			return err
+		}
 	}
-	return uc.Repo.Delete(parsedId)
+	return nil
 }
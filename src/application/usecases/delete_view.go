@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"strings"
+)
+
+// DeleteViewUseCase handles removing a saved view.
+type DeleteViewUseCase struct {
+	Views ports.SavedViewRepository
+}
+
+// Execute deletes the view of the owner with the given name.
+func (uc *DeleteViewUseCase) Execute(owner, name string) error {
+	if strings.TrimSpace(owner) == "" {
+		return entities.ErrMissingViewOwner
+	}
+	return uc.Views.Delete(owner, name)
+}
@@ -0,0 +1,28 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+)
+
+// EditCommentUseCase handles changing the body of a comment.
+type EditCommentUseCase struct {
+	Comments ports.CommentRepository
+}
+
+// Execute replaces the body of a comment on the given task.
+// Returns the updated comment or an error if it is not found on that task or the body is invalid.
+func (uc *EditCommentUseCase) Execute(taskIdStr string, commentIdStr string, body string) (*dto.CommentResponse, error) {
+	comment, err := findTaskComment(uc.Comments, taskIdStr, commentIdStr)
+	if err != nil {
+		return nil, err
+	}
+	if err := comment.Edit(body); err != nil {
+		return nil, err
+	}
+	if err := uc.Comments.Save(comment); err != nil {
+		return nil, err
+	}
+	response := dto.ToCommentResponse(comment)
+	return &response, nil
+}
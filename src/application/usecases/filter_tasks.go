@@ -0,0 +1,27 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// FilterTasksUseCase handles listing tasks matching a filter expression.
+type FilterTasksUseCase struct {
+	Query     ports.TaskQuery
+	Responses *ResponseBuilder
+}
+
+// Execute parses the filter expression and returns the matching tasks ordered by creation time.
+// Malformed expressions fail with a *value_objects.FilterSyntaxError.
+func (uc *FilterTasksUseCase) Execute(expr string) ([]dto.TaskResponse, error) {
+	filter, err := value_objects.ParseTaskFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := uc.Query.FindMatching(filter)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Responses.BuildAll(tasks)
+}
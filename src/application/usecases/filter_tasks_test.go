@@ -0,0 +1,43 @@
+package usecases
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+)
+
+func TestFilterTasks_ExecutesParsedFilter(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	paint := createTask(t, repo, "paint", nil)
+	mow := createTask(t, repo, "mow", nil)
+	createTask(t, repo, "call", nil)
+	(&AddTaskTagsUseCase{Repo: repo}).Execute(paint.ID, []string{"home"})
+	(&AddTaskTagsUseCase{Repo: repo}).Execute(mow.ID, []string{"garden"})
+	(&UpdateTaskStatusUseCase{Repo: repo}).Execute(mow.ID, "doing")
+	uc := &FilterTasksUseCase{Query: repo}
+
+	results, err := uc.Execute("tag:home OR status:doing")
+	if err != nil {
+		t.Fatalf("filter failed: %v", err)
+	}
+	if len(results) != 2 || results[0].ID != paint.ID || results[1].ID != mow.ID {
+		t.Fatalf("expected paint and mow in creation order, got %+v", results)
+	}
+	if results, _ := uc.Execute("NOT tag:home AND NOT tag:garden"); len(results) != 1 || results[0].Title != "call" {
+		t.Errorf("unexpected results %+v", results)
+	}
+
+	if err := (&SetTaskPriorityUseCase{Repo: repo}).Execute(mow.ID, "high"); err != nil {
+		t.Fatalf("set priority failed: %v", err)
+	}
+	if results, _ := uc.Execute("status:doing AND priority>=high"); len(results) != 1 || results[0].ID != mow.ID {
+		t.Errorf("expected mow, got %+v", results)
+	}
+
+	_, err = uc.Execute("status:doing AND priority>=soon")
+	var syntaxErr *value_objects.FilterSyntaxError
+	if !errors.As(err, &syntaxErr) || syntaxErr.Pos != 28 {
+		t.Errorf("expected a syntax error at position 28, got %v", err)
+	}
+}
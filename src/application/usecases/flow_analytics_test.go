@@ -0,0 +1,105 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// savedFlowTask stores a task created at created that moved to doing and done after the given hours.
+// A negative hour skips that transition.
+func savedFlowTask(t *testing.T, repo *repositories.InMemoryTaskRepository, title string, created time.Time, doingAfter, doneAfter int) {
+	t.Helper()
+	task, _ := entities.NewTask(title, "")
+	task.CreatedAt = created
+	if doingAfter >= 0 {
+		task.History = append(task.History, entities.StatusChange{From: value_objects.StatusTodo, To: value_objects.StatusDoing, At: created.Add(time.Duration(doingAfter) * time.Hour)})
+		task.Status = value_objects.StatusDoing
+	}
+	if doneAfter >= 0 {
+		task.History = append(task.History, entities.StatusChange{From: task.Status, To: value_objects.StatusDone, At: created.Add(time.Duration(doneAfter) * time.Hour)})
+		task.Status = value_objects.StatusDone
+	}
+	if err := repo.Save(task); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+}
+
+func TestFlowAnalytics(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	monday := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	savedFlowTask(t, repo, "a", monday, 24, 48)                  // done Wed week 1
+	savedFlowTask(t, repo, "b", monday, 48, 240)                 // done Thu week 2
+	savedFlowTask(t, repo, "c", monday, -1, 24)                  // done Tue week 1 without doing
+	savedFlowTask(t, repo, "d", monday, 24, -1)                  // still doing
+	savedFlowTask(t, repo, "e", monday.AddDate(0, 0, 8), -1, -1) // created week 2
+
+	report, err := (&GetFlowAnalyticsUseCase{Repo: repo}).Execute(dto.FlowAnalyticsRequest{From: "2026-10-05", To: "2026-10-18"})
+	if err != nil {
+		t.Fatalf("analytics failed: %v", err)
+	}
+	if report.LeadTime.Count != 3 || report.LeadTime.MedianSeconds != 48*3600 || report.LeadTime.P85Seconds != 240*3600 {
+		t.Errorf("unexpected lead time stats %+v", report.LeadTime)
+	}
+	if report.CycleTime.Count != 2 || report.CycleTime.AverageSeconds != (24+192)/2*3600 {
+		t.Errorf("unexpected cycle time stats %+v", report.CycleTime)
+	}
+	want := []dto.WeeklyThroughput{{WeekStart: "2026-10-05", Completed: 2}, {WeekStart: "2026-10-12", Completed: 1}}
+	if len(report.Throughput) != 2 || report.Throughput[0] != want[0] || report.Throughput[1] != want[1] {
+		t.Errorf("expected throughput %+v, got %+v", want, report.Throughput)
+	}
+	if len(report.CumulativeFlow) != 14 {
+		t.Fatalf("expected a point per day, got %d", len(report.CumulativeFlow))
+	}
+	first, last := report.CumulativeFlow[0].Counts, report.CumulativeFlow[13].Counts
+	if first["todo"] != 4 || first["doing"] != 0 || first["done"] != 0 {
+		t.Errorf("unexpected first day %v", first)
+	}
+	if last["todo"] != 1 || last["doing"] != 1 || last["done"] != 3 {
+		t.Errorf("unexpected last day %v", last)
+	}
+}
+
+func TestFlowAnalytics_CountsArchivedTasks(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	monday := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	savedFlowTask(t, repo, "a", monday, 24, 48)
+	savedFlowTask(t, repo, "b", monday, 24, -1)
+	uc := &GetFlowAnalyticsUseCase{Repo: repo, Archive: repo}
+	req := dto.FlowAnalyticsRequest{From: "2026-10-05", To: "2026-10-11"}
+	before, err := uc.Execute(req)
+	if err != nil {
+		t.Fatalf("analytics failed: %v", err)
+	}
+
+	archived, err := (&ArchiveDoneTasksUseCase{Repo: repo, Archive: repo}).Execute(monday.AddDate(0, 0, 3))
+	if err != nil || archived != 1 {
+		t.Fatalf("expected one task archived, got %d %v", archived, err)
+	}
+	after, err := uc.Execute(req)
+	if err != nil {
+		t.Fatalf("analytics failed: %v", err)
+	}
+	if !reflect.DeepEqual(before, after) {
+		t.Errorf("expected archiving to leave the metrics unchanged, got %+v then %+v", before, after)
+	}
+}
+
+func TestFlowAnalytics_InvalidRange(t *testing.T) {
+	uc := &GetFlowAnalyticsUseCase{Repo: repositories.NewInMemoryTaskRepository()}
+	for _, req := range []dto.FlowAnalyticsRequest{
+		{From: "2026-10-18", To: "2026-10-05"},
+		{From: "2025-01-01", To: "2026-10-05"},
+		{From: "yesterday"},
+		{Timezone: "Mars/Olympus"},
+	} {
+		if _, err := uc.Execute(req); !errors.Is(err, ErrInvalidAnalyticsRange) {
+			t.Errorf("Execute(%+v) expected ErrInvalidAnalyticsRange, got %v", req, err)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// GetArchivedTaskUseCase handles retrieving a single archived task with its status history.
+type GetArchivedTaskUseCase struct {
+	Archive   ports.TaskArchive
+	Responses *ResponseBuilder
+}
+
+// Execute retrieves the archived task identified by its string ID.
+func (uc *GetArchivedTaskUseCase) Execute(idStr string) (*dto.ArchivedTaskResponse, error) {
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	task, err := uc.Archive.FindArchivedById(parsedId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := uc.Responses.Build(task)
+	if err != nil {
+		return nil, err
+	}
+	archived := dto.ToArchivedTaskResponse(task, response)
+	return &archived, nil
+}
@@ -0,0 +1,185 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"math"
+	"sort"
+	"time"
+)
+
+// MaxAnalyticsDays bounds the range of a flow analytics report.
+const MaxAnalyticsDays = 366
+
+// ErrInvalidAnalyticsRange indicates analytics dates that are not YYYY-MM-DD, an unknown timezone,
+// or a range that is reversed or longer than MaxAnalyticsDays.
+var ErrInvalidAnalyticsRange = errors.New("invalid analytics range")
+
+// GetFlowAnalyticsUseCase computes flow metrics from the status history of tasks.
+// When ReadModel is set, the tasks are read from it and may not reflect the latest writes yet.
+// When Archive is set, archived tasks are counted too, so archiving done tasks leaves the metrics
+// unchanged.
+type GetFlowAnalyticsUseCase struct {
+	Repo      ports.TaskRepository
+	ReadModel ports.TaskListReadModel
+	Archive   ports.TaskArchive
+}
+
+// Execute computes lead time and cycle time of the tasks completed in the range, the number
+// of completions per week, and for every day the number of tasks in each status.
+func (uc *GetFlowAnalyticsUseCase) Execute(req dto.FlowAnalyticsRequest) (*dto.FlowAnalyticsResponse, error) {
+	from, to, err := analyticsRange(req)
+	if err != nil {
+		return nil, err
+	}
+	var tasks []*entities.Task
+	for _, status := range value_objects.AllStatuses() {
+		found, err := findByStatus(uc.Repo, uc.ReadModel, status)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, found...)
+	}
+	if uc.Archive != nil {
+		archived, err := uc.Archive.FindArchived()
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, archived...)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		if tasks[i].CreatedAt.Equal(tasks[j].CreatedAt) {
+			return tasks[i].ID < tasks[j].ID
+		}
+		return tasks[i].CreatedAt.Before(tasks[j].CreatedAt)
+	})
+	end := to.AddDate(0, 0, 1)
+
+	response := &dto.FlowAnalyticsResponse{
+		From:       from.Format(reportDateLayout),
+		To:         to.Format(reportDateLayout),
+		Completed:  []dto.TaskFlowResponse{},
+		Throughput: weeksBetween(from, to),
+	}
+	var leadTimes, cycleTimes []time.Duration
+	for _, task := range tasks {
+		completed := task.CompletedAt()
+		if completed == nil || completed.Before(from) || !completed.Before(end) {
+			continue
+		}
+		item := dto.TaskFlowResponse{
+			TaskID:      string(task.ID),
+			Title:       task.Title,
+			CreatedAt:   task.CreatedAt.Format(time.RFC3339),
+			CompletedAt: completed.Format(time.RFC3339),
+		}
+		if lead, ok := task.LeadTime(); ok {
+			item.LeadTimeSeconds = int64(lead / time.Second)
+			leadTimes = append(leadTimes, lead)
+		}
+		if cycle, ok := task.CycleTime(); ok {
+			item.StartedAt = task.StartedAt().Format(time.RFC3339)
+			item.CycleTimeSeconds = int64(cycle / time.Second)
+			cycleTimes = append(cycleTimes, cycle)
+		}
+		response.Completed = append(response.Completed, item)
+		weekStart := startOfWeek(completed.In(from.Location())).Format(reportDateLayout)
+		for i := range response.Throughput {
+			if response.Throughput[i].WeekStart == weekStart {
+				response.Throughput[i].Completed++
+			}
+		}
+	}
+	response.LeadTime = durationStats(leadTimes)
+	response.CycleTime = durationStats(cycleTimes)
+
+	for day := from; day.Before(end); day = day.AddDate(0, 0, 1) {
+		point := dto.CumulativeFlowPoint{Date: day.Format(reportDateLayout), Counts: make(map[string]int)}
+		for _, status := range value_objects.AllStatuses() {
+			point.Counts[status.String()] = 0
+		}
+		endOfDay := day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		for _, task := range tasks {
+			if status, ok := task.StatusAt(endOfDay); ok {
+				point.Counts[status.String()]++
+			}
+		}
+		response.CumulativeFlow = append(response.CumulativeFlow, point)
+	}
+	return response, nil
+}
+
+// analyticsRange resolves the requested dates to local midnights, applying the defaults.
+func analyticsRange(req dto.FlowAnalyticsRequest) (time.Time, time.Time, error) {
+	loc := time.UTC
+	if req.Timezone != "" {
+		loaded, err := time.LoadLocation(req.Timezone)
+		if err != nil {
+			return time.Time{}, time.Time{}, ErrInvalidAnalyticsRange
+		}
+		loc = loaded
+	}
+	now := time.Now().In(loc)
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if req.To != "" {
+		parsed, err := time.ParseInLocation(reportDateLayout, req.To, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, ErrInvalidAnalyticsRange
+		}
+		to = parsed
+	}
+	from := to.AddDate(0, 0, -27)
+	if req.From != "" {
+		parsed, err := time.ParseInLocation(reportDateLayout, req.From, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, ErrInvalidAnalyticsRange
+		}
+		from = parsed
+	}
+	if to.Before(from) || to.After(from.AddDate(0, 0, MaxAnalyticsDays-1)) {
+		return time.Time{}, time.Time{}, ErrInvalidAnalyticsRange
+	}
+	return from, to, nil
+}
+
+// startOfWeek returns the local midnight of the Monday on or before t.
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
+
+// weeksBetween returns an empty throughput bucket for every week touching [from, to].
+func weeksBetween(from, to time.Time) []dto.WeeklyThroughput {
+	var weeks []dto.WeeklyThroughput
+	for week := startOfWeek(from); !week.After(to); week = week.AddDate(0, 0, 7) {
+		weeks = append(weeks, dto.WeeklyThroughput{WeekStart: week.Format(reportDateLayout)})
+	}
+	return weeks
+}
+
+// durationStats computes the average, median and 85th percentile (nearest rank) of durations.
+func durationStats(durations []time.Duration) dto.DurationStats {
+	stats := dto.DurationStats{Count: len(durations)}
+	if len(durations) == 0 {
+		return stats
+	}
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	n := len(sorted)
+	median := sorted[n/2]
+	if n%2 == 0 {
+		median = (sorted[n/2-1] + sorted[n/2]) / 2
+	}
+	rank := int(math.Ceil(0.85*float64(n))) - 1
+	stats.AverageSeconds = int64(sum / time.Duration(n) / time.Second)
+	stats.MedianSeconds = int64(median / time.Second)
+	stats.P85Seconds = int64(sorted[rank] / time.Second)
+	return stats
+}
@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// GetNextTasksUseCase handles the "what can I work on next" query.
+// When ReadModel is set, the open tasks are read from it and may not reflect the latest writes yet.
+type GetNextTasksUseCase struct {
+	Repo      ports.TaskRepository
+	ReadModel ports.TaskListReadModel
+	Responses *ResponseBuilder
+}
+
+// Execute returns all open (todo and doing) tasks in dependency order:
+// every task comes after the tasks blocking it, and tasks that can be worked on
+// right away are flagged as ready.
+func (uc *GetNextTasksUseCase) Execute() ([]dto.NextTaskResponse, error) {
+	var open []*entities.Task
+	for _, status := range []value_objects.TaskStatus{value_objects.StatusDoing, value_objects.StatusTodo} {
+		tasks, err := findByStatus(uc.Repo, uc.ReadModel, status)
+		if err != nil {
+			return nil, err
+		}
+		open = append(open, tasks...)
+	}
+	ordered, err := entities.OrderByDependencies(open)
+	if err != nil {
+		return nil, err
+	}
+	isOpen := make(map[value_objects.TaskId]bool, len(open))
+	for _, task := range open {
+		isOpen[task.ID] = true
+	}
+	responses := make([]dto.NextTaskResponse, 0, len(ordered))
+	for _, task := range ordered {
+		response, err := uc.Responses.Build(task)
+		if err != nil {
+			return nil, err
+		}
+		ready := true
+		for _, blockerID := range task.BlockedBy {
+			if isOpen[blockerID] {
+				ready = false
+				break
+			}
+		}
+		responses = append(responses, dto.NextTaskResponse{Task: response, Ready: ready})
+	}
+	return responses, nil
+}
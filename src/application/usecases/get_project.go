@@ -0,0 +1,25 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+)
+
+// GetProjectUseCase handles retrieving a single project.
+type GetProjectUseCase struct {
+	Projects ports.ProjectRepository
+	Tasks    ports.TaskProjectIndex
+}
+
+// Execute retrieves the project identified by its string ID, including its number of tasks.
+func (uc *GetProjectUseCase) Execute(idStr string) (*dto.ProjectResponse, error) {
+	project, err := findProject(uc.Projects, idStr)
+	if err != nil {
+		return nil, err
+	}
+	response, err := projectResponse(uc.Tasks, project)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
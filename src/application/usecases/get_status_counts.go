@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// GetStatusCountsUseCase handles reporting how many tasks are in each status column.
+// When ReadModel is set, the counts are read from it and may not reflect the latest writes yet;
+// otherwise every column is read from the repository.
+type GetStatusCountsUseCase struct {
+	Repo      ports.TaskRepository
+	ReadModel ports.TaskListReadModel
+}
+
+// Execute returns the number of tasks in every status, in workflow order.
+func (uc *GetStatusCountsUseCase) Execute() ([]dto.StatusCountResponse, error) {
+	var counts map[value_objects.TaskStatus]int
+	if uc.ReadModel != nil {
+		var err error
+		if counts, err = uc.ReadModel.CountByStatus(); err != nil {
+			return nil, err
+		}
+	} else {
+		counts = make(map[value_objects.TaskStatus]int)
+		for _, status := range value_objects.AllStatuses() {
+			tasks, err := uc.Repo.FindByStatus(status)
+			if err != nil {
+				return nil, err
+			}
+			counts[status] = len(tasks)
+		}
+	}
+	responses := make([]dto.StatusCountResponse, 0, len(counts))
+	for _, status := range value_objects.AllStatuses() {
+		responses = append(responses, dto.StatusCountResponse{Status: status.String(), Count: counts[status]})
+	}
+	return responses, nil
+}
@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"sort"
+)
+
+// GetTagStatsUseCase handles reporting how often each tag is used.
+type GetTagStatsUseCase struct {
+	Repo ports.TaskTagIndex
+}
+
+// Execute returns the usage count of every tag, most used first.
+// Tags with the same count are ordered alphabetically.
+func (uc *GetTagStatsUseCase) Execute() ([]dto.TagCountResponse, error) {
+	counts, err := uc.Repo.CountTags()
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]dto.TagCountResponse, 0, len(counts))
+	for tag, count := range counts {
+		responses = append(responses, dto.TagCountResponse{Tag: tag.String(), Count: count})
+	}
+	sort.Slice(responses, func(i, j int) bool {
+		if responses[i].Count == responses[j].Count {
+			return responses[i].Tag < responses[j].Tag
+		}
+		return responses[i].Count > responses[j].Count
+	})
+	return responses, nil
+}
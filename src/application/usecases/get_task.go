@@ -0,0 +1,31 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// GetTaskUseCase handles retrieving a single task.
+type GetTaskUseCase struct {
+	Repo      ports.TaskRepository
+	Responses *ResponseBuilder
+}
+
+// Execute retrieves the task identified by its string ID.
+// Returns an error if the id is invalid or the task is not found.
+func (uc *GetTaskUseCase) Execute(idStr string) (*dto.TaskResponse, error) {
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	task, err := uc.Repo.FindById(parsedId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := uc.Responses.Build(task)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
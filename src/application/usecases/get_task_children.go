@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// GetTaskChildrenUseCase handles retrieving the direct subtasks of a task.
+type GetTaskChildrenUseCase struct {
+	Repo      ports.TaskRepository
+	Hierarchy ports.TaskHierarchy
+	Responses *ResponseBuilder
+}
+
+// Execute retrieves the subtasks of the task identified by its string ID.
+// Returns an error if the id is invalid or the parent task is not found.
+func (uc *GetTaskChildrenUseCase) Execute(idStr string) ([]dto.TaskResponse, error) {
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	if _, err := uc.Repo.FindById(parsedId); err != nil {
+		return nil, err
+	}
+	children, err := uc.Hierarchy.FindChildren(parsedId)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Responses.BuildAll(children)
+}
+
+// taskTree returns the task followed by all its descendants, breadth first.
+// Without a hierarchy only the task itself is returned.
+func taskTree(hierarchy ports.TaskHierarchy, root *entities.Task) ([]*entities.Task, error) {
+	tree := []*entities.Task{root}
+	if hierarchy == nil {
+		return tree, nil
+	}
+	descendants, err := hierarchy.FindDescendants(root.ID)
+	if err != nil {
+		return nil, err
+	}
+	return append(tree, descendants...), nil
+}
@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// GetTaskHistoryUseCase handles retrieving the status changes of a task.
+type GetTaskHistoryUseCase struct {
+	Repo ports.TaskRepository
+}
+
+// Execute returns the status history of the task identified by its string ID, oldest first.
+func (uc *GetTaskHistoryUseCase) Execute(idStr string) ([]dto.StatusChangeResponse, error) {
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	task, err := uc.Repo.FindById(parsedId)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]dto.StatusChangeResponse, 0, len(task.History))
+	for _, change := range task.History {
+		responses = append(responses, dto.ToStatusChangeResponse(change))
+	}
+	return responses, nil
+}
@@ -0,0 +1,76 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"time"
+)
+
+// ErrInvalidAsOf indicates an as-of time that is not an RFC 3339 timestamp.
+var ErrInvalidAsOf = errors.New("as_of must be an RFC 3339 timestamp")
+
+// GetTaskAsOfUseCase handles reading a task as it was at an earlier time, for audits.
+// Responses hold the fields of the task only: completion and comment counts come from subtasks and
+// comments, which are not versioned, so they are left zero.
+type GetTaskAsOfUseCase struct {
+	Timeline ports.TaskTimeline
+}
+
+// Execute returns the task identified by its string ID as it was at asOf.
+// Returns ErrNotFound from the repository if the task was not active at that time.
+func (uc *GetTaskAsOfUseCase) Execute(idStr, asOf string) (*dto.TaskResponse, error) {
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	at, err := parseAsOf(asOf)
+	if err != nil {
+		return nil, err
+	}
+	task, err := uc.Timeline.FindByIdAsOf(parsedId, at)
+	if err != nil {
+		return nil, err
+	}
+	response := dto.ToTaskResponse(task)
+	return &response, nil
+}
+
+// GetTasksByStatusAsOfUseCase handles listing a status column as it was at an earlier time.
+// Like GetTaskAsOfUseCase, responses hold the fields of the tasks only.
+type GetTasksByStatusAsOfUseCase struct {
+	Timeline ports.TaskTimeline
+}
+
+// Execute returns the tasks that had the status at asOf, ordered by their rank at that time.
+func (uc *GetTasksByStatusAsOfUseCase) Execute(statusStr, asOf string) ([]dto.TaskResponse, error) {
+	status := value_objects.TaskStatus(statusStr)
+	if !status.IsValid() {
+		return nil, entities.ErrInvalidStatus
+	}
+	at, err := parseAsOf(asOf)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := uc.Timeline.FindByStatusAsOf(status, at)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]dto.TaskResponse, 0, len(tasks))
+	for _, task := range tasks {
+		responses = append(responses, dto.ToTaskResponse(task))
+	}
+	return responses, nil
+}
+
+// parseAsOf parses an RFC 3339 timestamp. The zero time is rejected, as the timeline reads the
+// current state for it.
+func parseAsOf(asOf string) (time.Time, error) {
+	at, err := time.Parse(time.RFC3339, asOf)
+	if err != nil || at.IsZero() {
+		return time.Time{}, ErrInvalidAsOf
+	}
+	return at, nil
+}
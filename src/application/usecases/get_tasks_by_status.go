@@ -8,8 +8,11 @@ import (
 )
 
 // GetTasksByStatusUseCase handles retrieving tasks filtered by status.
+// When ReadModel is set, the column is read from it and may not reflect the latest writes yet.
 type GetTasksByStatusUseCase struct {
-	Repo ports.TaskRepository
+	Repo      ports.TaskRepository
+	ReadModel ports.TaskListReadModel
+	Responses *ResponseBuilder
 }
 
 // Execute retrieves all tasks with the specified status.
@@ -19,13 +22,17 @@ func (uc *GetTasksByStatusUseCase) Execute(statusStr string) ([]dto.TaskResponse
 	if !status.IsValid() {
 		return nil, entities.ErrInvalidStatus
 	}
-	tasks, err := uc.Repo.FindByStatus(status)
+	tasks, err := findByStatus(uc.Repo, uc.ReadModel, status)
 	if err != nil {
 		return nil, err
 	}
-	var responses []dto.TaskResponse
-	for _, task := range tasks {
-		responses = append(responses, dto.ToTaskResponse(task))
+	return uc.Responses.BuildAll(tasks)
+}
+
+// findByStatus reads a status column from the read model when one is set, and from the repository otherwise.
+func findByStatus(repo ports.TaskRepository, readModel ports.TaskListReadModel, status value_objects.TaskStatus) ([]*entities.Task, error) {
+	if readModel != nil {
+		return readModel.ListByStatus(status)
 	}
-	return responses, nil
+	return repo.FindByStatus(status)
 }
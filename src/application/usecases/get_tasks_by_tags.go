@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// GetTasksByTagsUseCase handles retrieving tasks filtered by tags.
+type GetTasksByTagsUseCase struct {
+	Repo      ports.TaskTagIndex
+	Responses *ResponseBuilder
+}
+
+// Execute retrieves tasks carrying all (matchAll) or any of the given tags.
+// When statusStr is not empty, only tasks with that status are returned.
+func (uc *GetTasksByTagsUseCase) Execute(rawTags []string, matchAll bool, statusStr string) ([]dto.TaskResponse, error) {
+	tags := make([]value_objects.Tag, 0, len(rawTags))
+	for _, raw := range rawTags {
+		tag, err := value_objects.NewTag(raw)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	status := value_objects.TaskStatus(statusStr)
+	if statusStr != "" && !status.IsValid() {
+		return nil, entities.ErrInvalidStatus
+	}
+	tasks, err := uc.Repo.FindByTags(tags, matchAll)
+	if err != nil {
+		return nil, err
+	}
+	var matching []*entities.Task
+	for _, task := range tasks {
+		if statusStr == "" || task.Status == status {
+			matching = append(matching, task)
+		}
+	}
+	return uc.Responses.BuildAll(matching)
+}
@@ -0,0 +1,110 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"sort"
+	"time"
+)
+
+// ErrInvalidReportRange indicates report dates that are not YYYY-MM-DD, an unknown timezone,
+// or a range ending before it starts.
+var ErrInvalidReportRange = errors.New("invalid report range")
+
+// reportDateLayout is the layout of report dates.
+const reportDateLayout = "2006-01-02"
+
+// GetTimeReportUseCase handles summing tracked time per task and per day.
+type GetTimeReportUseCase struct {
+	Repo    ports.TaskRepository
+	WorkLog ports.WorkLogRepository
+}
+
+// Execute sums the logged time matching the request.
+// Days are calendar days in the requested timezone; an entry crossing midnight is split
+// between both days, and running timers count until now.
+func (uc *GetTimeReportUseCase) Execute(req dto.TimeReportRequest) (*dto.TimeReportResponse, error) {
+	loc := time.UTC
+	if req.Timezone != "" {
+		loaded, err := time.LoadLocation(req.Timezone)
+		if err != nil {
+			return nil, ErrInvalidReportRange
+		}
+		loc = loaded
+	}
+	var from, to time.Time
+	if req.From != "" {
+		parsed, err := time.ParseInLocation(reportDateLayout, req.From, loc)
+		if err != nil {
+			return nil, ErrInvalidReportRange
+		}
+		from = parsed
+	}
+	if req.To != "" {
+		parsed, err := time.ParseInLocation(reportDateLayout, req.To, loc)
+		if err != nil {
+			return nil, ErrInvalidReportRange
+		}
+		to = parsed.AddDate(0, 0, 1)
+		if !from.IsZero() && !to.After(from) {
+			return nil, ErrInvalidReportRange
+		}
+	}
+
+	entries, err := uc.WorkLog.FindByUser(req.User)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	byTask := make(map[string]int64)
+	byDay := make(map[string]int64)
+	var total int64
+	for _, entry := range entries {
+		start, end := entry.Interval(now)
+		if !from.IsZero() && start.Before(from) {
+			start = from
+		}
+		if !to.IsZero() && end.After(to) {
+			end = to
+		}
+		for start.Before(end) {
+			day := start.In(loc)
+			nextDay := time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, loc)
+			chunkEnd := end
+			if nextDay.Before(chunkEnd) {
+				chunkEnd = nextDay
+			}
+			seconds := int64(chunkEnd.Sub(start) / time.Second)
+			byDay[day.Format(reportDateLayout)] += seconds
+			byTask[string(entry.TaskID)] += seconds
+			total += seconds
+			start = chunkEnd
+		}
+	}
+
+	report := &dto.TimeReportResponse{
+		TotalSeconds: total,
+		ByTask:       make([]dto.TaskTimeResponse, 0, len(byTask)),
+		ByDay:        make([]dto.DayTimeResponse, 0, len(byDay)),
+	}
+	for taskID, seconds := range byTask {
+		item := dto.TaskTimeResponse{TaskID: taskID, Seconds: seconds}
+		if task, err := uc.Repo.FindById(value_objects.TaskId(taskID)); err == nil {
+			item.Title = task.Title
+		}
+		report.ByTask = append(report.ByTask, item)
+	}
+	sort.Slice(report.ByTask, func(i, j int) bool {
+		if report.ByTask[i].Seconds != report.ByTask[j].Seconds {
+			return report.ByTask[i].Seconds > report.ByTask[j].Seconds
+		}
+		return report.ByTask[i].TaskID < report.ByTask[j].TaskID
+	})
+	for date, seconds := range byDay {
+		report.ByDay = append(report.ByDay, dto.DayTimeResponse{Date: date, Seconds: seconds})
+	}
+	sort.Slice(report.ByDay, func(i, j int) bool { return report.ByDay[i].Date < report.ByDay[j].Date })
+	return report, nil
+}
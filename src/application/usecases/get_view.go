@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+)
+
+// GetViewUseCase handles retrieving a single saved view.
+type GetViewUseCase struct {
+	Views ports.SavedViewRepository
+}
+
+// Execute retrieves the view of the owner with the given name.
+func (uc *GetViewUseCase) Execute(owner, name string) (dto.SavedViewResponse, error) {
+	view, err := findView(uc.Views, owner, name)
+	if err != nil {
+		return dto.SavedViewResponse{}, err
+	}
+	return dto.ToSavedViewResponse(view), nil
+}
@@ -0,0 +1,21 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+)
+
+// ListArchivedUseCase handles retrieving the archived tasks.
+type ListArchivedUseCase struct {
+	Archive   ports.TaskArchive
+	Responses *ResponseBuilder
+}
+
+// Execute returns the archived tasks, most recently archived first.
+func (uc *ListArchivedUseCase) Execute() ([]dto.TaskResponse, error) {
+	tasks, err := uc.Archive.FindArchived()
+	if err != nil {
+		return nil, err
+	}
+	return uc.Responses.BuildAll(tasks)
+}
@@ -0,0 +1,25 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// ListAttachmentsUseCase handles retrieving the files attached to a task.
+type ListAttachmentsUseCase struct {
+	Repo ports.TaskRepository
+}
+
+// Execute returns the attachments of the task identified by its string ID, in upload order.
+func (uc *ListAttachmentsUseCase) Execute(taskIdStr string) ([]dto.AttachmentResponse, error) {
+	taskId, err := value_objects.ParseTaskId(taskIdStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	task, err := uc.Repo.FindById(taskId)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ToTaskResponse(task).Attachments, nil
+}
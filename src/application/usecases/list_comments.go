@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// ListCommentsUseCase handles retrieving the comment thread of a task.
+type ListCommentsUseCase struct {
+	Repo     ports.TaskRepository
+	Comments ports.CommentRepository
+}
+
+// Execute returns the comments of the task identified by its string ID, oldest first.
+func (uc *ListCommentsUseCase) Execute(taskIdStr string) ([]dto.CommentResponse, error) {
+	taskId, err := value_objects.ParseTaskId(taskIdStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	if _, err := uc.Repo.FindById(taskId); err != nil {
+		return nil, err
+	}
+	comments, err := uc.Comments.FindByTask(taskId)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]dto.CommentResponse, 0, len(comments))
+	for _, comment := range comments {
+		responses = append(responses, dto.ToCommentResponse(comment))
+	}
+	return responses, nil
+}
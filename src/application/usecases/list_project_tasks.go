@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"sort"
+)
+
+// ListProjectTasksUseCase handles retrieving the tasks of a project.
+type ListProjectTasksUseCase struct {
+	Projects  ports.ProjectRepository
+	Tasks     ports.TaskProjectIndex
+	Responses *ResponseBuilder
+}
+
+// Execute returns the tasks of the project in creation order. When statusStr is given only
+// the tasks with that status are returned, in the rank order of their column.
+func (uc *ListProjectTasksUseCase) Execute(projectIdStr, statusStr string) ([]dto.TaskResponse, error) {
+	project, err := findProject(uc.Projects, projectIdStr)
+	if err != nil {
+		return nil, err
+	}
+	status := value_objects.TaskStatus(statusStr)
+	if statusStr != "" && !status.IsValid() {
+		return nil, entities.ErrInvalidStatus
+	}
+	tasks, err := uc.Tasks.FindByProject(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	if statusStr != "" {
+		filtered := tasks[:0]
+		for _, task := range tasks {
+			if task.Status == status {
+				filtered = append(filtered, task)
+			}
+		}
+		tasks = filtered
+		sort.SliceStable(tasks, func(i, j int) bool { return tasks[i].Rank < tasks[j].Rank })
+	}
+	return uc.Responses.BuildAll(tasks)
+}
@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+)
+
+// ListProjectsUseCase handles retrieving every project.
+type ListProjectsUseCase struct {
+	Projects ports.ProjectRepository
+	Tasks    ports.TaskProjectIndex
+}
+
+// Execute returns the projects ordered by name.
+func (uc *ListProjectsUseCase) Execute() ([]dto.ProjectResponse, error) {
+	projects, err := uc.Projects.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]dto.ProjectResponse, 0, len(projects))
+	for _, project := range projects {
+		response, err := projectResponse(uc.Tasks, project)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
@@ -0,0 +1,21 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+)
+
+// ListTrashUseCase handles retrieving the tasks in the trash.
+type ListTrashUseCase struct {
+	Trash     ports.TaskTrash
+	Responses *ResponseBuilder
+}
+
+// Execute returns the trashed tasks, most recently deleted first.
+func (uc *ListTrashUseCase) Execute() ([]dto.TaskResponse, error) {
+	tasks, err := uc.Trash.FindTrashed()
+	if err != nil {
+		return nil, err
+	}
+	return uc.Responses.BuildAll(tasks)
+}
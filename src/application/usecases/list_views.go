@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"strings"
+)
+
+// ListViewsUseCase handles listing the saved views of a user.
+type ListViewsUseCase struct {
+	Views ports.SavedViewRepository
+}
+
+// Execute returns the views of the owner ordered by name.
+func (uc *ListViewsUseCase) Execute(owner string) ([]dto.SavedViewResponse, error) {
+	if strings.TrimSpace(owner) == "" {
+		return nil, entities.ErrMissingViewOwner
+	}
+	views, err := uc.Views.FindByOwner(owner)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]dto.SavedViewResponse, len(views))
+	for i, view := range views {
+		responses[i] = dto.ToSavedViewResponse(view)
+	}
+	return responses, nil
+}
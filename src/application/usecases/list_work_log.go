@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+	"time"
+)
+
+// ListWorkLogUseCase handles retrieving the work logged on a task.
+type ListWorkLogUseCase struct {
+	Repo    ports.TaskRepository
+	WorkLog ports.WorkLogRepository
+}
+
+// Execute returns the work entries of the task identified by its string ID, ordered by start time.
+func (uc *ListWorkLogUseCase) Execute(taskIdStr string) ([]dto.WorkEntryResponse, error) {
+	taskId, err := value_objects.ParseTaskId(taskIdStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	if _, err := uc.Repo.FindById(taskId); err != nil {
+		return nil, err
+	}
+	entries, err := uc.WorkLog.FindByTask(taskId)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	responses := make([]dto.WorkEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		responses = append(responses, dto.ToWorkEntryResponse(entry, now))
+	}
+	return responses, nil
+}
@@ -0,0 +1,71 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"time"
+)
+
+// ErrInvalidWorkTime indicates a work log timestamp that is not RFC 3339.
+var ErrInvalidWorkTime = errors.New("invalid work time, expected RFC 3339")
+
+// LogWorkUseCase handles logging work on a task after the fact.
+// When UnitOfWork is set, the task is checked and the entry saved in a unit of work.
+type LogWorkUseCase struct {
+	Repo       ports.TaskRepository
+	WorkLog    ports.WorkLogRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute records a finished work entry.
+// Returns entities.ErrOverlappingWork if the user already logged time in that interval.
+func (uc *LogWorkUseCase) Execute(req dto.LogWorkRequest) (*dto.WorkEntryResponse, error) {
+	if uc.UnitOfWork != nil {
+		var response *dto.WorkEntryResponse
+		err := uc.UnitOfWork.Do(func(stores ports.Stores) error {
+			var err error
+			response, err = uc.within(stores).Execute(req)
+			return err
+		})
+		return response, err
+	}
+	taskId, err := value_objects.ParseTaskId(req.TaskID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	start, err := time.Parse(time.RFC3339, req.Start)
+	if err != nil {
+		return nil, ErrInvalidWorkTime
+	}
+	end, err := time.Parse(time.RFC3339, req.End)
+	if err != nil {
+		return nil, ErrInvalidWorkTime
+	}
+	if _, err := uc.Repo.FindById(taskId); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	entry, err := entities.NewWorkEntry(taskId, req.User, start, end, req.Note, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.WorkLog.Save(entry); err != nil {
+		return nil, err
+	}
+	response := dto.ToWorkEntryResponse(entry, now)
+	return &response, nil
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *LogWorkUseCase) within(stores ports.Stores) *LogWorkUseCase {
+	log := *uc
+	log.UnitOfWork = nil
+	log.Repo = stores.Tasks
+	if stores.WorkLog != nil {
+		log.WorkLog = stores.WorkLog
+	}
+	return &log
+}
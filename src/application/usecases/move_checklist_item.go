@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+)
+
+// MoveChecklistItemUseCase handles reordering the checklist of a task.
+// When UnitOfWork is set, the change can be undone.
+type MoveChecklistItemUseCase struct {
+	Repo       ports.TaskRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute moves the item to the given zero-based position and returns the reordered checklist.
+func (uc *MoveChecklistItemUseCase) Execute(taskIdStr, itemIdStr string, position int) ([]dto.ChecklistItemResponse, error) {
+	responses, _, err := uc.ExecuteUndoable(taskIdStr, itemIdStr, position)
+	return responses, err
+}
+
+// ExecuteUndoable moves the item like Execute and also returns a token for undoing the change, which
+// is empty unless UnitOfWork can record it.
+func (uc *MoveChecklistItemUseCase) ExecuteUndoable(taskIdStr, itemIdStr string, position int) ([]dto.ChecklistItemResponse, string, error) {
+	if uc.UnitOfWork != nil {
+		var responses []dto.ChecklistItemResponse
+		token, err := runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			var err error
+			responses, err = uc.within(stores).Execute(taskIdStr, itemIdStr, position)
+			return err
+		})
+		return responses, token, err
+	}
+	task, item, err := findTaskChecklistItem(uc.Repo, taskIdStr, itemIdStr)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := task.MoveChecklistItem(item.ID, position); err != nil {
+		return nil, "", err
+	}
+	if err := uc.Repo.Save(task); err != nil {
+		return nil, "", err
+	}
+	return dto.ToChecklistResponse(task.Checklist), "", nil
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *MoveChecklistItemUseCase) within(stores ports.Stores) *MoveChecklistItemUseCase {
+	move := *uc
+	move.UnitOfWork = nil
+	move.Repo = stores.Tasks
+	return &move
+}
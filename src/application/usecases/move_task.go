@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// MoveTaskUseCase handles reordering a task within its column or moving it to another column.
+// Status changes follow the same rules and side effects as UpdateTaskStatusUseCase.
+// When UnitOfWork is set, the moved task, its renumbered neighbours and any timers are saved atomically.
+type MoveTaskUseCase struct {
+	Repo       ports.TaskRepository
+	Status     *UpdateTaskStatusUseCase
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute changes the status of the task if requested and places it between the given neighbours.
+// Returns ErrInvalidPosition if the neighbours are not adjacent tasks of the target column.
+func (uc *MoveTaskUseCase) Execute(req dto.MoveTaskRequest) error {
+	_, err := uc.ExecuteUndoable(req)
+	return err
+}
+
+// ExecuteUndoable moves the task like Execute and returns a token for undoing the move, which is empty
+// unless UnitOfWork can record it.
+func (uc *MoveTaskUseCase) ExecuteUndoable(req dto.MoveTaskRequest) (string, error) {
+	if uc.UnitOfWork != nil {
+		return runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			return uc.within(stores).Execute(req)
+		})
+	}
+	return "", uc.move(req)
+}
+
+// move changes the status of the task if requested and places it between the given neighbours.
+func (uc *MoveTaskUseCase) move(req dto.MoveTaskRequest) error {
+	statusReq := dto.UpdateStatusRequest{TaskID: req.TaskID, NewStatus: req.Status, Actor: req.Actor, StartTimer: req.StartTimer}
+	var (
+		task     *entities.Task
+		previous value_objects.TaskStatus
+		next     *entities.Task
+		err      error
+	)
+	if req.Status == "" {
+		task, err = uc.find(req.TaskID)
+		if err == nil {
+			previous = task.Status
+		}
+	} else {
+		task, previous, next, err = uc.Status.transition(statusReq)
+	}
+	if err != nil {
+		return err
+	}
+	renumbered, err := placeInColumn(uc.Repo, task, req.AfterID, req.BeforeID)
+	if err != nil {
+		return err
+	}
+	return uc.Status.commit(task, previous, next, renumbered, statusReq)
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *MoveTaskUseCase) within(stores ports.Stores) *MoveTaskUseCase {
+	move := *uc
+	move.UnitOfWork = nil
+	move.Repo = stores.Tasks
+	move.Status = uc.Status.within(stores)
+	return &move
+}
+
+func (uc *MoveTaskUseCase) find(idStr string) (*entities.Task, error) {
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	return uc.Repo.FindById(parsedId)
+}
@@ -0,0 +1,80 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// MoveTaskToProjectUseCase handles moving a top-level task, with its subtasks, to another project.
+// The default tags of the target project are added to the moved tasks.
+// When UnitOfWork is set, the change can be undone.
+type MoveTaskToProjectUseCase struct {
+	Repo       ports.TaskRepository
+	Hierarchy  ports.TaskHierarchy
+	Projects   ports.ProjectRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute moves the task identified by taskIdStr to the project projectIdStr;
+// an empty project ID takes the task out of its project.
+// Returns ErrSubtaskProject for subtasks, which always follow their parent.
+func (uc *MoveTaskToProjectUseCase) Execute(taskIdStr, projectIdStr string) error {
+	_, err := uc.ExecuteUndoable(taskIdStr, projectIdStr)
+	return err
+}
+
+// ExecuteUndoable moves the task like Execute and returns a token for undoing the change, which is
+// empty unless UnitOfWork can record it.
+func (uc *MoveTaskToProjectUseCase) ExecuteUndoable(taskIdStr, projectIdStr string) (string, error) {
+	if uc.UnitOfWork != nil {
+		return runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			return uc.within(stores).Execute(taskIdStr, projectIdStr)
+		})
+	}
+	return "", uc.move(taskIdStr, projectIdStr)
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *MoveTaskToProjectUseCase) within(stores ports.Stores) *MoveTaskToProjectUseCase {
+	move := *uc
+	move.UnitOfWork = nil
+	move.Repo = stores.Tasks
+	if move.Hierarchy != nil {
+		move.Hierarchy = stores.Tasks
+	}
+	return &move
+}
+
+func (uc *MoveTaskToProjectUseCase) move(taskIdStr, projectIdStr string) error {
+	taskId, err := value_objects.ParseTaskId(taskIdStr)
+	if err != nil {
+		return ErrInvalidID
+	}
+	task, err := uc.Repo.FindById(taskId)
+	if err != nil {
+		return err
+	}
+	if task.IsSubtask() {
+		return entities.ErrSubtaskProject
+	}
+	var project *entities.Project
+	if projectIdStr != "" {
+		if project, err = findProject(uc.Projects, projectIdStr); err != nil {
+			return err
+		}
+	}
+	tree, err := taskTree(uc.Hierarchy, task)
+	if err != nil {
+		return err
+	}
+	for _, member := range tree {
+		member.MoveToProject(project)
+		if project != nil {
+			if err := project.ApplyDefaults(member); err != nil {
+				return err
+			}
+		}
+	}
+	return saveAll(uc.Repo, tree...)
+}
@@ -0,0 +1,28 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"io"
+)
+
+// OpenAttachmentUseCase handles downloading a file attached to a task.
+type OpenAttachmentUseCase struct {
+	Repo  ports.TaskRepository
+	Blobs ports.BlobStore
+}
+
+// Execute returns the metadata and content of an attachment.
+// The caller must close the returned reader.
+func (uc *OpenAttachmentUseCase) Execute(taskIdStr string, attachmentIdStr string) (*dto.AttachmentResponse, io.ReadSeekCloser, error) {
+	_, attachment, err := findTaskAttachment(uc.Repo, taskIdStr, attachmentIdStr)
+	if err != nil {
+		return nil, nil, err
+	}
+	content, err := uc.Blobs.Open(attachment.Digest)
+	if err != nil {
+		return nil, nil, err
+	}
+	response := dto.ToAttachmentResponse(*attachment)
+	return &response, content, nil
+}
@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// findProject parses a project ID and loads the project.
+func findProject(projects ports.ProjectRepository, idStr string) (*entities.Project, error) {
+	parsedId, err := value_objects.ParseProjectId(idStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	return projects.FindById(parsedId)
+}
+
+// projectResponse converts a project, counting its tasks when the index is set.
+func projectResponse(tasks ports.TaskProjectIndex, project *entities.Project) (dto.ProjectResponse, error) {
+	count := 0
+	if tasks != nil {
+		var err error
+		if count, err = tasks.CountByProject(project.ID); err != nil {
+			return dto.ProjectResponse{}, err
+		}
+	}
+	return dto.ToProjectResponse(project, count), nil
+}
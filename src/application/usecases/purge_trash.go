@@ -0,0 +1,43 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"time"
+)
+
+// DefaultTrashRetention is how long deleted tasks stay in the trash unless configured otherwise.
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+// PurgeTrashUseCase permanently deletes the tasks that have been in the trash for longer than Retention,
+// together with their comments, work log and unreferenced attachment blobs (see DeleteTaskUseCase).
+// The data is deleted before the task, so a failed purge leaves the task in the trash to be purged again.
+type PurgeTrashUseCase struct {
+	Trash       ports.TaskTrash
+	Comments    ports.CommentRepository
+	WorkLog     ports.WorkLogRepository
+	Blobs       ports.BlobStore
+	Attachments ports.TaskAttachmentIndex
+	Retention   time.Duration
+}
+
+// Execute purges the expired tasks as of now and returns how many were deleted.
+func (uc *PurgeTrashUseCase) Execute(now time.Time) (int, error) {
+	tasks, err := uc.Trash.FindTrashed()
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	for _, task := range tasks {
+		if !task.TrashExpired(uc.Retention, now) {
+			continue
+		}
+		if err := deleteTaskData(uc.Comments, uc.WorkLog, uc.Blobs, uc.Attachments, task.ID, task); err != nil {
+			return purged, err
+		}
+		if err := uc.Trash.Purge(task.ID); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
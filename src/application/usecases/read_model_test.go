@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"testing"
+
+	"clean-architecture-golang/infrastructure/repositories"
+)
+
+func TestQueriesThroughReadModel_LagUntilCaughtUp(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	readModel := repositories.NewInMemoryTaskListProjection(repo)
+	list := &GetTasksByStatusUseCase{Repo: repo, ReadModel: readModel, Responses: &ResponseBuilder{}}
+	counts := &GetStatusCountsUseCase{Repo: repo, ReadModel: readModel}
+	direct := &GetStatusCountsUseCase{Repo: repo}
+	task := createTask(t, repo, "paint", nil)
+
+	if todo, _ := list.Execute("todo"); len(todo) != 0 {
+		t.Errorf("expected the read model not to show the task yet, got %v", todo)
+	}
+	if result, _ := direct.Execute(); result[0].Status != "todo" || result[0].Count != 1 {
+		t.Errorf("expected the repository to count the task right away, got %v", result)
+	}
+
+	readModel.CatchUp()
+	if todo, _ := list.Execute("todo"); len(todo) != 1 || todo[0].Title != "paint" {
+		t.Errorf("expected the task once caught up, got %v", todo)
+	}
+	result, err := counts.Execute()
+	if err != nil || len(result) != 3 || result[0].Count != 1 || result[1].Count != 0 || result[2].Status != "done" {
+		t.Errorf("expected counts for every status in workflow order, got %v %v", result, err)
+	}
+
+	if err := (&DeleteTaskUseCase{Repo: repo}).Execute(task.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if err := (&RebuildReadModelUseCase{ReadModel: readModel}).Execute(); err != nil {
+		t.Fatalf("rebuild failed: %v", err)
+	}
+	if todo, _ := list.Execute("todo"); len(todo) != 0 {
+		t.Errorf("expected the rebuilt read model to drop the deleted task, got %v", todo)
+	}
+}
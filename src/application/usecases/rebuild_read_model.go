@@ -0,0 +1,14 @@
+package usecases
+
+import "clean-architecture-golang/application/ports"
+
+// RebuildReadModelUseCase handles rebuilding the task list read model from the current tasks,
+// for instance after changing how it is projected.
+type RebuildReadModelUseCase struct {
+	ReadModel ports.TaskListReadModel
+}
+
+// Execute discards the read model and builds it again.
+func (uc *RebuildReadModelUseCase) Execute() error {
+	return uc.ReadModel.Rebuild()
+}
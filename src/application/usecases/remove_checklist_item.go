@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+)
+
+// RemoveChecklistItemUseCase handles removing an item from the checklist of a task.
+// When UnitOfWork is set, the change can be undone.
+type RemoveChecklistItemUseCase struct {
+	Repo       ports.TaskRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute removes the item from the checklist.
+func (uc *RemoveChecklistItemUseCase) Execute(taskIdStr, itemIdStr string) error {
+	_, err := uc.ExecuteUndoable(taskIdStr, itemIdStr)
+	return err
+}
+
+// ExecuteUndoable removes the item like Execute and returns a token for undoing the change, which is
+// empty unless UnitOfWork can record it.
+func (uc *RemoveChecklistItemUseCase) ExecuteUndoable(taskIdStr, itemIdStr string) (string, error) {
+	if uc.UnitOfWork != nil {
+		return runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			return uc.within(stores).Execute(taskIdStr, itemIdStr)
+		})
+	}
+	task, item, err := findTaskChecklistItem(uc.Repo, taskIdStr, itemIdStr)
+	if err != nil {
+		return "", err
+	}
+	task.RemoveChecklistItem(item.ID)
+	return "", uc.Repo.Save(task)
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *RemoveChecklistItemUseCase) within(stores ports.Stores) *RemoveChecklistItemUseCase {
+	remove := *uc
+	remove.UnitOfWork = nil
+	remove.Repo = stores.Tasks
+	return &remove
+}
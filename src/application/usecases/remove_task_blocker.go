@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// RemoveTaskBlockerUseCase handles removing a dependency between two tasks.
+// When UnitOfWork is set, the change can be undone.
+type RemoveTaskBlockerUseCase struct {
+	Repo       ports.TaskRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute removes blockerIdStr from the blockers of the task identified by idStr.
+// Removing a dependency that does not exist succeeds without changes.
+func (uc *RemoveTaskBlockerUseCase) Execute(idStr string, blockerIdStr string) error {
+	_, err := uc.ExecuteUndoable(idStr, blockerIdStr)
+	return err
+}
+
+// ExecuteUndoable removes the blocker like Execute and returns a token for undoing the change, which
+// is empty unless UnitOfWork can record it.
+func (uc *RemoveTaskBlockerUseCase) ExecuteUndoable(idStr string, blockerIdStr string) (string, error) {
+	if uc.UnitOfWork != nil {
+		return runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			return uc.within(stores).Execute(idStr, blockerIdStr)
+		})
+	}
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return "", ErrInvalidID
+	}
+	blockerId, err := value_objects.ParseTaskId(blockerIdStr)
+	if err != nil {
+		return "", ErrInvalidID
+	}
+	task, err := uc.Repo.FindById(parsedId)
+	if err != nil {
+		return "", err
+	}
+	task.RemoveBlocker(blockerId)
+	return "", uc.Repo.Save(task)
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *RemoveTaskBlockerUseCase) within(stores ports.Stores) *RemoveTaskBlockerUseCase {
+	remove := *uc
+	remove.UnitOfWork = nil
+	remove.Repo = stores.Tasks
+	return &remove
+}
@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// RemoveTaskTagUseCase handles detaching a tag from an existing task.
+// When UnitOfWork is set, the change can be undone.
+type RemoveTaskTagUseCase struct {
+	Repo       ports.TaskRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute removes the tag from the task identified by its string ID.
+// Removing a tag the task does not carry succeeds without changes.
+func (uc *RemoveTaskTagUseCase) Execute(idStr string, tag string) error {
+	_, err := uc.ExecuteUndoable(idStr, tag)
+	return err
+}
+
+// ExecuteUndoable removes the tag like Execute and returns a token for undoing the change,
+// which is empty unless UnitOfWork can record it.
+func (uc *RemoveTaskTagUseCase) ExecuteUndoable(idStr string, tag string) (string, error) {
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return "", ErrInvalidID
+	}
+	if uc.UnitOfWork != nil {
+		return runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			return removeTag(stores.Tasks, parsedId, tag)
+		})
+	}
+	return "", removeTag(uc.Repo, parsedId, tag)
+}
+
+func removeTag(repo ports.TaskRepository, id value_objects.TaskId, tag string) error {
+	task, err := repo.FindById(id)
+	if err != nil {
+		return err
+	}
+	if err := task.RemoveTag(tag); err != nil {
+		return err
+	}
+	return repo.Save(task)
+}
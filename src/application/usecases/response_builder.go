@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// ResponseBuilder converts domain tasks into TaskResponse DTOs and fills in
+// fields derived from related data, such as the subtask completion rollup and
+// the number of comments. Fields whose port is not set keep the values of
+// dto.ToTaskResponse; a nil ResponseBuilder falls back to it entirely.
+type ResponseBuilder struct {
+	Hierarchy ports.TaskHierarchy
+	Comments  ports.CommentRepository
+}
+
+// Build converts a single task.
+func (b *ResponseBuilder) Build(task *entities.Task) (dto.TaskResponse, error) {
+	response := dto.ToTaskResponse(task)
+	if b == nil {
+		return response, nil
+	}
+	if b.Hierarchy != nil {
+		percent, err := b.completion(task)
+		if err != nil {
+			return dto.TaskResponse{}, err
+		}
+		response.CompletionPercent = percent
+	}
+	if b.Comments != nil {
+		count, err := b.Comments.CountByTask(task.ID)
+		if err != nil {
+			return dto.TaskResponse{}, err
+		}
+		response.CommentCount = count
+	}
+	return response, nil
+}
+
+// BuildAll converts a list of tasks, preserving their order.
+func (b *ResponseBuilder) BuildAll(tasks []*entities.Task) ([]dto.TaskResponse, error) {
+	var responses []dto.TaskResponse
+	for _, task := range tasks {
+		response, err := b.Build(task)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+// completion computes the rolled-up completion of a task from its whole subtree, read in one query.
+func (b *ResponseBuilder) completion(task *entities.Task) (int, error) {
+	descendants, err := b.Hierarchy.FindDescendants(task.ID)
+	if err != nil {
+		return 0, err
+	}
+	children := make(map[value_objects.TaskId][]*entities.Task)
+	for _, descendant := range descendants {
+		children[descendant.ParentID] = append(children[descendant.ParentID], descendant)
+	}
+	return rollUp(task, children), nil
+}
+
+// rollUp computes the completion of a task from the children of every task of its subtree.
+func rollUp(task *entities.Task, children map[value_objects.TaskId][]*entities.Task) int {
+	percents := make([]int, 0, len(children[task.ID]))
+	for _, child := range children[task.ID] {
+		percents = append(percents, rollUp(child, children))
+	}
+	return task.CompletionPercent(percents)
+}
@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+)
+
+// RestoreTaskUseCase handles taking a task out of the trash.
+// Subtasks and dependents were detached when the task was trashed and stay detached;
+// references of the restored task to tasks that no longer exist are dropped, and an open task
+// whose parent was completed or moved to another project meanwhile is restored as a top-level task.
+// When Projects is set, the task also leaves a project that no longer exists.
+// A task cannot be restored into a status column that already holds the number of tasks allowed by Limits.
+// When UnitOfWork is set, the task and its renumbered column are saved atomically, so the limits
+// cannot be exceeded by a concurrent move.
+type RestoreTaskUseCase struct {
+	Repo       ports.TaskRepository
+	Trash      ports.TaskTrash
+	Projects   ports.ProjectRepository
+	Limits     entities.WIPLimits
+	Responses  *ResponseBuilder
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute restores a trashed task by its string ID and places it at the bottom of its status column.
+// Returns an error if the task is not in the trash.
+func (uc *RestoreTaskUseCase) Execute(idStr string) (dto.TaskResponse, error) {
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return dto.TaskResponse{}, ErrInvalidID
+	}
+	var task *entities.Task
+	if uc.UnitOfWork != nil {
+		err = uc.UnitOfWork.Do(func(stores ports.Stores) error {
+			task, err = uc.within(stores).restore(parsedId)
+			return err
+		})
+	} else {
+		task, err = uc.restore(parsedId)
+	}
+	if err != nil {
+		return dto.TaskResponse{}, err
+	}
+	return uc.Responses.Build(task)
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *RestoreTaskUseCase) within(stores ports.Stores) *RestoreTaskUseCase {
+	restore := *uc
+	restore.UnitOfWork = nil
+	restore.Repo = stores.Tasks
+	restore.Trash = stores.Tasks
+	return &restore
+}
+
+func (uc *RestoreTaskUseCase) restore(id value_objects.TaskId) (*entities.Task, error) {
+	task, err := uc.Trash.FindTrashedById(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.dropMissingReferences(task); err != nil {
+		return nil, err
+	}
+	task.Restore()
+	if len(uc.Limits) > 0 {
+		column, err := uc.Repo.FindByStatus(task.Status)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.Limits.Check(task, column); err != nil {
+			return nil, err
+		}
+	}
+	renumbered, err := placeInColumn(uc.Repo, task, "", "")
+	if err != nil {
+		return nil, err
+	}
+	if err := saveAll(uc.Repo, renumbered...); err != nil {
+		return nil, err
+	}
+	if err := uc.Trash.Restore(task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// dropMissingReferences clears the references of the task that do not hold anymore: its project if
+// that was deleted, its parent if that cannot be found among the active tasks or no longer accepts
+// the task as a subtask, and its blockers that cannot be found among the active tasks.
+func (uc *RestoreTaskUseCase) dropMissingReferences(task *entities.Task) error {
+	if uc.Projects != nil && task.ProjectID != "" {
+		_, err := uc.Projects.FindById(task.ProjectID)
+		if errors.Is(err, ports.ErrProjectNotFound) {
+			task.MoveToProject(nil)
+		} else if err != nil {
+			return err
+		}
+	}
+	if task.ParentID != "" {
+		parent, err := uc.Repo.FindById(task.ParentID)
+		if err != nil && !errors.Is(err, ports.ErrTaskNotFound) {
+			return err
+		}
+		// SetParent refuses an open task below a completed parent.
+		if err != nil || parent.ProjectID != task.ProjectID || task.SetParent(parent, nil) != nil {
+			task.SetParent(nil, nil)
+		}
+	}
+	for _, blockerID := range append([]value_objects.TaskId(nil), task.BlockedBy...) {
+		_, err := uc.Repo.FindById(blockerID)
+		if errors.Is(err, ports.ErrTaskNotFound) {
+			task.RemoveBlocker(blockerID)
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+)
+
+// RunViewUseCase handles listing the tasks of a saved view.
+type RunViewUseCase struct {
+	Views     ports.SavedViewRepository
+	Query     ports.TaskQuery
+	Responses *ResponseBuilder
+}
+
+// Execute returns the tasks matching the filter of the owner's view, in the order of its sort.
+func (uc *RunViewUseCase) Execute(owner, name string) ([]dto.TaskResponse, error) {
+	view, err := findView(uc.Views, owner, name)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := uc.Query.FindMatching(view.Filter)
+	if err != nil {
+		return nil, err
+	}
+	view.Sort.Apply(tasks)
+	return uc.Responses.BuildAll(tasks)
+}
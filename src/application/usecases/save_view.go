@@ -0,0 +1,36 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"errors"
+)
+
+// SaveViewUseCase handles creating or replacing a saved view.
+type SaveViewUseCase struct {
+	Views ports.SavedViewRepository
+}
+
+// Execute validates the filter and sort and saves the view under its owner and name,
+// replacing the filter and sort of an existing view with that name. The boolean reports
+// whether a new view was created.
+func (uc *SaveViewUseCase) Execute(req dto.SavedViewRequest) (dto.SavedViewResponse, bool, error) {
+	view, err := findView(uc.Views, req.Owner, req.Name)
+	created := errors.Is(err, ports.ErrViewNotFound)
+	if err != nil && !created {
+		return dto.SavedViewResponse{}, false, err
+	}
+	if created {
+		view, err = entities.NewSavedView(req.Owner, req.Name, req.Filter, req.Sort)
+	} else {
+		err = view.Update(req.Filter, req.Sort)
+	}
+	if err != nil {
+		return dto.SavedViewResponse{}, false, err
+	}
+	if err := uc.Views.Save(view); err != nil {
+		return dto.SavedViewResponse{}, false, err
+	}
+	return dto.ToSavedViewResponse(view), created, nil
+}
@@ -0,0 +1,71 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+)
+
+func TestSavedViews_SaveRunAndDelete(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	views := repositories.NewInMemorySavedViewRepository()
+	createTask(t, repo, "mow", nil)
+	createTask(t, repo, "buy paint", nil)
+	done := createTask(t, repo, "call", nil)
+	for _, status := range []string{"doing", "done"} {
+		if err := (&UpdateTaskStatusUseCase{Repo: repo}).Execute(done.ID, status); err != nil {
+			t.Fatalf("update status failed: %v", err)
+		}
+	}
+
+	save := &SaveViewUseCase{Views: views}
+	resp, created, err := save.Execute(dto.SavedViewRequest{Owner: "alice", Name: "open", Filter: "status!=done", Sort: "-created"})
+	if err != nil || !created {
+		t.Fatalf("expected the view to be created, got %v (created=%v)", err, created)
+	}
+	if resp.Filter != "status!=done" || resp.Sort != "-created" {
+		t.Errorf("unexpected view: %+v", resp)
+	}
+	save.Execute(dto.SavedViewRequest{Owner: "bob", Name: "open", Filter: "status:done"})
+
+	run := &RunViewUseCase{Views: views, Query: repo, Responses: &ResponseBuilder{Hierarchy: repo}}
+	tasks, err := run.Execute("alice", "open")
+	if err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if len(tasks) != 2 || tasks[0].Title != "buy paint" || tasks[1].Title != "mow" {
+		t.Fatalf("expected open tasks newest first, got %+v", tasks)
+	}
+
+	// Saving under an existing name replaces the filter and sort.
+	if _, created, _ := save.Execute(dto.SavedViewRequest{Owner: "alice", Name: "open", Filter: "status!=done", Sort: "title"}); created {
+		t.Errorf("expected the existing view to be replaced")
+	}
+	if tasks, _ := run.Execute("alice", "open"); len(tasks) != 2 || tasks[0].Title != "buy paint" {
+		t.Errorf("expected open tasks by title, got %+v", tasks)
+	}
+	if _, _, err := save.Execute(dto.SavedViewRequest{Owner: "alice", Name: "open", Filter: "status:"}); !errors.Is(err, value_objects.ErrInvalidFilter) {
+		t.Errorf("expected ErrInvalidFilter, got %v", err)
+	}
+
+	list, _ := (&ListViewsUseCase{Views: views}).Execute("alice")
+	if len(list) != 1 || list[0].Sort != "title" {
+		t.Errorf("expected alice's single view, got %+v", list)
+	}
+	if _, err := (&ListViewsUseCase{Views: views}).Execute(""); !errors.Is(err, entities.ErrMissingViewOwner) {
+		t.Errorf("expected ErrMissingViewOwner, got %v", err)
+	}
+
+	if err := (&DeleteViewUseCase{Views: views}).Execute("alice", "open"); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := run.Execute("alice", "open"); !errors.Is(err, repositories.ErrViewNotFound) {
+		t.Errorf("expected ErrViewNotFound after delete, got %v", err)
+	}
+	if view, err := (&GetViewUseCase{Views: views}).Execute("bob", "open"); err != nil || view.Filter != "status:done" {
+		t.Errorf("expected bob's view to survive, got %+v (err=%v)", view, err)
+	}
+}
@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"time"
+)
+
+// ErrInvalidDueDate indicates a due date that is not RFC 3339 or a timezone that is unknown.
+var ErrInvalidDueDate = errors.New("invalid due date or timezone")
+
+// applySchedule parses the due date and recurrence rule and sets them on the task.
+// The due date is converted to the given IANA timezone so recurrences follow its
+// daylight saving rules.
+func applySchedule(task *entities.Task, dueStr, timezone, recurrenceStr string) error {
+	var due *time.Time
+	if dueStr != "" {
+		parsed, err := time.Parse(time.RFC3339, dueStr)
+		if err != nil {
+			return ErrInvalidDueDate
+		}
+		if timezone != "" {
+			loc, err := time.LoadLocation(timezone)
+			if err != nil {
+				return ErrInvalidDueDate
+			}
+			parsed = parsed.In(loc)
+		}
+		due = &parsed
+	}
+	var rule *value_objects.RecurrenceRule
+	if recurrenceStr != "" {
+		parsed, err := value_objects.ParseRecurrenceRule(recurrenceStr)
+		if err != nil {
+			return err
+		}
+		rule = &parsed
+	}
+	// Clear the recurrence first so the due date can be removed together with it.
+	task.SetRecurrence(nil)
+	if err := task.SetDueDate(due); err != nil {
+		return err
+	}
+	return task.SetRecurrence(rule)
+}
@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"errors"
+	"strings"
+)
+
+// DefaultSearchLimit and MaxSearchLimit bound the number of search results.
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+)
+
+// ErrEmptySearchQuery is returned when a search query is blank.
+var ErrEmptySearchQuery = errors.New("search query cannot be empty")
+
+// SearchTasksUseCase handles full-text search over task titles and descriptions.
+type SearchTasksUseCase struct {
+	Repo      ports.TaskRepository
+	Search    ports.TaskSearch
+	Responses *ResponseBuilder
+}
+
+// Execute returns the tasks matching every word of the query, best match first.
+// A limit of zero or less uses DefaultSearchLimit; larger limits are capped at MaxSearchLimit.
+func (uc *SearchTasksUseCase) Execute(query string, limit int) ([]dto.TaskSearchResponse, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, ErrEmptySearchQuery
+	}
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+	hits, err := uc.Search.Search(query, limit)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]dto.TaskSearchResponse, 0, len(hits))
+	for _, hit := range hits {
+		task, err := uc.Repo.FindById(hit.TaskID)
+		if err != nil {
+			// The task was removed since the search; leave it out.
+			continue
+		}
+		response, err := uc.Responses.Build(task)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, dto.TaskSearchResponse{
+			TaskResponse:     response,
+			Score:            hit.Score,
+			HighlightedTitle: hit.Title,
+			Snippet:          hit.Snippet,
+		})
+	}
+	return responses, nil
+}
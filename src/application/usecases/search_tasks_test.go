@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestSearchTasks_RanksAndLimits(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	create := &CreateTaskUseCase{Repo: repo}
+	create.Execute(dto.CreateTaskRequest{Title: "Clean the garage", Description: "Move the paint cans"})
+	fence, _ := create.Execute(dto.CreateTaskRequest{Title: "Paint the fence"})
+	for i := 0; i < MaxSearchLimit+5; i++ {
+		createTask(t, repo, fmt.Sprintf("errand %d", i), nil)
+	}
+	uc := &SearchTasksUseCase{Repo: repo, Search: repo}
+
+	results, err := uc.Execute("PAINT", 0)
+	if err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	if len(results) != 2 || results[0].ID != fence.ID || results[0].Title != "Paint the fence" {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	if results[0].HighlightedTitle != "<mark>Paint</mark> the fence" || results[0].Score <= results[1].Score {
+		t.Errorf("unexpected ranking or highlight %+v", results)
+	}
+
+	if results, _ := uc.Execute("err", 0); len(results) != DefaultSearchLimit {
+		t.Errorf("expected %d results by default, got %d", DefaultSearchLimit, len(results))
+	}
+	if results, _ := uc.Execute("err", 1000); len(results) != MaxSearchLimit {
+		t.Errorf("expected the limit to be capped at %d, got %d", MaxSearchLimit, len(results))
+	}
+	if _, err := uc.Execute("  ", 0); !errors.Is(err, ErrEmptySearchQuery) {
+		t.Errorf("expected ErrEmptySearchQuery, got %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// SetTaskParentUseCase handles moving a task below another task or back to the top level.
+// When UnitOfWork is set, the change can be undone.
+type SetTaskParentUseCase struct {
+	Repo       ports.TaskRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute makes the task identified by idStr a subtask of parentIdStr.
+// An empty parentIdStr turns the task into a top-level task.
+// Returns an error if either task is not found or the move would create a cycle, and
+// ErrSubtaskProject if the tasks belong to different projects.
+func (uc *SetTaskParentUseCase) Execute(idStr string, parentIdStr string) error {
+	_, err := uc.ExecuteUndoable(idStr, parentIdStr)
+	return err
+}
+
+// ExecuteUndoable moves the task like Execute and returns a token for undoing the change, which is
+// empty unless UnitOfWork can record it.
+func (uc *SetTaskParentUseCase) ExecuteUndoable(idStr string, parentIdStr string) (string, error) {
+	if uc.UnitOfWork != nil {
+		return runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			return uc.within(stores).Execute(idStr, parentIdStr)
+		})
+	}
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return "", ErrInvalidID
+	}
+	task, err := uc.Repo.FindById(parsedId)
+	if err != nil {
+		return "", err
+	}
+	var parent *entities.Task
+	var ancestors []value_objects.TaskId
+	if parentIdStr != "" {
+		parentId, err := value_objects.ParseTaskId(parentIdStr)
+		if err != nil {
+			return "", ErrInvalidID
+		}
+		if parent, err = uc.Repo.FindById(parentId); err != nil {
+			return "", err
+		}
+		if ancestors, err = uc.ancestors(parent); err != nil {
+			return "", err
+		}
+		if task.ProjectID != parent.ProjectID {
+			return "", entities.ErrSubtaskProject
+		}
+	}
+	if err := task.SetParent(parent, ancestors); err != nil {
+		return "", err
+	}
+	return "", uc.Repo.Save(task)
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *SetTaskParentUseCase) within(stores ports.Stores) *SetTaskParentUseCase {
+	set := *uc
+	set.UnitOfWork = nil
+	set.Repo = stores.Tasks
+	return &set
+}
+
+// ancestors walks up the hierarchy from task and returns the IDs of all its ancestors.
+// The walk stops at an already visited task, so corrupted data cannot loop forever.
+func (uc *SetTaskParentUseCase) ancestors(task *entities.Task) ([]value_objects.TaskId, error) {
+	var ids []value_objects.TaskId
+	visited := map[value_objects.TaskId]bool{task.ID: true}
+	for current := task; current.IsSubtask() && !visited[current.ParentID]; {
+		visited[current.ParentID] = true
+		ids = append(ids, current.ParentID)
+		next, err := uc.Repo.FindById(current.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		current = next
+	}
+	return ids, nil
+}
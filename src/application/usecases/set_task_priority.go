@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// SetTaskPriorityUseCase handles changing the priority of a task.
+// When UnitOfWork is set, the change can be undone.
+type SetTaskPriorityUseCase struct {
+	Repo       ports.TaskRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute sets the priority of the task identified by its string ID.
+// Returns value_objects.ErrInvalidPriority for an unknown priority or an error if the task is not found.
+func (uc *SetTaskPriorityUseCase) Execute(idStr string, priorityStr string) error {
+	_, err := uc.ExecuteUndoable(idStr, priorityStr)
+	return err
+}
+
+// ExecuteUndoable sets the priority like Execute and returns a token for undoing the change, which is
+// empty unless UnitOfWork can record it.
+func (uc *SetTaskPriorityUseCase) ExecuteUndoable(idStr string, priorityStr string) (string, error) {
+	if uc.UnitOfWork != nil {
+		return runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			return uc.within(stores).Execute(idStr, priorityStr)
+		})
+	}
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return "", ErrInvalidID
+	}
+	priority, err := value_objects.ParsePriority(priorityStr)
+	if err != nil {
+		return "", err
+	}
+	task, err := uc.Repo.FindById(parsedId)
+	if err != nil {
+		return "", err
+	}
+	if err := task.SetPriority(priority); err != nil {
+		return "", err
+	}
+	return "", uc.Repo.Save(task)
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *SetTaskPriorityUseCase) within(stores ports.Stores) *SetTaskPriorityUseCase {
+	set := *uc
+	set.UnitOfWork = nil
+	set.Repo = stores.Tasks
+	return &set
+}
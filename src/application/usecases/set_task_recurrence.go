@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// SetTaskRecurrenceUseCase handles changing the due date and recurrence rule of a task.
+// When UnitOfWork is set, the change can be undone.
+type SetTaskRecurrenceUseCase struct {
+	Repo       ports.TaskRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute replaces the schedule of the task identified by its string ID.
+// Setting a rule restarts the series at the given due date.
+// Returns an error if the task is not found or the due date or rule is invalid.
+func (uc *SetTaskRecurrenceUseCase) Execute(idStr string, req dto.SetRecurrenceRequest) error {
+	_, err := uc.ExecuteUndoable(idStr, req)
+	return err
+}
+
+// ExecuteUndoable replaces the schedule like Execute and returns a token for undoing the change, which
+// is empty unless UnitOfWork can record it.
+func (uc *SetTaskRecurrenceUseCase) ExecuteUndoable(idStr string, req dto.SetRecurrenceRequest) (string, error) {
+	if uc.UnitOfWork != nil {
+		return runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			return uc.within(stores).Execute(idStr, req)
+		})
+	}
+	parsedId, err := value_objects.ParseTaskId(idStr)
+	if err != nil {
+		return "", ErrInvalidID
+	}
+	task, err := uc.Repo.FindById(parsedId)
+	if err != nil {
+		return "", err
+	}
+	if err := applySchedule(task, req.DueDate, req.Timezone, req.Recurrence); err != nil {
+		return "", err
+	}
+	return "", uc.Repo.Save(task)
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *SetTaskRecurrenceUseCase) within(stores ports.Stores) *SetTaskRecurrenceUseCase {
+	set := *uc
+	set.UnitOfWork = nil
+	set.Repo = stores.Tasks
+	return &set
+}
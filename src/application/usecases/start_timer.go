@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"time"
+)
+
+// StartTimerUseCase handles starting a timer on a task.
+// When UnitOfWork is set, the task is checked and the timer saved in a unit of work.
+type StartTimerUseCase struct {
+	Repo       ports.TaskRepository
+	WorkLog    ports.WorkLogRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute starts a timer for user on the task identified by its string ID.
+// Returns entities.ErrTimerRunning if the user is already tracking time.
+func (uc *StartTimerUseCase) Execute(taskIdStr string, user string) (*dto.WorkEntryResponse, error) {
+	if uc.UnitOfWork != nil {
+		var response *dto.WorkEntryResponse
+		err := uc.UnitOfWork.Do(func(stores ports.Stores) error {
+			var err error
+			response, err = uc.within(stores).Execute(taskIdStr, user)
+			return err
+		})
+		return response, err
+	}
+	taskId, err := value_objects.ParseTaskId(taskIdStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	if _, err := uc.Repo.FindById(taskId); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	entry, err := entities.StartTimer(taskId, user, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.WorkLog.Save(entry); err != nil {
+		return nil, err
+	}
+	response := dto.ToWorkEntryResponse(entry, now)
+	return &response, nil
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *StartTimerUseCase) within(stores ports.Stores) *StartTimerUseCase {
+	start := *uc
+	start.UnitOfWork = nil
+	start.Repo = stores.Tasks
+	if stores.WorkLog != nil {
+		start.WorkLog = stores.WorkLog
+	}
+	return &start
+}
@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"time"
+)
+
+// StopTimerUseCase handles stopping the timer a user runs on a task.
+// When UnitOfWork is set, the timer is read and saved in a unit of work.
+type StopTimerUseCase struct {
+	WorkLog    ports.WorkLogRepository
+	UnitOfWork ports.UnitOfWork
+}
+
+// Execute stops user's timer on the task identified by its string ID.
+// Returns entities.ErrTimerNotRunning if the user has no timer running on that task.
+func (uc *StopTimerUseCase) Execute(taskIdStr string, user string) (*dto.WorkEntryResponse, error) {
+	if uc.UnitOfWork != nil {
+		var response *dto.WorkEntryResponse
+		err := uc.UnitOfWork.Do(func(stores ports.Stores) error {
+			var err error
+			response, err = uc.within(stores).Execute(taskIdStr, user)
+			return err
+		})
+		return response, err
+	}
+	taskId, err := value_objects.ParseTaskId(taskIdStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	if user == "" {
+		return nil, entities.ErrMissingUser
+	}
+	entry, err := uc.WorkLog.FindRunning(user)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil || entry.TaskID != taskId {
+		return nil, entities.ErrTimerNotRunning
+	}
+	now := time.Now()
+	if err := entry.Stop(now); err != nil {
+		return nil, err
+	}
+	if err := uc.WorkLog.Save(entry); err != nil {
+		return nil, err
+	}
+	response := dto.ToWorkEntryResponse(entry, now)
+	return &response, nil
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *StopTimerUseCase) within(stores ports.Stores) *StopTimerUseCase {
+	stop := *uc
+	stop.UnitOfWork = nil
+	if stores.WorkLog != nil {
+		stop.WorkLog = stores.WorkLog
+	}
+	return &stop
+}
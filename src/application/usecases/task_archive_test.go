@@ -0,0 +1,123 @@
+package usecases
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestArchiveTask_ArchivesTreeAndUnblocksDependents(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	parent := createTask(t, repo, "parent", nil)
+	child := createTask(t, repo, "child", parent)
+	dependent := createTask(t, repo, "dependent", nil)
+	(&AddTaskBlockerUseCase{Repo: repo}).Execute(dependent.ID, parent.ID)
+	status := &UpdateTaskStatusUseCase{Repo: repo, Hierarchy: repo}
+	uc := &ArchiveTaskUseCase{Repo: repo, Hierarchy: repo, Dependencies: repo, Archive: repo}
+
+	if err := uc.Execute(parent.ID); !errors.Is(err, entities.ErrArchiveNotDone) {
+		t.Fatalf("Expected ErrArchiveNotDone, got %v", err)
+	}
+	for _, id := range []string{child.ID, parent.ID} {
+		status.Execute(id, "doing")
+		if err := status.Execute(id, "done"); err != nil {
+			t.Fatalf("update failed: %v", err)
+		}
+	}
+	if err := uc.Execute(child.ID); !errors.Is(err, entities.ErrArchiveSubtask) {
+		t.Fatalf("Expected ErrArchiveSubtask, got %v", err)
+	}
+	if err := uc.Execute(parent.ID); err != nil {
+		t.Fatalf("archive failed: %v", err)
+	}
+
+	assertColumn(t, repo, value_objects.StatusDone)
+	archived, err := (&ListArchivedUseCase{Archive: repo}).Execute()
+	if err != nil || len(archived) != 2 {
+		t.Fatalf("expected parent and child in the archive, got %+v (err=%v)", archived, err)
+	}
+	if task, _ := repo.FindById(value_objects.TaskId(dependent.ID)); len(task.BlockedBy) != 0 {
+		t.Errorf("expected dependent to be unblocked, got %v", task.BlockedBy)
+	}
+
+	got, err := (&GetArchivedTaskUseCase{Archive: repo}).Execute(parent.ID)
+	if err != nil {
+		t.Fatalf("get archived failed: %v", err)
+	}
+	if got.ArchivedAt == "" || got.CompletedAt == "" || len(got.History) != 2 {
+		t.Errorf("expected the archived task to keep its data and history, got %+v", got)
+	}
+}
+
+func TestArchiveDoneTasks_ArchivesAfterDelay(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	old := createTask(t, repo, "old", nil)
+	open := createTask(t, repo, "open", nil)
+	status := &UpdateTaskStatusUseCase{Repo: repo}
+	status.Execute(old.ID, "doing")
+	status.Execute(old.ID, "done")
+
+	uc := &ArchiveDoneTasksUseCase{Repo: repo, Hierarchy: repo, Dependencies: repo, Archive: repo, After: 24 * time.Hour}
+	if archived, err := uc.Execute(time.Now()); err != nil || archived != 0 {
+		t.Fatalf("expected nothing to archive yet, got %d (err=%v)", archived, err)
+	}
+	if archived, err := uc.Execute(time.Now().Add(25 * time.Hour)); err != nil || archived != 1 {
+		t.Fatalf("expected one archived task, got %d (err=%v)", archived, err)
+	}
+	if _, err := repo.FindArchivedById(value_objects.TaskId(old.ID)); err != nil {
+		t.Errorf("expected the done task in the archive, got %v", err)
+	}
+	if _, err := repo.FindById(value_objects.TaskId(open.ID)); err != nil {
+		t.Errorf("expected the open task to stay active, got %v", err)
+	}
+}
+
+func TestArchiveDoneTasks_WritesNothingWhenATreeCannotBeArchived(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	parent := createTask(t, repo, "parent", nil)
+	createTask(t, repo, "child", parent)
+	dependent := createTask(t, repo, "dependent", nil)
+	(&AddTaskBlockerUseCase{Repo: repo}).Execute(dependent.ID, parent.ID)
+	status := &UpdateTaskStatusUseCase{Repo: repo}
+	status.Execute(parent.ID, "doing")
+	status.Execute(parent.ID, "done")
+
+	uow := repositories.NewInMemoryUnitOfWork(repo, nil, repositories.DefaultUndoWindow)
+	uc := &ArchiveDoneTasksUseCase{Repo: repo, Hierarchy: repo, Dependencies: repo, Archive: repo,
+		UnitOfWork: uow, After: time.Hour}
+	if _, err := uc.Execute(time.Now().Add(2 * time.Hour)); !errors.Is(err, entities.ErrArchiveNotDone) {
+		t.Fatalf("Expected ErrArchiveNotDone, got %v", err)
+	}
+	if task, _ := repo.FindById(value_objects.TaskId(dependent.ID)); len(task.BlockedBy) != 1 {
+		t.Errorf("expected dependent to stay blocked, got %v", task.BlockedBy)
+	}
+	if _, err := repo.FindById(value_objects.TaskId(parent.ID)); err != nil {
+		t.Errorf("expected the parent to stay active, got %v", err)
+	}
+}
+
+func TestArchiveTask_UnblocksDependentOfSeveralTreeMembers(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	parent := createTask(t, repo, "parent", nil)
+	child := createTask(t, repo, "child", parent)
+	dependent := createTask(t, repo, "dependent", nil)
+	addBlocker := &AddTaskBlockerUseCase{Repo: repo}
+	addBlocker.Execute(dependent.ID, parent.ID)
+	addBlocker.Execute(dependent.ID, child.ID)
+	status := &UpdateTaskStatusUseCase{Repo: repo, Hierarchy: repo}
+	for _, id := range []string{child.ID, parent.ID} {
+		status.Execute(id, "doing")
+		status.Execute(id, "done")
+	}
+
+	uc := &ArchiveTaskUseCase{Repo: repo, Hierarchy: repo, Dependencies: repo, Archive: repo}
+	if err := uc.Execute(parent.ID); err != nil {
+		t.Fatalf("archive failed: %v", err)
+	}
+	if task, _ := repo.FindById(value_objects.TaskId(dependent.ID)); len(task.BlockedBy) != 0 {
+		t.Errorf("expected dependent to be unblocked from parent and child, got %v", task.BlockedBy)
+	}
+}
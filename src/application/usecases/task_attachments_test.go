@@ -0,0 +1,147 @@
+package usecases
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"clean-architecture-golang/infrastructure/storage"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func newBlobStore(t *testing.T) *storage.LocalBlobStore {
+	t.Helper()
+	blobs, err := storage.NewLocalBlobStore(t.TempDir(), 64)
+	if err != nil {
+		t.Fatalf("new blob store: %v", err)
+	}
+	return blobs
+}
+
+func TestAttachments_UploadOpenDelete(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	blobs := newBlobStore(t)
+	task := createTask(t, repo, "paint", nil)
+	upload := &UploadAttachmentUseCase{Repo: repo, Blobs: blobs, Attachments: repo}
+
+	first, err := upload.Execute(task.ID, "notes.txt", strings.NewReader("two coats"))
+	if err != nil {
+		t.Fatalf("upload failed: %v", err)
+	}
+	// The same content attached twice shares one blob.
+	second, _ := upload.Execute(task.ID, "copy.txt", strings.NewReader("two coats"))
+	if first.Digest != second.Digest || first.ContentType != "text/plain; charset=utf-8" {
+		t.Fatalf("unexpected attachments %+v / %+v", first, second)
+	}
+
+	list, _ := (&ListAttachmentsUseCase{Repo: repo}).Execute(task.ID)
+	if len(list) != 2 || list[0].Name != "notes.txt" {
+		t.Fatalf("unexpected list %+v", list)
+	}
+
+	meta, content, err := (&OpenAttachmentUseCase{Repo: repo, Blobs: blobs}).Execute(task.ID, first.ID)
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	data, _ := io.ReadAll(content)
+	content.Close()
+	if string(data) != "two coats" || meta.Name != "notes.txt" {
+		t.Errorf("unexpected download %q / %+v", data, meta)
+	}
+
+	remove := &DeleteAttachmentUseCase{Repo: repo, Blobs: blobs, Attachments: repo}
+	if err := remove.Execute(task.ID, first.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := blobs.Open(first.Digest); err != nil {
+		t.Fatalf("expected blob shared with the second attachment to survive, got %v", err)
+	}
+	if err := remove.Execute(task.ID, second.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := blobs.Open(first.Digest); !errors.Is(err, storage.ErrBlobNotFound) {
+		t.Fatalf("expected unreferenced blob to be collected, got %v", err)
+	}
+	if err := remove.Execute(task.ID, second.ID); !errors.Is(err, ErrAttachmentNotFound) {
+		t.Errorf("expected ErrAttachmentNotFound, got %v", err)
+	}
+}
+
+// editingReader edits its task on the first read, like a request made while an upload streams.
+type editingReader struct {
+	io.Reader
+	edit func()
+}
+
+func (r *editingReader) Read(p []byte) (int, error) {
+	if r.edit != nil {
+		r.edit()
+		r.edit = nil
+	}
+	return r.Reader.Read(p)
+}
+
+func TestAttachments_UploadKeepsChangesMadeWhileStreaming(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	blobs := newBlobStore(t)
+	task := createTask(t, repo, "paint", nil)
+	uow := repositories.NewInMemoryUnitOfWork(repo, nil, repositories.DefaultUndoWindow)
+	upload := &UploadAttachmentUseCase{Repo: repo, Blobs: blobs, Attachments: repo, UnitOfWork: uow}
+
+	content := &editingReader{Reader: strings.NewReader("two coats"), edit: func() {
+		current, _ := repo.FindById(value_objects.TaskId(task.ID))
+		current.AddTags("urgent")
+		repo.Save(current)
+	}}
+	if _, err := upload.Execute(task.ID, "notes.txt", content); err != nil {
+		t.Fatalf("upload failed: %v", err)
+	}
+	got, _ := repo.FindById(value_objects.TaskId(task.ID))
+	if len(got.Tags) != 1 || len(got.Attachments) != 1 {
+		t.Errorf("expected the tag and the attachment, got tags %v and attachments %+v", got.Tags, got.Attachments)
+	}
+}
+
+func TestAttachments_RejectedUploadLeavesNoBlob(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	blobs := newBlobStore(t)
+	task := createTask(t, repo, "paint", nil)
+	upload := &UploadAttachmentUseCase{Repo: repo, Blobs: blobs, Attachments: repo}
+
+	if _, err := upload.Execute(task.ID, "big.bin", strings.NewReader(strings.Repeat("x", 65))); !errors.Is(err, storage.ErrBlobTooLarge) {
+		t.Errorf("expected ErrBlobTooLarge, got %v", err)
+	}
+	_, err := upload.Execute(task.ID, "../", strings.NewReader("orphan"))
+	if err == nil {
+		t.Fatalf("expected invalid file name to be rejected")
+	}
+	sum := sha256.Sum256([]byte("orphan"))
+	if _, err := blobs.Open(hex.EncodeToString(sum[:])); !errors.Is(err, storage.ErrBlobNotFound) {
+		t.Errorf("expected rejected upload to leave no blob, got %v", err)
+	}
+}
+
+func TestDeleteTask_CollectsAttachmentBlobs(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	blobs := newBlobStore(t)
+	kept := createTask(t, repo, "kept", nil)
+	deleted := createTask(t, repo, "deleted", nil)
+	upload := &UploadAttachmentUseCase{Repo: repo, Blobs: blobs, Attachments: repo}
+	shared, _ := upload.Execute(kept.ID, "shared.txt", strings.NewReader("shared"))
+	upload.Execute(deleted.ID, "shared.txt", strings.NewReader("shared"))
+	own, _ := upload.Execute(deleted.ID, "own.txt", strings.NewReader("own"))
+
+	uc := &DeleteTaskUseCase{Repo: repo, Blobs: blobs, Attachments: repo}
+	if err := uc.Execute(deleted.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := blobs.Open(own.Digest); !errors.Is(err, storage.ErrBlobNotFound) {
+		t.Errorf("expected the deleted task's own blob to be collected, got %v", err)
+	}
+	if _, err := blobs.Open(shared.Digest); err != nil {
+		t.Errorf("expected blob still referenced by another task to survive, got %v", err)
+	}
+}
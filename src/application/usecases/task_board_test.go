@@ -0,0 +1,107 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+)
+
+func columnTitles(t *testing.T, repo *repositories.InMemoryTaskRepository, status value_objects.TaskStatus) []string {
+	t.Helper()
+	tasks, err := repo.FindByStatus(status)
+	if err != nil {
+		t.Fatalf("find by status failed: %v", err)
+	}
+	titles := make([]string, len(tasks))
+	for i, task := range tasks {
+		titles[i] = task.Title
+	}
+	return titles
+}
+
+func assertColumn(t *testing.T, repo *repositories.InMemoryTaskRepository, status value_objects.TaskStatus, want ...string) {
+	t.Helper()
+	got := columnTitles(t, repo, status)
+	if len(got) != len(want) {
+		t.Fatalf("%s column: expected %v, got %v", status, want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("%s column: expected %v, got %v", status, want, got)
+		}
+	}
+}
+
+func TestMoveTask_ReorderAndAcrossColumns(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	a := createTask(t, repo, "a", nil)
+	b := createTask(t, repo, "b", nil)
+	c := createTask(t, repo, "c", nil)
+	assertColumn(t, repo, value_objects.StatusTodo, "a", "b", "c")
+
+	uc := &MoveTaskUseCase{Repo: repo, Status: &UpdateTaskStatusUseCase{Repo: repo}}
+	if err := uc.Execute(dto.MoveTaskRequest{TaskID: c.ID, BeforeID: a.ID}); err != nil {
+		t.Fatalf("move failed: %v", err)
+	}
+	assertColumn(t, repo, value_objects.StatusTodo, "c", "a", "b")
+
+	if err := uc.Execute(dto.MoveTaskRequest{TaskID: c.ID, AfterID: a.ID, BeforeID: b.ID}); err != nil {
+		t.Fatalf("move failed: %v", err)
+	}
+	assertColumn(t, repo, value_objects.StatusTodo, "a", "c", "b")
+
+	if err := uc.Execute(dto.MoveTaskRequest{TaskID: b.ID, Status: "doing"}); err != nil {
+		t.Fatalf("move failed: %v", err)
+	}
+	if err := uc.Execute(dto.MoveTaskRequest{TaskID: a.ID, Status: "doing", BeforeID: b.ID}); err != nil {
+		t.Fatalf("move failed: %v", err)
+	}
+	assertColumn(t, repo, value_objects.StatusTodo, "c")
+	assertColumn(t, repo, value_objects.StatusDoing, "a", "b")
+
+	// Status changes through the regular endpoint append to the bottom of the column.
+	if err := (&UpdateTaskStatusUseCase{Repo: repo}).Execute(c.ID, "doing"); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	assertColumn(t, repo, value_objects.StatusDoing, "a", "b", "c")
+}
+
+func TestMoveTask_InvalidPosition(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	a := createTask(t, repo, "a", nil)
+	b := createTask(t, repo, "b", nil)
+	c := createTask(t, repo, "c", nil)
+	uc := &MoveTaskUseCase{Repo: repo, Status: &UpdateTaskStatusUseCase{Repo: repo}}
+
+	for _, req := range []dto.MoveTaskRequest{
+		{TaskID: a.ID, AfterID: b.ID, BeforeID: b.ID},
+		{TaskID: a.ID, AfterID: string(value_objects.NewTaskId())},
+		{TaskID: c.ID, Status: "doing", AfterID: a.ID},
+	} {
+		if err := uc.Execute(req); !errors.Is(err, ErrInvalidPosition) {
+			t.Errorf("Execute(%+v) expected ErrInvalidPosition, got %v", req, err)
+		}
+	}
+	assertColumn(t, repo, value_objects.StatusTodo, "a", "b", "c")
+}
+
+func TestMoveTask_RebalancesCrowdedColumn(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	a := createTask(t, repo, "a", nil)
+	b := createTask(t, repo, "b", nil)
+	c := createTask(t, repo, "c", nil)
+	// Tasks sharing a rank leave no room between them until the column is renumbered.
+	for _, id := range []string{a.ID, b.ID} {
+		task, _ := repo.FindById(value_objects.TaskId(id))
+		task.Rank = "i"
+		repo.Save(task)
+	}
+
+	uc := &MoveTaskUseCase{Repo: repo, Status: &UpdateTaskStatusUseCase{Repo: repo}}
+	if err := uc.Execute(dto.MoveTaskRequest{TaskID: c.ID, AfterID: a.ID, BeforeID: b.ID}); err != nil {
+		t.Fatalf("move failed: %v", err)
+	}
+	assertColumn(t, repo, value_objects.StatusTodo, "a", "c", "b")
+}
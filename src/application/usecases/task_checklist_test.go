@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+)
+
+func TestChecklist_AddCheckMoveRemove(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	task := createTask(t, repo, "pack", nil)
+	add := &AddChecklistItemUseCase{Repo: repo}
+
+	passport, err := add.Execute(task.ID, "passport")
+	if err != nil {
+		t.Fatalf("add failed: %v", err)
+	}
+	charger, _ := add.Execute(task.ID, "charger")
+	if _, err := add.Execute(task.ID, ""); !errors.Is(err, entities.ErrEmptyChecklistItem) {
+		t.Errorf("expected ErrEmptyChecklistItem, got %v", err)
+	}
+
+	checked, err := (&CheckChecklistItemUseCase{Repo: repo}).Execute(task.ID, charger.ID, true)
+	if err != nil || !checked.Checked {
+		t.Fatalf("unexpected check result %+v (err=%v)", checked, err)
+	}
+
+	list, err := (&MoveChecklistItemUseCase{Repo: repo}).Execute(task.ID, charger.ID, 0)
+	if err != nil || len(list) != 2 || list[0].ID != charger.ID || !list[0].Checked {
+		t.Fatalf("unexpected reordered checklist %+v (err=%v)", list, err)
+	}
+
+	remove := &RemoveChecklistItemUseCase{Repo: repo}
+	if err := remove.Execute(task.ID, passport.ID); err != nil {
+		t.Fatalf("remove failed: %v", err)
+	}
+	if err := remove.Execute(task.ID, passport.ID); !errors.Is(err, ErrChecklistItemNotFound) {
+		t.Errorf("expected ErrChecklistItemNotFound, got %v", err)
+	}
+	if err := remove.Execute(task.ID, "bad"); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("expected ErrInvalidID, got %v", err)
+	}
+
+	stored, _ := repo.FindById(value_objects.TaskId(task.ID))
+	if len(stored.Checklist) != 1 || stored.Checklist[0].Text != "charger" {
+		t.Errorf("unexpected stored checklist %+v", stored.Checklist)
+	}
+}
+
+func TestUpdateTaskStatus_RequireChecklist(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	task := createTask(t, repo, "pack", nil)
+	item, _ := (&AddChecklistItemUseCase{Repo: repo}).Execute(task.ID, "passport")
+
+	// Without the rule unchecked items do not block completion.
+	other := createTask(t, repo, "other", nil)
+	(&AddChecklistItemUseCase{Repo: repo}).Execute(other.ID, "anything")
+	if err := (&UpdateTaskStatusUseCase{Repo: repo}).Execute(other.ID, "done"); err != nil {
+		t.Fatalf("unexpected error without the rule: %v", err)
+	}
+
+	uc := &UpdateTaskStatusUseCase{Repo: repo, RequireChecklist: true}
+	if err := uc.Execute(task.ID, "done"); !errors.Is(err, entities.ErrUncheckedItems) {
+		t.Fatalf("expected ErrUncheckedItems, got %v", err)
+	}
+	(&CheckChecklistItemUseCase{Repo: repo}).Execute(task.ID, item.ID, true)
+	if err := uc.Execute(task.ID, "done"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+package usecases
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+)
+
+func TestComments_AddEditListDelete(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	comments := repositories.NewInMemoryCommentRepository()
+	task := createTask(t, repo, "review", nil)
+
+	added, err := (&AddCommentUseCase{Repo: repo, Comments: comments}).Execute(task.ID, "alice", "first pass")
+	if err != nil {
+		t.Fatalf("add failed: %v", err)
+	}
+	if added.Author != "alice" || added.TaskID != task.ID {
+		t.Errorf("unexpected comment: %+v", added)
+	}
+
+	edited, err := (&EditCommentUseCase{Comments: comments}).Execute(task.ID, added.ID, "second pass")
+	if err != nil {
+		t.Fatalf("edit failed: %v", err)
+	}
+	if edited.Body != "second pass" || edited.UpdatedAt == "" {
+		t.Errorf("expected edited body and UpdatedAt, got %+v", edited)
+	}
+
+	list, err := (&ListCommentsUseCase{Repo: repo, Comments: comments}).Execute(task.ID)
+	if err != nil || len(list) != 1 || list[0].Body != "second pass" {
+		t.Fatalf("unexpected list %+v (err=%v)", list, err)
+	}
+
+	resp, _ := (&GetTaskUseCase{Repo: repo, Responses: &ResponseBuilder{Comments: comments}}).Execute(task.ID)
+	if resp.CommentCount != 1 {
+		t.Errorf("expected CommentCount 1, got %d", resp.CommentCount)
+	}
+
+	if err := (&DeleteCommentUseCase{Comments: comments}).Execute(task.ID, added.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if err := (&DeleteCommentUseCase{Comments: comments}).Execute(task.ID, added.ID); !errors.Is(err, repositories.ErrCommentNotFound) {
+		t.Errorf("expected ErrCommentNotFound, got %v", err)
+	}
+}
+
+func TestComments_Validation(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	comments := repositories.NewInMemoryCommentRepository()
+	task := createTask(t, repo, "review", nil)
+	other := createTask(t, repo, "other", nil)
+	add := &AddCommentUseCase{Repo: repo, Comments: comments}
+
+	if _, err := add.Execute("bad", "alice", "hi"); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("expected ErrInvalidID, got %v", err)
+	}
+	if _, err := add.Execute(string(value_objects.NewTaskId()), "alice", "hi"); !errors.Is(err, repositories.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := add.Execute(task.ID, "alice", " "); !errors.Is(err, entities.ErrEmptyComment) {
+		t.Errorf("expected ErrEmptyComment, got %v", err)
+	}
+
+	comment, _ := add.Execute(task.ID, "alice", "hi")
+	if _, err := (&EditCommentUseCase{Comments: comments}).Execute(other.ID, comment.ID, "moved"); !errors.Is(err, ErrCommentNotOnTask) {
+		t.Errorf("expected ErrCommentNotOnTask, got %v", err)
+	}
+}
+
+func TestDeleteTask_CascadesComments(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	comments := repositories.NewInMemoryCommentRepository()
+	task := createTask(t, repo, "review", nil)
+	comment, _ := (&AddCommentUseCase{Repo: repo, Comments: comments}).Execute(task.ID, "alice", "hi")
+
+	if err := (&DeleteTaskUseCase{Repo: repo, Comments: comments}).Execute(task.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := comments.FindById(value_objects.CommentId(comment.ID)); !errors.Is(err, repositories.ErrCommentNotFound) {
+		t.Errorf("expected comment to be deleted with its task, got %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package usecases
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+)
+
+func TestAddTaskBlocker_RejectsTransitiveCycle(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	a := createTask(t, repo, "a", nil)
+	b := createTask(t, repo, "b", nil)
+	c := createTask(t, repo, "c", nil)
+
+	uc := &AddTaskBlockerUseCase{Repo: repo}
+	if err := uc.Execute(a.ID, b.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := uc.Execute(b.ID, c.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := uc.Execute(c.ID, a.ID); !errors.Is(err, entities.ErrDependencyCycle) {
+		t.Fatalf("Expected ErrDependencyCycle, got %v", err)
+	}
+}
+
+func TestAddTaskBlocker_SkipsPurgedTransitiveBlocker(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	a := createTask(t, repo, "a", nil)
+	b := createTask(t, repo, "b", nil)
+	c := createTask(t, repo, "c", nil)
+	purged := createTask(t, repo, "purged", nil)
+
+	uc := &AddTaskBlockerUseCase{Repo: repo}
+	uc.Execute(b.ID, purged.ID)
+	uc.Execute(b.ID, c.ID)
+	repo.Delete(value_objects.TaskId(purged.ID))
+
+	if err := uc.Execute(a.ID, b.ID); err != nil {
+		t.Fatalf("Unexpected error with a purged transitive blocker: %v", err)
+	}
+	if err := uc.Execute(c.ID, a.ID); !errors.Is(err, entities.ErrDependencyCycle) {
+		t.Fatalf("Expected ErrDependencyCycle past the purged blocker, got %v", err)
+	}
+}
+
+func TestUpdateStatus_Blocked(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	task := createTask(t, repo, "task", nil)
+	blocker := createTask(t, repo, "blocker", nil)
+	(&AddTaskBlockerUseCase{Repo: repo}).Execute(task.ID, blocker.ID)
+
+	uc := &UpdateTaskStatusUseCase{Repo: repo}
+	if err := uc.Execute(task.ID, "doing"); !errors.Is(err, entities.ErrBlocked) {
+		t.Fatalf("Expected ErrBlocked, got %v", err)
+	}
+	uc.Execute(blocker.ID, "done")
+	if err := uc.Execute(task.ID, "doing"); err != nil {
+		t.Fatalf("Unexpected error once blocker is done: %v", err)
+	}
+}
+
+func TestUpdateStatus_SkipsBlockersThatNoLongerExist(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	task := createTask(t, repo, "task", nil)
+	blocker := createTask(t, repo, "blocker", nil)
+	(&AddTaskBlockerUseCase{Repo: repo}).Execute(task.ID, blocker.ID)
+	repo.Delete(value_objects.TaskId(blocker.ID))
+
+	if err := (&UpdateTaskStatusUseCase{Repo: repo}).Execute(task.ID, "doing"); err != nil {
+		t.Fatalf("Unexpected error with a dangling blocker: %v", err)
+	}
+}
+
+func TestGetNextTasks_OrdersAndFlagsReady(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	deploy := createTask(t, repo, "deploy", nil)
+	build := createTask(t, repo, "build", nil)
+	finished := createTask(t, repo, "finished", nil)
+	addBlocker := &AddTaskBlockerUseCase{Repo: repo}
+	addBlocker.Execute(deploy.ID, build.ID)
+	addBlocker.Execute(build.ID, finished.ID)
+	(&UpdateTaskStatusUseCase{Repo: repo}).Execute(finished.ID, "done")
+
+	next, err := (&GetNextTasksUseCase{Repo: repo}).Execute()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(next) != 2 || next[0].Task.ID != build.ID || next[1].Task.ID != deploy.ID {
+		t.Fatalf("Unexpected order: %+v", next)
+	}
+	if !next[0].Ready || next[1].Ready {
+		t.Errorf("Expected only build to be ready, got %+v", next)
+	}
+}
+
+func TestDeleteTask_UnblocksDependents(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	task := createTask(t, repo, "task", nil)
+	blocker := createTask(t, repo, "blocker", nil)
+	(&AddTaskBlockerUseCase{Repo: repo}).Execute(task.ID, blocker.ID)
+
+	if err := (&DeleteTaskUseCase{Repo: repo, Dependencies: repo}).Execute(blocker.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got, _ := repo.FindById(value_objects.TaskId(task.ID))
+	if len(got.BlockedBy) != 0 {
+		t.Errorf("Expected dependency on deleted task to be removed, got %v", got.BlockedBy)
+	}
+}
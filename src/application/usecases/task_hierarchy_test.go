@@ -0,0 +1,109 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+)
+
+func createTask(t *testing.T, repo *repositories.InMemoryTaskRepository, title string, parent *dto.TaskResponse) *dto.TaskResponse {
+	t.Helper()
+	req := dto.CreateTaskRequest{Title: title}
+	if parent != nil {
+		req.ParentID = parent.ID
+	}
+	resp, err := (&CreateTaskUseCase{Repo: repo}).Execute(req)
+	if err != nil {
+		t.Fatalf("create %q failed: %v", title, err)
+	}
+	return resp
+}
+
+func TestSetTaskParent_RejectsCycle(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	root := createTask(t, repo, "root", nil)
+	middle := createTask(t, repo, "middle", root)
+	leaf := createTask(t, repo, "leaf", middle)
+
+	uc := &SetTaskParentUseCase{Repo: repo}
+	if err := uc.Execute(root.ID, leaf.ID); !errors.Is(err, entities.ErrParentCycle) {
+		t.Fatalf("Expected ErrParentCycle, got %v", err)
+	}
+	if err := uc.Execute(leaf.ID, root.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := uc.Execute(leaf.ID, ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	task, _ := repo.FindById(value_objects.TaskId(leaf.ID))
+	if task.IsSubtask() {
+		t.Errorf("Expected leaf to be top-level, got parent %s", task.ParentID)
+	}
+}
+
+func TestUpdateStatus_OpenChildren(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	parent := createTask(t, repo, "parent", nil)
+	child := createTask(t, repo, "child", parent)
+
+	uc := &UpdateTaskStatusUseCase{Repo: repo, Hierarchy: repo}
+	if err := uc.Execute(parent.ID, "done"); !errors.Is(err, entities.ErrOpenChildren) {
+		t.Fatalf("Expected ErrOpenChildren, got %v", err)
+	}
+	if err := uc.Execute(child.ID, "done"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := uc.Execute(parent.ID, "done"); err != nil {
+		t.Fatalf("Unexpected error once child is done: %v", err)
+	}
+
+	if err := uc.Execute(child.ID, "doing"); !errors.Is(err, entities.ErrParentClosed) {
+		t.Fatalf("Expected ErrParentClosed, got %v", err)
+	}
+	if err := uc.Execute(parent.ID, "doing"); err != nil {
+		t.Fatalf("Unexpected error reopening the parent: %v", err)
+	}
+	if err := uc.Execute(child.ID, "doing"); err != nil {
+		t.Fatalf("Unexpected error once the parent is open: %v", err)
+	}
+}
+
+func TestGetTaskChildren_CompletionRollup(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	responses := &ResponseBuilder{Hierarchy: repo}
+	root := createTask(t, repo, "root", nil)
+	a := createTask(t, repo, "a", root)
+	createTask(t, repo, "b", root)
+	a1 := createTask(t, repo, "a1", a)
+	createTask(t, repo, "a2", a)
+	(&UpdateTaskStatusUseCase{Repo: repo}).Execute(a1.ID, "done")
+
+	children, err := (&GetTaskChildrenUseCase{Repo: repo, Hierarchy: repo, Responses: responses}).Execute(root.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(children) != 2 || children[0].CompletionPercent != 50 || children[1].CompletionPercent != 0 {
+		t.Fatalf("Unexpected children rollup: %+v", children)
+	}
+	got, _ := (&GetTaskUseCase{Repo: repo, Responses: responses}).Execute(root.ID)
+	if got.CompletionPercent != 25 {
+		t.Errorf("Expected root completion 25, got %d", got.CompletionPercent)
+	}
+}
+
+func TestDeleteTask_DetachesChildren(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	parent := createTask(t, repo, "parent", nil)
+	child := createTask(t, repo, "child", parent)
+
+	if err := (&DeleteTaskUseCase{Repo: repo, Hierarchy: repo}).Execute(parent.ID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	task, _ := repo.FindById(value_objects.TaskId(child.ID))
+	if task.IsSubtask() {
+		t.Errorf("Expected child to become top-level")
+	}
+}
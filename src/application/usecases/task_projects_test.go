@@ -0,0 +1,128 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+)
+
+func TestProjects_CreateTasksMoveAndDelete(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	projects := repositories.NewInMemoryProjectRepository()
+	home, err := (&CreateProjectUseCase{Projects: projects}).Execute(dto.ProjectRequest{Name: "Home", DefaultTags: []string{"home"}})
+	if err != nil {
+		t.Fatalf("create project failed: %v", err)
+	}
+	work, _ := (&CreateProjectUseCase{Projects: projects}).Execute(dto.ProjectRequest{Name: "Work"})
+
+	create := &CreateTaskUseCase{Repo: repo, Projects: projects}
+	paint, err := create.Execute(dto.CreateTaskRequest{Title: "paint", ProjectID: home.ID})
+	if err != nil {
+		t.Fatalf("create task failed: %v", err)
+	}
+	if paint.ProjectID != home.ID || len(paint.Tags) != 1 || paint.Tags[0] != "home" {
+		t.Fatalf("expected project and default tag, got %+v", paint)
+	}
+	brush, _ := create.Execute(dto.CreateTaskRequest{Title: "buy brush", ParentID: paint.ID})
+	if brush.ProjectID != home.ID {
+		t.Errorf("expected subtask to inherit the project, got %q", brush.ProjectID)
+	}
+	if _, err := create.Execute(dto.CreateTaskRequest{Title: "x", ParentID: paint.ID, ProjectID: work.ID}); !errors.Is(err, entities.ErrSubtaskProject) {
+		t.Errorf("expected ErrSubtaskProject, got %v", err)
+	}
+	if _, err := create.Execute(dto.CreateTaskRequest{Title: "x", ProjectID: "not-an-id"}); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("expected ErrInvalidID, got %v", err)
+	}
+	createTask(t, repo, "inbox", nil)
+
+	list := &ListProjectTasksUseCase{Projects: projects, Tasks: repo}
+	if tasks, err := list.Execute(home.ID, "todo"); err != nil || len(tasks) != 2 {
+		t.Fatalf("expected the two home tasks, got %+v (err=%v)", tasks, err)
+	}
+
+	move := &MoveTaskToProjectUseCase{Repo: repo, Hierarchy: repo, Projects: projects}
+	if err := move.Execute(brush.ID, work.ID); !errors.Is(err, entities.ErrSubtaskProject) {
+		t.Errorf("expected ErrSubtaskProject for a subtask, got %v", err)
+	}
+	if err := move.Execute(paint.ID, work.ID); err != nil {
+		t.Fatalf("move failed: %v", err)
+	}
+	if tasks, _ := list.Execute(work.ID, ""); len(tasks) != 2 {
+		t.Errorf("expected the task and its subtask in work, got %+v", tasks)
+	}
+
+	del := &DeleteProjectUseCase{Projects: projects, Tasks: repo}
+	if err := del.Execute(work.ID); !errors.Is(err, ErrProjectNotEmpty) {
+		t.Errorf("expected ErrProjectNotEmpty, got %v", err)
+	}
+	if err := del.Execute(home.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	all, _ := (&ListProjectsUseCase{Projects: projects, Tasks: repo}).Execute()
+	if len(all) != 1 || all[0].Name != "Work" || all[0].TaskCount != 2 {
+		t.Errorf("unexpected projects %+v", all)
+	}
+}
+
+func TestUpdateTaskStatus_ProjectStartsTimers(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	projects := repositories.NewInMemoryProjectRepository()
+	workLog := repositories.NewInMemoryWorkLogRepository()
+	project, _ := (&CreateProjectUseCase{Projects: projects}).Execute(dto.ProjectRequest{Name: "Work", StartTimers: true})
+	create := &CreateTaskUseCase{Repo: repo, Projects: projects}
+	first, _ := create.Execute(dto.CreateTaskRequest{Title: "first", ProjectID: project.ID})
+	second, _ := create.Execute(dto.CreateTaskRequest{Title: "second", ProjectID: project.ID})
+	uc := &UpdateTaskStatusUseCase{Repo: repo, WorkLog: workLog, Projects: projects}
+
+	if err := uc.ExecuteRequest(dto.UpdateStatusRequest{TaskID: first.ID, NewStatus: "doing", Actor: "alice"}); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	running, _ := workLog.FindRunning("alice")
+	if running == nil || string(running.TaskID) != first.ID {
+		t.Fatalf("expected a timer on the first task, got %+v", running)
+	}
+	// A running timer elsewhere does not block the move.
+	if err := uc.ExecuteRequest(dto.UpdateStatusRequest{TaskID: second.ID, NewStatus: "doing", Actor: "alice"}); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if running, _ := workLog.FindRunning("alice"); string(running.TaskID) != first.ID {
+		t.Errorf("expected the first timer to keep running, got %+v", running)
+	}
+}
+
+func TestDeleteProject_CountsTrashedAndArchivedTasks(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	projects := repositories.NewInMemoryProjectRepository()
+	home, _ := (&CreateProjectUseCase{Projects: projects}).Execute(dto.ProjectRequest{Name: "Home"})
+	task, _ := (&CreateTaskUseCase{Repo: repo, Projects: projects}).Execute(dto.CreateTaskRequest{Title: "paint", ProjectID: home.ID})
+	(&DeleteTaskUseCase{Repo: repo, Trash: repo}).Execute(task.ID)
+
+	uow := repositories.NewInMemoryUnitOfWork(repo, nil, repositories.DefaultUndoWindow)
+	del := &DeleteProjectUseCase{Projects: projects, Tasks: repo, Trash: repo, Archive: repo, UnitOfWork: uow}
+	if err := del.Execute(home.ID); !errors.Is(err, ErrProjectNotEmpty) {
+		t.Fatalf("expected ErrProjectNotEmpty for a trashed task, got %v", err)
+	}
+	repo.Purge(value_objects.TaskId(task.ID))
+	if err := del.Execute(home.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+}
+
+func TestSetTaskParent_RejectsParentInAnotherProject(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	projects := repositories.NewInMemoryProjectRepository()
+	home, _ := (&CreateProjectUseCase{Projects: projects}).Execute(dto.ProjectRequest{Name: "Home"})
+	paint, _ := (&CreateTaskUseCase{Repo: repo, Projects: projects}).Execute(dto.CreateTaskRequest{Title: "paint", ProjectID: home.ID})
+	inbox := createTask(t, repo, "inbox", nil)
+
+	uc := &SetTaskParentUseCase{Repo: repo}
+	if err := uc.Execute(inbox.ID, paint.ID); !errors.Is(err, entities.ErrSubtaskProject) {
+		t.Fatalf("expected ErrSubtaskProject, got %v", err)
+	}
+	if task, _ := repo.FindById(value_objects.TaskId(inbox.ID)); task.IsSubtask() {
+		t.Errorf("expected the refused move to leave the task top-level, got parent %s", task.ParentID)
+	}
+}
@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+	_ "time/tzdata"
+)
+
+func TestCreateTask_InvalidSchedule(t *testing.T) {
+	uc := &CreateTaskUseCase{Repo: &mockRepoCreate{}}
+	cases := []struct {
+		req  dto.CreateTaskRequest
+		want error
+	}{
+		{dto.CreateTaskRequest{Title: "t", DueDate: "tomorrow"}, ErrInvalidDueDate},
+		{dto.CreateTaskRequest{Title: "t", DueDate: "2026-10-19T09:00:00Z", Timezone: "Mars/Base"}, ErrInvalidDueDate},
+		{dto.CreateTaskRequest{Title: "t", Recurrence: "FREQ=WEEKLY"}, entities.ErrRecurrenceWithoutDueDate},
+		{dto.CreateTaskRequest{Title: "t", DueDate: "2026-10-19T09:00:00Z", Recurrence: "FREQ=SOMETIMES"}, value_objects.ErrInvalidRecurrence},
+	}
+	for _, c := range cases {
+		if _, err := uc.Execute(c.req); !errors.Is(err, c.want) {
+			t.Errorf("Execute(%+v): expected %v, got %v", c.req, c.want, err)
+		}
+	}
+}
+
+func TestUpdateStatus_DoneSpawnsNextOccurrence(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	created, err := (&CreateTaskUseCase{Repo: repo}).Execute(dto.CreateTaskRequest{
+		Title:      "Take out the trash",
+		DueDate:    "2026-10-26T07:00:00Z",
+		Timezone:   "Europe/Berlin",
+		Recurrence: "FREQ=WEEKLY;BYDAY=MO",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if created.DueDate != "2026-10-26T08:00:00+01:00" {
+		t.Fatalf("Expected due date in Berlin time, got %s", created.DueDate)
+	}
+
+	uc := &UpdateTaskStatusUseCase{Repo: repo}
+	if err := uc.Execute(created.ID, "done"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	todo, _ := repo.FindByStatus(value_objects.StatusTodo)
+	if len(todo) != 1 {
+		t.Fatalf("Expected the next occurrence to be created, got %d todo tasks", len(todo))
+	}
+	if got := todo[0].DueDate.Format("2006-01-02T15:04:05Z07:00"); got != "2026-11-02T08:00:00+01:00" {
+		t.Errorf("Expected next due date 2026-11-02T08:00:00+01:00, got %s", got)
+	}
+
+	// Re-completing the finished occurrence must not spawn another one.
+	uc.Execute(created.ID, "doing")
+	uc.Execute(created.ID, "done")
+	if todo, _ := repo.FindByStatus(value_objects.StatusTodo); len(todo) != 1 {
+		t.Errorf("Expected a single spawned occurrence, got %d", len(todo))
+	}
+}
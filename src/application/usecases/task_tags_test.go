@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+)
+
+func TestAddTaskTags_Success(t *testing.T) {
+	validId := string(value_objects.NewTaskId())
+	repo := &mockRepoUpdate{found: &entities.Task{ID: value_objects.TaskId(validId), Status: value_objects.StatusTodo}}
+	uc := &AddTaskTagsUseCase{Repo: repo}
+	resp, err := uc.Execute(validId, []string{"Home", "work"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(resp.Tags) != 2 || resp.Tags[0] != "home" {
+		t.Errorf("Expected normalized tags, got %v", resp.Tags)
+	}
+	if repo.lastSaved == nil || len(repo.lastSaved.Tags) != 2 {
+		t.Errorf("Expected task with tags to be saved")
+	}
+}
+
+func TestAddTaskTags_InvalidTag(t *testing.T) {
+	validId := string(value_objects.NewTaskId())
+	repo := &mockRepoUpdate{found: &entities.Task{Status: value_objects.StatusTodo}}
+	uc := &AddTaskTagsUseCase{Repo: repo}
+	_, err := uc.Execute(validId, []string{""})
+	if !errors.Is(err, value_objects.ErrInvalidTag) {
+		t.Errorf("Expected ErrInvalidTag, got %v", err)
+	}
+	if repo.lastSaved != nil {
+		t.Errorf("Expected nothing to be saved")
+	}
+}
+
+func TestRemoveTaskTag_InvalidID(t *testing.T) {
+	uc := &RemoveTaskTagUseCase{Repo: &mockRepoUpdate{}}
+	if err := uc.Execute("nope", "home"); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("Expected ErrInvalidID, got %v", err)
+	}
+}
+
+func TestGetTasksByTags_FiltersStatus(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	todo, _ := entities.NewTask("todo", "")
+	todo.AddTags("home")
+	doing, _ := entities.NewTask("doing", "")
+	doing.AddTags("home")
+	doing.UpdateStatus(value_objects.StatusDoing)
+	repo.Save(todo)
+	repo.Save(doing)
+
+	uc := &GetTasksByTagsUseCase{Repo: repo}
+	all, err := uc.Execute([]string{"HOME"}, true, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(all) != 2 {
+		t.Errorf("Expected 2 tasks, got %d", len(all))
+	}
+	onlyDoing, _ := uc.Execute([]string{"home"}, true, "doing")
+	if len(onlyDoing) != 1 || onlyDoing[0].Title != "doing" {
+		t.Errorf("Expected only the doing task, got %v", onlyDoing)
+	}
+	if _, err := uc.Execute([]string{"home"}, true, "invalid"); !errors.Is(err, entities.ErrInvalidStatus) {
+		t.Errorf("Expected ErrInvalidStatus, got %v", err)
+	}
+}
+
+func TestGetTagStats_OrderedByCount(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	for _, tags := range [][]string{{"b", "a"}, {"a"}, {"c"}} {
+		task, _ := entities.NewTask("t", "")
+		task.AddTags(tags...)
+		repo.Save(task)
+	}
+
+	uc := &GetTagStatsUseCase{Repo: repo}
+	stats, err := uc.Execute()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(stats) != 3 || stats[0].Tag != "a" || stats[0].Count != 2 || stats[1].Tag != "b" || stats[2].Tag != "c" {
+		t.Errorf("Unexpected stats: %v", stats)
+	}
+}
@@ -0,0 +1,158 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+	_ "time/tzdata"
+)
+
+func TestTimers_StartStopAndOverlap(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	workLog := repositories.NewInMemoryWorkLogRepository()
+	first := createTask(t, repo, "first", nil)
+	second := createTask(t, repo, "second", nil)
+	start := &StartTimerUseCase{Repo: repo, WorkLog: workLog}
+	stop := &StopTimerUseCase{WorkLog: workLog}
+
+	if _, err := start.Execute(first.ID, ""); !errors.Is(err, entities.ErrMissingUser) {
+		t.Errorf("expected ErrMissingUser, got %v", err)
+	}
+	if _, err := start.Execute(first.ID, "alice"); err != nil {
+		t.Fatalf("start failed: %v", err)
+	}
+	if _, err := start.Execute(second.ID, "alice"); !errors.Is(err, entities.ErrTimerRunning) {
+		t.Errorf("expected ErrTimerRunning, got %v", err)
+	}
+	if _, err := start.Execute(second.ID, "bob"); err != nil {
+		t.Errorf("expected another user's timer to start, got %v", err)
+	}
+	if _, err := stop.Execute(second.ID, "alice"); !errors.Is(err, entities.ErrTimerNotRunning) {
+		t.Errorf("expected ErrTimerNotRunning for a task without alice's timer, got %v", err)
+	}
+	stopped, err := stop.Execute(first.ID, "alice")
+	if err != nil || stopped.End == "" {
+		t.Fatalf("expected stopped timer, got %+v (err=%v)", stopped, err)
+	}
+	if _, err := start.Execute(second.ID, "alice"); err != nil {
+		t.Errorf("expected a new timer after stopping, got %v", err)
+	}
+}
+
+func TestTimers_RunInUnitOfWork(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	workLog := repositories.NewInMemoryWorkLogRepository()
+	uow := repositories.NewInMemoryUnitOfWork(repo, workLog, repositories.DefaultUndoWindow)
+	task := createTask(t, repo, "task", nil)
+	start := &StartTimerUseCase{Repo: repo, WorkLog: workLog, UnitOfWork: uow}
+	stop := &StopTimerUseCase{WorkLog: workLog, UnitOfWork: uow}
+	logWork := &LogWorkUseCase{Repo: repo, WorkLog: workLog, UnitOfWork: uow}
+
+	if _, err := start.Execute(task.ID, "alice"); err != nil {
+		t.Fatalf("start failed: %v", err)
+	}
+	if _, err := stop.Execute(task.ID, "alice"); err != nil {
+		t.Fatalf("stop failed: %v", err)
+	}
+	req := dto.LogWorkRequest{TaskID: task.ID, User: "alice", Start: "2024-01-01T09:00:00Z", End: "2024-01-01T10:00:00Z"}
+	if _, err := logWork.Execute(req); err != nil {
+		t.Fatalf("log failed: %v", err)
+	}
+	if _, err := logWork.Execute(req); !errors.Is(err, entities.ErrOverlappingWork) {
+		t.Errorf("expected ErrOverlappingWork, got %v", err)
+	}
+	if entries, _ := workLog.FindByTask(value_objects.TaskId(task.ID)); len(entries) != 2 {
+		t.Errorf("expected the timer and the logged entry, got %+v", entries)
+	}
+}
+
+func TestUpdateStatus_StartsAndStopsTimer(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	workLog := repositories.NewInMemoryWorkLogRepository()
+	task := createTask(t, repo, "paint", nil)
+	uc := &UpdateTaskStatusUseCase{Repo: repo, WorkLog: workLog}
+
+	err := uc.ExecuteRequest(dto.UpdateStatusRequest{TaskID: task.ID, NewStatus: "doing", Actor: "alice", StartTimer: true})
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if running, _ := workLog.FindRunning("alice"); running == nil || string(running.TaskID) != task.ID {
+		t.Fatalf("expected a running timer on the task, got %+v", running)
+	}
+	if err := uc.Execute(task.ID, "done"); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if running, _ := workLog.FindRunning("alice"); running != nil {
+		t.Errorf("expected leaving doing to stop the timer, got %+v", running)
+	}
+}
+
+func TestUpdateStatus_RejectedTimerKeepsStatus(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	workLog := repositories.NewInMemoryWorkLogRepository()
+	busy := createTask(t, repo, "busy", nil)
+	task := createTask(t, repo, "paint", nil)
+	(&StartTimerUseCase{Repo: repo, WorkLog: workLog}).Execute(busy.ID, "alice")
+
+	uc := &UpdateTaskStatusUseCase{Repo: repo, WorkLog: workLog}
+	err := uc.ExecuteRequest(dto.UpdateStatusRequest{TaskID: task.ID, NewStatus: "doing", Actor: "alice", StartTimer: true})
+	if !errors.Is(err, entities.ErrTimerRunning) {
+		t.Fatalf("expected ErrTimerRunning, got %v", err)
+	}
+	stored, _ := repo.FindById(value_objects.TaskId(task.ID))
+	if stored.Status != value_objects.StatusTodo {
+		t.Errorf("expected status to stay todo, got %s", stored.Status)
+	}
+}
+
+func TestTimeReport_SplitsDaysInTimezone(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	workLog := repositories.NewInMemoryWorkLogRepository()
+	paint := createTask(t, repo, "paint", nil)
+	clean := createTask(t, repo, "clean", nil)
+	logWork := &LogWorkUseCase{Repo: repo, WorkLog: workLog}
+
+	// 23:00-01:00 in Berlin (UTC+2) spans two local days.
+	entries := []dto.LogWorkRequest{
+		{TaskID: paint.ID, User: "alice", Start: "2026-10-05T21:00:00Z", End: "2026-10-05T23:00:00Z"},
+		{TaskID: clean.ID, User: "alice", Start: "2026-10-06T08:00:00Z", End: "2026-10-06T08:30:00Z"},
+		{TaskID: clean.ID, User: "bob", Start: "2026-10-06T08:00:00Z", End: "2026-10-06T09:00:00Z"},
+	}
+	for _, req := range entries {
+		if _, err := logWork.Execute(req); err != nil {
+			t.Fatalf("log work failed: %v", err)
+		}
+	}
+	overlapping := dto.LogWorkRequest{TaskID: paint.ID, User: "alice", Start: "2026-10-06T08:15:00Z", End: "2026-10-06T08:45:00Z"}
+	if _, err := logWork.Execute(overlapping); !errors.Is(err, entities.ErrOverlappingWork) {
+		t.Fatalf("expected ErrOverlappingWork, got %v", err)
+	}
+
+	report, err := (&GetTimeReportUseCase{Repo: repo, WorkLog: workLog}).Execute(dto.TimeReportRequest{User: "alice", Timezone: "Europe/Berlin"})
+	if err != nil {
+		t.Fatalf("report failed: %v", err)
+	}
+	if report.TotalSeconds != 9000 {
+		t.Errorf("expected 2h30m in total, got %ds", report.TotalSeconds)
+	}
+	if len(report.ByTask) != 2 || report.ByTask[0].Title != "paint" || report.ByTask[0].Seconds != 7200 {
+		t.Errorf("unexpected per-task totals %+v", report.ByTask)
+	}
+	want := []dto.DayTimeResponse{{Date: "2026-10-05", Seconds: 3600}, {Date: "2026-10-06", Seconds: 5400}}
+	if len(report.ByDay) != 2 || report.ByDay[0] != want[0] || report.ByDay[1] != want[1] {
+		t.Errorf("expected %+v, got %+v", want, report.ByDay)
+	}
+
+	report, _ = (&GetTimeReportUseCase{Repo: repo, WorkLog: workLog}).Execute(dto.TimeReportRequest{
+		User: "alice", From: "2026-10-06", To: "2026-10-06", Timezone: "Europe/Berlin",
+	})
+	if report.TotalSeconds != 5400 {
+		t.Errorf("expected the range to clip the entry crossing midnight, got %ds", report.TotalSeconds)
+	}
+	if _, err := (&GetTimeReportUseCase{Repo: repo, WorkLog: workLog}).Execute(dto.TimeReportRequest{From: "2026-10-07", To: "2026-10-06"}); !errors.Is(err, ErrInvalidReportRange) {
+		t.Errorf("expected ErrInvalidReportRange, got %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"clean-architecture-golang/infrastructure/storage"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDeleteTask_MovesToTrashAndRestores(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	parent := createTask(t, repo, "parent", nil)
+	child := createTask(t, repo, "child", parent)
+	other := createTask(t, repo, "other", nil)
+	if err := (&AddTaskBlockerUseCase{Repo: repo}).Execute(other.ID, parent.ID); err != nil {
+		t.Fatalf("add blocker failed: %v", err)
+	}
+
+	uc := &DeleteTaskUseCase{Repo: repo, Hierarchy: repo, Dependencies: repo, Trash: repo}
+	if err := uc.Execute(parent.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := repo.FindById(value_objects.TaskId(parent.ID)); !errors.Is(err, repositories.ErrNotFound) {
+		t.Errorf("expected trashed task to be hidden, got %v", err)
+	}
+	assertColumn(t, repo, value_objects.StatusTodo, "child", "other")
+	if task, _ := repo.FindById(value_objects.TaskId(child.ID)); task.ParentID != "" {
+		t.Errorf("expected subtask to be detached, got parent %q", task.ParentID)
+	}
+	if task, _ := repo.FindById(value_objects.TaskId(other.ID)); len(task.BlockedBy) != 0 {
+		t.Errorf("expected dependent to be unblocked, got %v", task.BlockedBy)
+	}
+
+	trash, err := (&ListTrashUseCase{Trash: repo}).Execute()
+	if err != nil || len(trash) != 1 || trash[0].ID != parent.ID || trash[0].DeletedAt == "" {
+		t.Fatalf("unexpected trash %+v (err=%v)", trash, err)
+	}
+
+	restore := &RestoreTaskUseCase{Repo: repo, Trash: repo}
+	restored, err := restore.Execute(parent.ID)
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if restored.DeletedAt != "" {
+		t.Errorf("expected DeletedAt to be cleared, got %q", restored.DeletedAt)
+	}
+	assertColumn(t, repo, value_objects.StatusTodo, "child", "other", "parent")
+	if _, err := restore.Execute(parent.ID); !errors.Is(err, repositories.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a task not in the trash, got %v", err)
+	}
+}
+
+func TestRestoreTask_DropsMissingReferences(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	parent := createTask(t, repo, "parent", nil)
+	child := createTask(t, repo, "child", parent)
+	uc := &DeleteTaskUseCase{Repo: repo, Hierarchy: repo, Dependencies: repo, Trash: repo}
+	uc.Execute(child.ID)
+	uc.Execute(parent.ID)
+	repo.Purge(value_objects.TaskId(parent.ID))
+
+	restored, err := (&RestoreTaskUseCase{Repo: repo, Trash: repo}).Execute(child.ID)
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if restored.ParentID != "" {
+		t.Errorf("expected the purged parent to be dropped, got %q", restored.ParentID)
+	}
+}
+
+func TestRestoreTask_DetachesOpenSubtaskOfCompletedParent(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	parent := createTask(t, repo, "parent", nil)
+	child := createTask(t, repo, "child", parent)
+	(&DeleteTaskUseCase{Repo: repo, Hierarchy: repo, Dependencies: repo, Trash: repo}).Execute(child.ID)
+	update := &UpdateTaskStatusUseCase{Repo: repo, Hierarchy: repo}
+	if err := update.Execute(parent.ID, "done"); err != nil {
+		t.Fatalf("complete failed: %v", err)
+	}
+
+	restored, err := (&RestoreTaskUseCase{Repo: repo, Trash: repo}).Execute(child.ID)
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if restored.ParentID != "" {
+		t.Errorf("expected the open subtask to leave its completed parent, got %q", restored.ParentID)
+	}
+	if children, _ := repo.FindChildren(value_objects.TaskId(parent.ID)); len(children) != 0 {
+		t.Errorf("expected the completed parent to have no open subtasks, got %d", len(children))
+	}
+}
+
+// failingProjects fails every lookup with an error other than ports.ErrProjectNotFound.
+type failingProjects struct {
+	*repositories.InMemoryProjectRepository
+}
+
+func (failingProjects) FindById(value_objects.ProjectId) (*entities.Project, error) {
+	return nil, errProjectsDown
+}
+
+var errProjectsDown = errors.New("projects unavailable")
+
+func TestRestoreTask_KeepsProjectOnLookupFailure(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	projects := repositories.NewInMemoryProjectRepository()
+	project, _ := entities.NewProject("home", "", entities.ProjectSettings{})
+	projects.Save(project)
+	task, err := (&CreateTaskUseCase{Repo: repo, Projects: projects}).Execute(dto.CreateTaskRequest{Title: "mow", ProjectID: string(project.ID)})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	(&DeleteTaskUseCase{Repo: repo, Trash: repo}).Execute(task.ID)
+
+	restore := &RestoreTaskUseCase{Repo: repo, Trash: repo, Projects: failingProjects{projects}}
+	if _, err := restore.Execute(task.ID); !errors.Is(err, errProjectsDown) {
+		t.Fatalf("expected the lookup error, got %v", err)
+	}
+	trashed, err := repo.FindTrashedById(value_objects.TaskId(task.ID))
+	if err != nil || trashed.ProjectID != project.ID {
+		t.Fatalf("expected the task to stay trashed in its project, got %+v %v", trashed, err)
+	}
+
+	restore.Projects = projects
+	if restored, err := restore.Execute(task.ID); err != nil || restored.ProjectID != string(project.ID) {
+		t.Errorf("expected the task back in its project, got %+v %v", restored, err)
+	}
+}
+
+func TestRestoreTask_RefusesToExceedWIPLimits(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	uow := repositories.NewInMemoryUnitOfWork(repo, nil, repositories.DefaultUndoWindow)
+	limits := entities.WIPLimits{value_objects.StatusDoing: {Max: 1}}
+	update := &UpdateTaskStatusUseCase{Repo: repo, WIP: repo, Limits: limits}
+	first := createTask(t, repo, "first", nil)
+	second := createTask(t, repo, "second", nil)
+	update.Execute(first.ID, "doing")
+	(&DeleteTaskUseCase{Repo: repo, Trash: repo}).Execute(first.ID)
+	update.Execute(second.ID, "doing")
+
+	restore := &RestoreTaskUseCase{Repo: repo, Trash: repo, Limits: limits, UnitOfWork: uow}
+	if _, err := restore.Execute(first.ID); !errors.Is(err, entities.ErrWIPLimitReached) {
+		t.Fatalf("expected ErrWIPLimitReached, got %v", err)
+	}
+	if _, err := repo.FindTrashedById(value_objects.TaskId(first.ID)); err != nil {
+		t.Errorf("expected the task to stay in the trash, got %v", err)
+	}
+	assertColumn(t, repo, value_objects.StatusDoing, "second")
+}
+
+func TestPurgeTrash_DeletesExpiredTasksAndTheirData(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	comments := repositories.NewInMemoryCommentRepository()
+	blobs := newBlobStore(t)
+	task := createTask(t, repo, "old", nil)
+	(&AddCommentUseCase{Repo: repo, Comments: comments}).Execute(task.ID, "alice", "done already")
+	file, _ := (&UploadAttachmentUseCase{Repo: repo, Blobs: blobs, Attachments: repo}).Execute(task.ID, "a.txt", strings.NewReader("a"))
+
+	del := &DeleteTaskUseCase{Repo: repo, Comments: comments, Blobs: blobs, Attachments: repo, Trash: repo}
+	if err := del.Execute(task.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := blobs.Open(file.Digest); err != nil {
+		t.Fatalf("expected the blob of a trashed task to be kept, got %v", err)
+	}
+
+	purge := &PurgeTrashUseCase{Trash: repo, Comments: comments, Blobs: blobs, Attachments: repo, Retention: time.Hour}
+	if purged, err := purge.Execute(time.Now()); err != nil || purged != 0 {
+		t.Fatalf("expected nothing to expire yet, purged %d (err=%v)", purged, err)
+	}
+	if purged, err := purge.Execute(time.Now().Add(2 * time.Hour)); err != nil || purged != 1 {
+		t.Fatalf("expected one purged task, got %d (err=%v)", purged, err)
+	}
+	if _, err := repo.FindTrashedById(value_objects.TaskId(task.ID)); !errors.Is(err, repositories.ErrNotFound) {
+		t.Errorf("expected the task to leave the trash, got %v", err)
+	}
+	if count, _ := comments.CountByTask(value_objects.TaskId(task.ID)); count != 0 {
+		t.Errorf("expected comments to be purged, got %d", count)
+	}
+	if _, err := blobs.Open(file.Digest); !errors.Is(err, storage.ErrBlobNotFound) {
+		t.Errorf("expected the blob to be collected, got %v", err)
+	}
+}
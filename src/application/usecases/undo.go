@@ -0,0 +1,80 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"time"
+)
+
+// UndoUseCase handles reverting an operation by the undo token it returned.
+// When Status is set, the restored tasks must still fit with the other tasks: a task put back into
+// another status column must pass the rules of Status involving its blockers, subtasks and parent, and
+// its WIP limits, and a task put back into a project needs the project to exist when Status has
+// Projects. Otherwise the undo is rolled back and the error of the failed check returned. The task's
+// own transition rules do not apply, as the undo restores its previous state exactly; a completion can
+// be undone.
+type UndoUseCase struct {
+	UnitOfWork ports.UndoableUnitOfWork
+	Status     *UpdateTaskStatusUseCase
+}
+
+// Execute restores the records changed by the operation and returns a token for redoing it.
+func (uc *UndoUseCase) Execute(token string) (string, error) {
+	if uc.Status == nil {
+		return uc.UnitOfWork.Undo(token, time.Now(), nil)
+	}
+	return uc.UnitOfWork.Undo(token, time.Now(), func(stores ports.Stores, reverted []ports.RevertedTask) error {
+		status := uc.Status.within(stores)
+		for _, task := range reverted {
+			if err := status.checkRestored(task.Current, task.Restored); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// checkRestored checks a task put back by an undo against the rules of a status change from the
+// current task, nil when the task was not active, that involve other tasks. Nothing is checked when
+// the restored task is not active, nor the column of a task staying in it.
+func (uc *UpdateTaskStatusUseCase) checkRestored(current, restored *entities.Task) error {
+	if restored == nil {
+		return nil
+	}
+	if uc.Projects != nil && restored.ProjectID != "" && (current == nil || current.ProjectID != restored.ProjectID) {
+		if _, err := uc.Projects.FindById(restored.ProjectID); err != nil {
+			return err
+		}
+	}
+	if current != nil && current.Status == restored.Status {
+		return nil
+	}
+	if current != nil {
+		rules, err := uc.relationRules(restored)
+		if err != nil {
+			return err
+		}
+		for _, rule := range rules {
+			if err := rule(current, restored.Status); err != nil {
+				return err
+			}
+		}
+	}
+	if uc.WIP == nil || len(uc.Limits) == 0 {
+		return nil
+	}
+	column, err := uc.Repo.FindByStatus(restored.Status)
+	if err != nil {
+		return err
+	}
+	return uc.Limits.Check(restored, column)
+}
+
+// runUndoable runs fn in the unit of work and returns a token for undoing what it committed when the
+// unit of work can record it, and an empty token otherwise.
+func runUndoable(unitOfWork ports.UnitOfWork, fn func(stores ports.Stores) error) (string, error) {
+	if undoable, ok := unitOfWork.(ports.UndoableUnitOfWork); ok {
+		return undoable.DoUndoable(fn, time.Now())
+	}
+	return "", unitOfWork.Do(fn)
+}
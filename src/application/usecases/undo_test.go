@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"testing"
+)
+
+func TestUndo_RefusesToExceedWIPLimits(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	uow := repositories.NewInMemoryUnitOfWork(repo, nil, repositories.DefaultUndoWindow)
+	update := &UpdateTaskStatusUseCase{Repo: repo, WIP: repo, Limits: entities.WIPLimits{value_objects.StatusDoing: {Max: 1}},
+		UnitOfWork: uow}
+	undo := &UndoUseCase{UnitOfWork: uow, Status: update}
+	first := createTask(t, repo, "first", nil)
+	second := createTask(t, repo, "second", nil)
+
+	if err := update.Execute(first.ID, "doing"); err != nil {
+		t.Fatalf("start failed: %v", err)
+	}
+	token, err := update.ExecuteUndoable(dto.UpdateStatusRequest{TaskID: first.ID, NewStatus: "todo"})
+	if err != nil {
+		t.Fatalf("stop failed: %v", err)
+	}
+	if err := update.Execute(second.ID, "doing"); err != nil {
+		t.Fatalf("start failed: %v", err)
+	}
+	if _, err := undo.Execute(token); !errors.Is(err, entities.ErrWIPLimitReached) {
+		t.Fatalf("expected ErrWIPLimitReached, got %v", err)
+	}
+	if task, _ := repo.FindById(value_objects.TaskId(first.ID)); task.Status != value_objects.StatusTodo {
+		t.Errorf("expected the refused undo to leave the task in todo, got %s", task.Status)
+	}
+}
+
+func TestUndo_RefusesToRestoreIntoDeletedProject(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	projects := repositories.NewInMemoryProjectRepository()
+	uow := repositories.NewInMemoryUnitOfWork(repo, nil, repositories.DefaultUndoWindow)
+	undo := &UndoUseCase{UnitOfWork: uow, Status: &UpdateTaskStatusUseCase{Repo: repo, Projects: projects}}
+	home, _ := (&CreateProjectUseCase{Projects: projects}).Execute(dto.ProjectRequest{Name: "Home"})
+	paint, _ := (&CreateTaskUseCase{Repo: repo, Projects: projects}).Execute(dto.CreateTaskRequest{Title: "paint", ProjectID: home.ID})
+
+	token, err := (&DeleteTaskUseCase{Repo: repo, Trash: repo, UnitOfWork: uow}).ExecuteUndoable(paint.ID)
+	if err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if err := projects.Delete(value_objects.ProjectId(home.ID)); err != nil {
+		t.Fatalf("delete project failed: %v", err)
+	}
+	if _, err := undo.Execute(token); !errors.Is(err, repositories.ErrProjectNotFound) {
+		t.Fatalf("expected ErrProjectNotFound, got %v", err)
+	}
+	if _, err := repo.FindById(value_objects.TaskId(paint.ID)); !errors.Is(err, repositories.ErrNotFound) {
+		t.Errorf("expected the refused undo to leave the task deleted, got %v", err)
+	}
+}
+
+func TestUndo_RevertsCompletion(t *testing.T) {
+	for _, from := range []string{"todo", "doing"} {
+		repo := repositories.NewInMemoryTaskRepository()
+		uow := repositories.NewInMemoryUnitOfWork(repo, nil, repositories.DefaultUndoWindow)
+		update := &UpdateTaskStatusUseCase{Repo: repo, Hierarchy: repo, UnitOfWork: uow}
+		undo := &UndoUseCase{UnitOfWork: uow, Status: update}
+		task := createTask(t, repo, "task", nil)
+		if from == "doing" {
+			update.Execute(task.ID, "doing")
+		}
+		before, _ := repo.FindById(value_objects.TaskId(task.ID))
+
+		token, err := update.ExecuteUndoable(dto.UpdateStatusRequest{TaskID: task.ID, NewStatus: "done"})
+		if err != nil {
+			t.Fatalf("complete failed: %v", err)
+		}
+		if _, err := undo.Execute(token); err != nil {
+			t.Fatalf("undo of %s to done failed: %v", from, err)
+		}
+		got, _ := repo.FindById(value_objects.TaskId(task.ID))
+		if string(got.Status) != from || len(got.History) != len(before.History) {
+			t.Errorf("expected the task back in %s with its history, got %s (history %+v)", from, got.Status, got.History)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+)
+
+// UpdateProjectUseCase handles changing the name, description and settings of a project.
+// New settings apply to tasks created or moved afterwards; existing tasks keep their tags.
+type UpdateProjectUseCase struct {
+	Projects ports.ProjectRepository
+	Tasks    ports.TaskProjectIndex
+}
+
+// Execute replaces the name, description and settings of the project identified by req.ID.
+func (uc *UpdateProjectUseCase) Execute(req dto.ProjectRequest) (*dto.ProjectResponse, error) {
+	project, err := findProject(uc.Projects, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := entities.NewProjectSettings(req.DefaultTags, req.StartTimers)
+	if err != nil {
+		return nil, err
+	}
+	if err := project.Update(req.Name, req.Description, settings); err != nil {
+		return nil, err
+	}
+	if err := uc.Projects.Save(project); err != nil {
+		return nil, err
+	}
+	response, err := projectResponse(uc.Tasks, project)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
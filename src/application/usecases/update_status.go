@@ -1,35 +1,269 @@
 package usecases
 
 import (
+	"clean-architecture-golang/application/dto"
 	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
 	"clean-architecture-golang/domain/value_objects"
 	"errors"
+	"time"
 )
 
 // Package-level errors for usecases
 var ErrInvalidID = errors.New("invalid id")
 
 // UpdateTaskStatusUseCase handles updating the status of existing tasks.
+// A task cannot be started while one of its blockers is open.
+// When Hierarchy is set, a task cannot be completed while it has open subtasks, nor reopened while
+// its parent is completed.
+// When RequireChecklist is set, a task cannot be completed while it has unchecked checklist items.
+// Completing a recurring task spawns its next occurrence.
+// When WorkLog is set, timers can be started on the move to doing, and running timers
+// on the task are stopped when it leaves doing.
+// When Projects is set, tasks of projects with StartTimers get a timer on the move to doing as if requested,
+// unless the actor is unknown or already has a running timer.
+// When WIP is set, a task cannot enter a status column that already holds the number of tasks
+// allowed by Limits.
+// When UnitOfWork is set, the tasks and timers changed by a move are saved atomically.
 type UpdateTaskStatusUseCase struct {
-	Repo ports.TaskRepository
+	Repo      ports.TaskRepository
+	Hierarchy ports.TaskHierarchy
+	WorkLog   ports.WorkLogRepository
+	Projects  ports.ProjectRepository
+	WIP       ports.TaskWIPGuard
+	Limits    entities.WIPLimits
+	// RequireChecklist enables the entities.ChecklistComplete rule.
+	RequireChecklist bool
+	UnitOfWork       ports.UnitOfWork
 }
 
 // Execute updates the status of a task identified by its string ID.
 // Validates the new status and enforces business rules.
 // Returns an error if the task is not found, status is invalid, or transition is not allowed.
 func (uc *UpdateTaskStatusUseCase) Execute(idStr string, statusStr string) error {
-	parsedId, err := value_objects.ParseTaskId(idStr)
+	return uc.ExecuteRequest(dto.UpdateStatusRequest{TaskID: idStr, NewStatus: statusStr})
+}
+
+// ExecuteRequest updates the status of a task like Execute and applies the time tracking options.
+// A task entering a new status column is placed at its bottom.
+func (uc *UpdateTaskStatusUseCase) ExecuteRequest(req dto.UpdateStatusRequest) error {
+	_, err := uc.ExecuteUndoable(req)
+	return err
+}
+
+// ExecuteUndoable updates the status of a task like ExecuteRequest and returns a token for undoing
+// the change, which is empty unless UnitOfWork can record it.
+func (uc *UpdateTaskStatusUseCase) ExecuteUndoable(req dto.UpdateStatusRequest) (string, error) {
+	if uc.UnitOfWork != nil {
+		return runUndoable(uc.UnitOfWork, func(stores ports.Stores) error {
+			return uc.within(stores).ExecuteRequest(req)
+		})
+	}
+	task, previous, next, err := uc.transition(req)
+	if err != nil {
+		return "", err
+	}
+	var renumbered []*entities.Task
+	if task.Status != previous {
+		if renumbered, err = placeInColumn(uc.Repo, task, "", ""); err != nil {
+			return "", err
+		}
+	}
+	return "", uc.commit(task, previous, next, renumbered, req)
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+// The work log stays outside the unit of work when it does not cover tracked work.
+func (uc *UpdateTaskStatusUseCase) within(stores ports.Stores) *UpdateTaskStatusUseCase {
+	update := *uc
+	update.UnitOfWork = nil
+	update.Repo = stores.Tasks
+	if update.Hierarchy != nil {
+		update.Hierarchy = stores.Tasks
+	}
+	if update.WIP != nil {
+		update.WIP = stores.Tasks
+	}
+	if update.WorkLog != nil && stores.WorkLog != nil {
+		update.WorkLog = stores.WorkLog
+	}
+	return &update
+}
+
+// transition loads the task and changes its status, enforcing the business rules.
+// It returns the previous status and the next occurrence spawned by completing a recurring task;
+// nothing is saved yet.
+func (uc *UpdateTaskStatusUseCase) transition(req dto.UpdateStatusRequest) (task *entities.Task, previous value_objects.TaskStatus, next *entities.Task, err error) {
+	parsedId, err := value_objects.ParseTaskId(req.TaskID)
+	if err != nil {
+		return nil, "", nil, ErrInvalidID
+	}
+	task, err = uc.Repo.FindById(parsedId)
 	if err != nil {
-		return ErrInvalidID
+		return nil, "", nil, err
 	}
-	task, err := uc.Repo.FindById(parsedId)
+	newStatus := value_objects.TaskStatus(req.NewStatus)
+	rules, err := uc.rules(task)
 	if err != nil {
+		return nil, "", nil, err
+	}
+	previous = task.Status
+	if err := task.UpdateStatusBy(req.Actor, newStatus, rules...); err != nil {
+		return nil, "", nil, err
+	}
+	if previous != value_objects.StatusDone && newStatus == value_objects.StatusDone {
+		next, _ = task.SpawnNextOccurrence()
+	}
+	return task, previous, next, nil
+}
+
+// rules builds the status rules a transition of the task must pass.
+func (uc *UpdateTaskStatusUseCase) rules(task *entities.Task) ([]entities.StatusRule, error) {
+	rules, err := uc.relationRules(task)
+	if err != nil {
+		return nil, err
+	}
+	if uc.RequireChecklist {
+		rules = append(rules, entities.ChecklistComplete)
+	}
+	return rules, nil
+}
+
+// relationRules builds the status rules involving other tasks: its blockers, subtasks and parent.
+func (uc *UpdateTaskStatusUseCase) relationRules(task *entities.Task) ([]entities.StatusRule, error) {
+	blockers, err := uc.blockers(task)
+	if err != nil {
+		return nil, err
+	}
+	rules := []entities.StatusRule{entities.BlockersDone(blockers)}
+	if uc.Hierarchy != nil {
+		children, err := uc.Hierarchy.FindChildren(task.ID)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, entities.ChildrenClosed(children))
+		if task.IsSubtask() {
+			parent, err := uc.Repo.FindById(task.ParentID)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, entities.ParentOpen(parent))
+		}
+	}
+	return rules, nil
+}
+
+// commit applies the time tracking side effects of a transition and saves every changed task.
+// The spawned next occurrence goes to the bottom of its column, within the WIP limits of that column.
+func (uc *UpdateTaskStatusUseCase) commit(task *entities.Task, previous value_objects.TaskStatus, next *entities.Task, renumbered []*entities.Task, req dto.UpdateStatusRequest) error {
+	if err := uc.save(task, previous, renumbered, req); err != nil {
 		return err
 	}
-	newStatus := value_objects.TaskStatus(statusStr)
-	err = task.UpdateStatus(newStatus)
+	if next == nil {
+		return nil
+	}
+	renumbered, err := placeInColumn(uc.Repo, next, "", "")
 	if err != nil {
 		return err
 	}
+	return saveInColumn(uc.Repo, uc.WIP, uc.Limits, next, renumbered)
+}
+
+// save tracks time and saves the task with its renumbered column. A task entering a limited
+// column is checked and saved in one atomic step.
+func (uc *UpdateTaskStatusUseCase) save(task *entities.Task, previous value_objects.TaskStatus, renumbered []*entities.Task, req dto.UpdateStatusRequest) error {
+	if uc.WIP != nil && len(uc.Limits) > 0 && task.Status != previous {
+		trackTime := func() error { return uc.trackTime(task, previous, req) }
+		return uc.WIP.SaveWithinLimits(uc.Limits, trackTime, append([]*entities.Task{task}, renumbered...)...)
+	}
+	if err := uc.trackTime(task, previous, req); err != nil {
+		return err
+	}
+	if err := saveAll(uc.Repo, renumbered...); err != nil {
+		return err
+	}
 	return uc.Repo.Save(task)
 }
+
+// trackTime starts the actor's timer when the task enters doing, and stops the timers
+// running on the task when it leaves doing. The timer is saved before the task so a
+// rejected timer leaves the status unchanged.
+func (uc *UpdateTaskStatusUseCase) trackTime(task *entities.Task, previous value_objects.TaskStatus, req dto.UpdateStatusRequest) error {
+	if uc.WorkLog == nil || previous == task.Status {
+		return nil
+	}
+	now := time.Now()
+	startTimer, err := uc.startsTimer(task, req)
+	if err != nil {
+		return err
+	}
+	if task.Status == value_objects.StatusDoing && startTimer {
+		entry, err := entities.StartTimer(task.ID, req.Actor, now)
+		if err != nil {
+			return err
+		}
+		return uc.WorkLog.Save(entry)
+	}
+	if previous != value_objects.StatusDoing {
+		return nil
+	}
+	entries, err := uc.WorkLog.FindByTask(task.ID)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsRunning() {
+			continue
+		}
+		if err := entry.Stop(now); err != nil {
+			return err
+		}
+		if err := uc.WorkLog.Save(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startsTimer reports whether a move to doing starts a timer, either on request or by project setting.
+func (uc *UpdateTaskStatusUseCase) startsTimer(task *entities.Task, req dto.UpdateStatusRequest) (bool, error) {
+	if req.StartTimer {
+		return true, nil
+	}
+	if uc.Projects == nil || task.ProjectID == "" || req.Actor == "" || task.Status != value_objects.StatusDoing {
+		return false, nil
+	}
+	project, err := uc.Projects.FindById(task.ProjectID)
+	if errors.Is(err, ports.ErrProjectNotFound) {
+		// A task whose project no longer exists follows no project settings.
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !project.Settings.StartTimers {
+		return false, nil
+	}
+	running, err := uc.WorkLog.FindRunning(req.Actor)
+	if err != nil {
+		return false, err
+	}
+	return running == nil, nil
+}
+
+// blockers loads the tasks the given task is blocked by. Blockers that no longer exist, such as
+// purged tasks, no longer block anything and are skipped.
+func (uc *UpdateTaskStatusUseCase) blockers(task *entities.Task) ([]*entities.Task, error) {
+	blockers := make([]*entities.Task, 0, len(task.BlockedBy))
+	for _, id := range task.BlockedBy {
+		blocker, err := uc.Repo.FindById(id)
+		if errors.Is(err, ports.ErrTaskNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		blockers = append(blockers, blocker)
+	}
+	return blockers, nil
+}
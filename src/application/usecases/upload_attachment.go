@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"io"
+)
+
+// UploadAttachmentUseCase handles attaching a file to a task.
+// When UnitOfWork is set, the task is read again and saved in a unit of work once the file is stored,
+// so changes made to it during the upload are kept.
+type UploadAttachmentUseCase struct {
+	Repo        ports.TaskRepository
+	Blobs       ports.BlobStore
+	Attachments ports.TaskAttachmentIndex
+	UnitOfWork  ports.UnitOfWork
+}
+
+// Execute stores content in the blob store and records it as an attachment named filename.
+// Returns the attachment metadata or an error if the task is not found or the file is rejected.
+func (uc *UploadAttachmentUseCase) Execute(taskIdStr string, filename string, content io.Reader) (*dto.AttachmentResponse, error) {
+	taskId, err := value_objects.ParseTaskId(taskIdStr)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	if _, err := uc.Repo.FindById(taskId); err != nil {
+		return nil, err
+	}
+	blob, err := uc.Blobs.Put(content)
+	if err != nil {
+		return nil, err
+	}
+	var attachment *entities.Attachment
+	if uc.UnitOfWork != nil {
+		err = uc.UnitOfWork.Do(func(stores ports.Stores) error {
+			attachment, err = uc.within(stores).attach(taskId, filename, blob)
+			return err
+		})
+	} else {
+		attachment, err = uc.attach(taskId, filename, blob)
+	}
+	if err != nil {
+		// Drop the blob again unless another attachment already shared it.
+		if gcErr := collectBlobs(uc.Attachments, uc.Blobs, "", []string{blob.Digest}); gcErr != nil {
+			return nil, gcErr
+		}
+		return nil, err
+	}
+	response := dto.ToAttachmentResponse(*attachment)
+	return &response, nil
+}
+
+// within returns a copy of the use case working on the stores of a unit of work.
+func (uc *UploadAttachmentUseCase) within(stores ports.Stores) *UploadAttachmentUseCase {
+	upload := *uc
+	upload.UnitOfWork = nil
+	upload.Repo = stores.Tasks
+	return &upload
+}
+
+// attach reads the task and saves it with the stored blob as a new attachment.
+func (uc *UploadAttachmentUseCase) attach(taskId value_objects.TaskId, filename string, blob ports.BlobInfo) (*entities.Attachment, error) {
+	task, err := uc.Repo.FindById(taskId)
+	if err != nil {
+		return nil, err
+	}
+	attachment, err := task.AddAttachment(filename, blob.ContentType, blob.Size, blob.Digest)
+	if err != nil {
+		return nil, err
+	}
+	return attachment, uc.Repo.Save(task)
+}
@@ -0,0 +1,15 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"strings"
+)
+
+// findView loads a view of the owner, who must be known.
+func findView(views ports.SavedViewRepository, owner, name string) (*entities.SavedView, error) {
+	if strings.TrimSpace(owner) == "" {
+		return nil, entities.ErrMissingViewOwner
+	}
+	return views.FindByName(owner, name)
+}
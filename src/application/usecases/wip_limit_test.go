@@ -0,0 +1,113 @@
+package usecases
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestUpdateTaskStatus_WIPLimitPerUser(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	workLog := repositories.NewInMemoryWorkLogRepository()
+	limits := entities.WIPLimits{value_objects.StatusDoing: {Max: 1, PerUser: true}}
+	uc := &UpdateTaskStatusUseCase{Repo: repo, WorkLog: workLog, WIP: repo, Limits: limits}
+	a := createTask(t, repo, "a", nil)
+	b := createTask(t, repo, "b", nil)
+	c := createTask(t, repo, "c", nil)
+
+	if err := uc.ExecuteRequest(dto.UpdateStatusRequest{TaskID: a.ID, NewStatus: "doing", Actor: "alice"}); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	err := uc.ExecuteRequest(dto.UpdateStatusRequest{TaskID: b.ID, NewStatus: "doing", Actor: "alice", StartTimer: true})
+	if !errors.Is(err, entities.ErrWIPLimitReached) {
+		t.Fatalf("Expected ErrWIPLimitReached, got %v", err)
+	}
+	if task, _ := repo.FindById(value_objects.TaskId(b.ID)); task.Status != value_objects.StatusTodo {
+		t.Errorf("Expected the rejected task to stay in todo, got %s", task.Status)
+	}
+	if running, _ := workLog.FindRunning("alice"); running != nil {
+		t.Errorf("Expected no timer for a rejected move, got %+v", running)
+	}
+	if err := uc.ExecuteRequest(dto.UpdateStatusRequest{TaskID: c.ID, NewStatus: "doing", Actor: "bob"}); err != nil {
+		t.Fatalf("Expected bob to have room in doing, got %v", err)
+	}
+	if err := uc.Execute(a.ID, "done"); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if err := uc.ExecuteRequest(dto.UpdateStatusRequest{TaskID: b.ID, NewStatus: "doing", Actor: "alice"}); err != nil {
+		t.Errorf("Expected room after finishing a task, got %v", err)
+	}
+}
+
+func TestUpdateTaskStatus_WIPLimitConcurrentMoves(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	limits := entities.WIPLimits{value_objects.StatusDoing: {Max: 3}}
+	uc := &UpdateTaskStatusUseCase{Repo: repo, WIP: repo, Limits: limits}
+	var ids []string
+	for i := 0; i < 20; i++ {
+		ids = append(ids, createTask(t, repo, "t", nil).ID)
+	}
+
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			err := uc.Execute(id, "doing")
+			if err != nil && !errors.Is(err, entities.ErrWIPLimitReached) {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	doing, _ := repo.FindByStatus(value_objects.StatusDoing)
+	if len(doing) != 3 {
+		t.Errorf("Expected exactly 3 tasks in doing, got %d", len(doing))
+	}
+}
+
+func TestCreateTask_WIPLimitOnTodo(t *testing.T) {
+	repo := repositories.NewInMemoryTaskRepository()
+	unitOfWork := repositories.NewInMemoryUnitOfWork(repo, nil, repositories.DefaultUndoWindow)
+	limits := entities.WIPLimits{value_objects.StatusTodo: {Max: 2}}
+	create := &CreateTaskUseCase{Repo: repo, WIP: repo, Limits: limits, UnitOfWork: unitOfWork}
+	chores, err := create.Execute(dto.CreateTaskRequest{Title: "chores", DueDate: "2026-10-19T09:00:00Z", Recurrence: "FREQ=WEEKLY"})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if _, err := create.Execute(dto.CreateTaskRequest{Title: "b"}); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	if _, err := create.Execute(dto.CreateTaskRequest{Title: "c"}); !errors.Is(err, entities.ErrWIPLimitReached) {
+		t.Fatalf("Expected ErrWIPLimitReached for a create into a full todo column, got %v", err)
+	}
+	bulk := &BulkCreateTasksUseCase{Create: create, UnitOfWork: unitOfWork}
+	results, err := bulk.Execute(dto.BulkCreateRequest{Tasks: []dto.CreateTaskRequest{{Title: "d"}}})
+	if err != nil || len(results) != 1 || !errors.Is(results[0].Err, entities.ErrWIPLimitReached) {
+		t.Fatalf("Expected the bulk create to hit the limit, got %+v %v", results, err)
+	}
+
+	// Completing the recurring task spawns its next occurrence into the full todo column.
+	update := &UpdateTaskStatusUseCase{Repo: repo, WIP: repo, Limits: limits, UnitOfWork: unitOfWork}
+	if err := update.Execute(chores.ID, "doing"); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if _, err := create.Execute(dto.CreateTaskRequest{Title: "e"}); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if err := update.Execute(chores.ID, "done"); !errors.Is(err, entities.ErrWIPLimitReached) {
+		t.Fatalf("Expected ErrWIPLimitReached for the next occurrence, got %v", err)
+	}
+	if task, _ := repo.FindById(value_objects.TaskId(chores.ID)); task.Status != value_objects.StatusDoing {
+		t.Errorf("Expected the completion to be rolled back, got %s", task.Status)
+	}
+	if todo, _ := repo.FindByStatus(value_objects.StatusTodo); len(todo) != 2 {
+		t.Errorf("Expected 2 tasks in todo, got %d", len(todo))
+	}
+}
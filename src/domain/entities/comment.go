@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxCommentLength is the maximum number of characters in a comment body.
+const MaxCommentLength = 5000
+
+// Sentinel errors for comment business rules
+var (
+	ErrEmptyComment   = fmt.Errorf("%w: comment cannot be empty", ErrInvalidInput)
+	ErrCommentTooLong = fmt.Errorf("%w: comment is too long", ErrInvalidInput)
+)
+
+// Comment represents a message in the discussion thread of a task.
+type Comment struct {
+	ID        value_objects.CommentId
+	TaskID    value_objects.TaskId
+	Author    string
+	Body      string
+	CreatedAt time.Time
+	// UpdatedAt is nil until the comment is edited.
+	UpdatedAt *time.Time
+}
+
+// NewComment creates a new comment on a task with validation.
+// It enforces the business rules that the body is neither blank nor longer than MaxCommentLength.
+func NewComment(taskID value_objects.TaskId, author, body string) (*Comment, error) {
+	if err := validateCommentBody(body); err != nil {
+		return nil, err
+	}
+	return &Comment{
+		ID:        value_objects.NewCommentId(),
+		TaskID:    taskID,
+		Author:    author,
+		Body:      body,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// Edit replaces the body of the comment and records when it happened.
+func (c *Comment) Edit(body string) error {
+	if err := validateCommentBody(body); err != nil {
+		return err
+	}
+	now := time.Now()
+	c.Body = body
+	c.UpdatedAt = &now
+	return nil
+}
+
+func validateCommentBody(body string) error {
+	if strings.TrimSpace(body) == "" {
+		return ErrEmptyComment
+	}
+	if utf8.RuneCountInString(body) > MaxCommentLength {
+		return ErrCommentTooLong
+	}
+	return nil
+}
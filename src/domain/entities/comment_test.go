@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewComment(t *testing.T) {
+	taskID := value_objects.NewTaskId()
+	comment, err := NewComment(taskID, "alice", "Looks good")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if comment.TaskID != taskID || comment.Author != "alice" || comment.Body != "Looks good" {
+		t.Errorf("Unexpected comment: %+v", comment)
+	}
+	if comment.UpdatedAt != nil {
+		t.Errorf("Expected a new comment to have no UpdatedAt")
+	}
+}
+
+func TestNewComment_InvalidBody(t *testing.T) {
+	taskID := value_objects.NewTaskId()
+	if _, err := NewComment(taskID, "alice", "   "); !errors.Is(err, ErrEmptyComment) {
+		t.Errorf("Expected ErrEmptyComment, got %v", err)
+	}
+	if _, err := NewComment(taskID, "alice", strings.Repeat("é", MaxCommentLength+1)); !errors.Is(err, ErrCommentTooLong) {
+		t.Errorf("Expected ErrCommentTooLong, got %v", err)
+	}
+	if _, err := NewComment(taskID, "alice", strings.Repeat("é", MaxCommentLength)); err != nil {
+		t.Errorf("Expected a body of exactly MaxCommentLength runes to be accepted, got %v", err)
+	}
+}
+
+func TestCommentEdit(t *testing.T) {
+	comment, _ := NewComment(value_objects.NewTaskId(), "alice", "first")
+	if err := comment.Edit(""); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+	if comment.Body != "first" || comment.UpdatedAt != nil {
+		t.Errorf("Expected a rejected edit to leave the comment unchanged")
+	}
+	if err := comment.Edit("second"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if comment.Body != "second" || comment.UpdatedAt == nil {
+		t.Errorf("Expected body and UpdatedAt to be set, got %+v", comment)
+	}
+}
@@ -0,0 +1,110 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxProjectNameLength is the maximum number of characters in a project name.
+const MaxProjectNameLength = 100
+
+// Sentinel errors for project business rules
+var (
+	ErrEmptyProjectName   = fmt.Errorf("%w: project name cannot be empty", ErrInvalidInput)
+	ErrProjectNameTooLong = fmt.Errorf("%w: project name is too long", ErrInvalidInput)
+	ErrSubtaskProject     = fmt.Errorf("%w: subtasks belong to the project of their parent", ErrInvalidInput)
+)
+
+// ProjectSettings holds the default workflow of the tasks in a project.
+type ProjectSettings struct {
+	// DefaultTags are added to every task created in the project.
+	DefaultTags []value_objects.Tag
+	// StartTimers starts a timer for the actor whenever a task of the project moves to doing.
+	StartTimers bool
+}
+
+// NewProjectSettings creates project settings, normalizing the default tags like Task.AddTags.
+func NewProjectSettings(defaultTags []string, startTimers bool) (ProjectSettings, error) {
+	settings := ProjectSettings{StartTimers: startTimers}
+	for _, raw := range defaultTags {
+		tag, err := value_objects.NewTag(raw)
+		if err != nil {
+			return ProjectSettings{}, err
+		}
+		if !containsTag(settings.DefaultTags, tag) {
+			settings.DefaultTags = append(settings.DefaultTags, tag)
+		}
+	}
+	if len(settings.DefaultTags) > MaxTagsPerTask {
+		return ProjectSettings{}, ErrTooManyTags
+	}
+	return settings, nil
+}
+
+// Project groups tasks into a list with shared settings.
+type Project struct {
+	ID          value_objects.ProjectId
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	Settings    ProjectSettings
+}
+
+// NewProject creates a new project with validation.
+// It enforces the business rule that the name is neither blank nor longer than MaxProjectNameLength.
+func NewProject(name, description string, settings ProjectSettings) (*Project, error) {
+	name = strings.TrimSpace(name)
+	if err := validateProjectName(name); err != nil {
+		return nil, err
+	}
+	return &Project{
+		ID:          value_objects.NewProjectId(),
+		Name:        name,
+		Description: description,
+		CreatedAt:   time.Now(),
+		Settings:    settings,
+	}, nil
+}
+
+// Update replaces the name, description and settings of the project.
+func (p *Project) Update(name, description string, settings ProjectSettings) error {
+	name = strings.TrimSpace(name)
+	if err := validateProjectName(name); err != nil {
+		return err
+	}
+	p.Name = name
+	p.Description = description
+	p.Settings = settings
+	return nil
+}
+
+// ApplyDefaults adds the default tags of the project to a new task of the project.
+func (p *Project) ApplyDefaults(task *Task) error {
+	raw := make([]string, len(p.Settings.DefaultTags))
+	for i, tag := range p.Settings.DefaultTags {
+		raw[i] = tag.String()
+	}
+	return task.AddTags(raw...)
+}
+
+// MoveToProject makes the task part of the given project; a nil project removes it from its project.
+func (t *Task) MoveToProject(project *Project) {
+	if project == nil {
+		t.ProjectID = ""
+		return
+	}
+	t.ProjectID = project.ID
+}
+
+func validateProjectName(name string) error {
+	if name == "" {
+		return ErrEmptyProjectName
+	}
+	if utf8.RuneCountInString(name) > MaxProjectNameLength {
+		return ErrProjectNameTooLong
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewProject_Validation(t *testing.T) {
+	if _, err := NewProject("  ", "", ProjectSettings{}); !errors.Is(err, ErrEmptyProjectName) {
+		t.Errorf("Expected ErrEmptyProjectName, got %v", err)
+	}
+	if _, err := NewProject(strings.Repeat("x", MaxProjectNameLength+1), "", ProjectSettings{}); !errors.Is(err, ErrProjectNameTooLong) {
+		t.Errorf("Expected ErrProjectNameTooLong, got %v", err)
+	}
+	project, err := NewProject(" Home ", "chores", ProjectSettings{})
+	if err != nil || project.Name != "Home" {
+		t.Fatalf("Expected trimmed name, got %+v (err=%v)", project, err)
+	}
+	if err := project.Update("", "", ProjectSettings{}); !errors.Is(err, ErrEmptyProjectName) {
+		t.Errorf("Expected ErrEmptyProjectName on update, got %v", err)
+	}
+}
+
+func TestProjectSettings_DefaultTags(t *testing.T) {
+	if _, err := NewProjectSettings([]string{""}, false); !errors.Is(err, value_objects.ErrInvalidTag) {
+		t.Errorf("Expected ErrInvalidTag, got %v", err)
+	}
+	settings, err := NewProjectSettings([]string{"Home", "home", "Errands"}, true)
+	if err != nil || len(settings.DefaultTags) != 2 || !settings.StartTimers {
+		t.Fatalf("Unexpected settings %+v (err=%v)", settings, err)
+	}
+
+	project, _ := NewProject("Home", "", settings)
+	task, _ := NewTask("t", "")
+	task.AddTags("errands")
+	task.MoveToProject(project)
+	if err := project.ApplyDefaults(task); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if task.ProjectID != project.ID || len(task.Tags) != 2 || !task.HasTag("home") {
+		t.Errorf("Expected project and default tags, got %+v", task)
+	}
+	task.MoveToProject(nil)
+	if task.ProjectID != "" {
+		t.Errorf("Expected task to leave the project")
+	}
+}
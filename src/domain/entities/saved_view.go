@@ -0,0 +1,149 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaxViewNameLength is the maximum number of characters in the name of a saved view.
+const MaxViewNameLength = 64
+
+// Sentinel errors for saved view business rules
+var (
+	ErrMissingViewOwner = fmt.Errorf("%w: saved views require a user", ErrInvalidInput)
+	ErrInvalidViewName  = fmt.Errorf("%w: view names must be 1 to %d letters, digits, '-' or '_'", ErrInvalidInput, MaxViewNameLength)
+	ErrInvalidViewSort  = fmt.Errorf("%w: invalid view sort", ErrInvalidInput)
+)
+
+// ViewSortField is a task attribute saved views can be sorted by.
+type ViewSortField string
+
+const (
+	SortByCreated ViewSortField = "created"
+	SortByTitle   ViewSortField = "title"
+	SortByStatus  ViewSortField = "status"
+	SortByDue     ViewSortField = "due"
+)
+
+// ViewSort orders the tasks of a saved view.
+type ViewSort struct {
+	Field      ViewSortField
+	Descending bool
+}
+
+// ParseViewSort parses a sort such as "due" or "-created"; a leading "-" sorts in descending order.
+// An empty string sorts by creation time, oldest first.
+func ParseViewSort(s string) (ViewSort, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ViewSort{Field: SortByCreated}, nil
+	}
+	descending := strings.HasPrefix(s, "-")
+	field := ViewSortField(strings.ToLower(strings.TrimPrefix(s, "-")))
+	switch field {
+	case SortByCreated, SortByTitle, SortByStatus, SortByDue:
+		return ViewSort{Field: field, Descending: descending}, nil
+	}
+	return ViewSort{}, fmt.Errorf("%w: %q, expected created, title, status or due with an optional leading '-'", ErrInvalidViewSort, s)
+}
+
+// String returns the sort in the syntax accepted by ParseViewSort.
+func (s ViewSort) String() string {
+	if s.Descending {
+		return "-" + string(s.Field)
+	}
+	return string(s.Field)
+}
+
+// Apply sorts the tasks in place. Ties keep creation order, and tasks without a due date come
+// last when sorting by due date in either direction.
+func (s ViewSort) Apply(tasks []*Task) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		a, b := tasks[i], tasks[j]
+		if s.Field == SortByDue && (a.DueDate == nil) != (b.DueDate == nil) {
+			return b.DueDate == nil
+		}
+		cmp := s.compare(a, b)
+		if s.Descending {
+			cmp = -cmp
+		}
+		if cmp != 0 {
+			return cmp < 0
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+}
+
+func (s ViewSort) compare(a, b *Task) int {
+	switch s.Field {
+	case SortByTitle:
+		return strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+	case SortByStatus:
+		return statusOrder(a.Status) - statusOrder(b.Status)
+	case SortByDue:
+		if a.DueDate == nil {
+			return 0
+		}
+		return a.DueDate.Compare(*b.DueDate)
+	}
+	return a.CreatedAt.Compare(b.CreatedAt)
+}
+
+// SavedView is a named filter with a sort order, saved by a user for reuse.
+// Names are unique per owner.
+type SavedView struct {
+	Owner     string
+	Name      string
+	Filter    value_objects.TaskFilter
+	Sort      ViewSort
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewSavedView validates the name and parses the filter expression and sort of a new view.
+// Filter errors are *value_objects.FilterSyntaxError values.
+func NewSavedView(owner, name, filter, sort string) (*SavedView, error) {
+	if strings.TrimSpace(owner) == "" {
+		return nil, ErrMissingViewOwner
+	}
+	if !isValidViewName(name) {
+		return nil, ErrInvalidViewName
+	}
+	view := &SavedView{Owner: owner, Name: name, CreatedAt: time.Now()}
+	if err := view.Update(filter, sort); err != nil {
+		return nil, err
+	}
+	view.UpdatedAt = view.CreatedAt
+	return view, nil
+}
+
+// Update replaces the filter and sort of the view; the view is left unchanged if either is invalid.
+func (v *SavedView) Update(filter, sort string) error {
+	parsedFilter, err := value_objects.ParseTaskFilter(filter)
+	if err != nil {
+		return err
+	}
+	parsedSort, err := ParseViewSort(sort)
+	if err != nil {
+		return err
+	}
+	v.Filter = parsedFilter
+	v.Sort = parsedSort
+	v.UpdatedAt = time.Now()
+	return nil
+}
+
+func isValidViewName(name string) bool {
+	if name == "" || len(name) > MaxViewNameLength {
+		return false
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"clean-architecture-golang/domain/value_objects"
+)
+
+func TestParseViewSort(t *testing.T) {
+	cases := map[string]ViewSort{
+		"":      {Field: SortByCreated},
+		"due":   {Field: SortByDue},
+		"-DUE":  {Field: SortByDue, Descending: true},
+		"title": {Field: SortByTitle},
+	}
+	for raw, want := range cases {
+		got, err := ParseViewSort(raw)
+		if err != nil || got != want {
+			t.Errorf("ParseViewSort(%q) = %+v, %v; want %+v", raw, got, err, want)
+		}
+	}
+	if _, err := ParseViewSort("priority"); !errors.Is(err, ErrInvalidViewSort) {
+		t.Errorf("expected ErrInvalidViewSort, got %v", err)
+	}
+}
+
+func TestViewSort_DueDateLastWithoutDate(t *testing.T) {
+	base := time.Now()
+	undated := &Task{Title: "undated", CreatedAt: base}
+	later := &Task{Title: "later", CreatedAt: base.Add(time.Second)}
+	laterDue := base.Add(48 * time.Hour)
+	later.SetDueDate(&laterDue)
+	sooner := &Task{Title: "sooner", CreatedAt: base.Add(2 * time.Second)}
+	soonerDue := base.Add(24 * time.Hour)
+	sooner.SetDueDate(&soonerDue)
+
+	for sort, want := range map[string][]string{
+		"due":  {"sooner", "later", "undated"},
+		"-due": {"later", "sooner", "undated"},
+	} {
+		tasks := []*Task{undated, later, sooner}
+		s, _ := ParseViewSort(sort)
+		s.Apply(tasks)
+		for i, task := range tasks {
+			if task.Title != want[i] {
+				t.Errorf("%s: expected %v, got %s at %d", sort, want, task.Title, i)
+			}
+		}
+	}
+}
+
+func TestNewSavedView_Validates(t *testing.T) {
+	view, err := NewSavedView("alice", "my-todo_1", "status:todo", "-created")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if view.Filter.String() != "status:todo" || view.Sort.String() != "-created" {
+		t.Errorf("unexpected view: %+v", view)
+	}
+
+	if _, err := NewSavedView("", "todo", "status:todo", ""); !errors.Is(err, ErrMissingViewOwner) {
+		t.Errorf("expected ErrMissingViewOwner, got %v", err)
+	}
+	for _, name := range []string{"", "with space", "a/b", strings.Repeat("x", MaxViewNameLength+1)} {
+		if _, err := NewSavedView("alice", name, "status:todo", ""); !errors.Is(err, ErrInvalidViewName) {
+			t.Errorf("name %q: expected ErrInvalidViewName, got %v", name, err)
+		}
+	}
+	if _, err := NewSavedView("alice", "todo", "status:", ""); !errors.Is(err, value_objects.ErrInvalidFilter) {
+		t.Errorf("expected ErrInvalidFilter, got %v", err)
+	}
+
+	if err := view.Update("tag:home", "bogus"); !errors.Is(err, ErrInvalidViewSort) {
+		t.Errorf("expected ErrInvalidViewSort, got %v", err)
+	}
+	if view.Filter.String() != "status:todo" {
+		t.Errorf("expected a failed update to leave the view unchanged, got %s", view.Filter)
+	}
+}
@@ -17,15 +17,43 @@ var (
 	ErrEmptyTitle        = fmt.Errorf("%w: title cannot be empty", ErrInvalidInput)
 	ErrInvalidStatus     = fmt.Errorf("%w: invalid status", ErrInvalidInput)
 	ErrInvalidTransition = fmt.Errorf("%w: cannot change status from done to todo", ErrInvalidInput)
+	ErrTooManyTags       = fmt.Errorf("%w: too many tags", ErrInvalidInput)
 )
 
+// MaxTagsPerTask is the maximum number of tags a single task can carry.
+const MaxTagsPerTask = 20
+
 // Task represents a personal task with its core attributes and business rules.
 type Task struct {
 	ID          value_objects.TaskId
 	Title       string
 	Description string
 	Status      value_objects.TaskStatus
+	Priority    value_objects.Priority
 	CreatedAt   time.Time
+	Tags        []value_objects.Tag
+	// ProjectID references the project of the task; it is empty for tasks outside any project.
+	ProjectID value_objects.ProjectId
+	// ParentID references the parent task; it is empty for top-level tasks.
+	ParentID value_objects.TaskId
+	// BlockedBy lists the tasks that must be done before this task can be started.
+	BlockedBy []value_objects.TaskId
+	// DueDate is optional; its location is used for recurrence calculations.
+	DueDate *time.Time
+	// Recurrence is optional; RecurrenceStart is the due date of the first occurrence of the series.
+	Recurrence      *value_objects.RecurrenceRule
+	RecurrenceStart *time.Time
+	Attachments     []Attachment
+	// Checklist lists the checklist items of the task in display order.
+	Checklist []ChecklistItem
+	// History lists every status change, oldest first.
+	History []StatusChange
+	// Rank orders the task within its status column; see value_objects.Rank.
+	Rank value_objects.Rank
+	// DeletedAt is set while the task is in the trash.
+	DeletedAt *time.Time
+	// ArchivedAt is set once the task has been moved to the archive.
+	ArchivedAt *time.Time
 }
 
 // NewTask creates a new task with validation.
@@ -40,20 +68,98 @@ func NewTask(title, description string) (*Task, error) {
 		Title:       title,
 		Description: description,
 		Status:      value_objects.StatusTodo,
+		Priority:    value_objects.DefaultPriority,
 		CreatedAt:   time.Now(),
 	}, nil
 }
 
+// SetPriority changes the priority of the task.
+// Returns value_objects.ErrInvalidPriority if the priority is not one of the allowed levels.
+func (t *Task) SetPriority(priority value_objects.Priority) error {
+	if !priority.IsValid() {
+		return value_objects.ErrInvalidPriority
+	}
+	t.Priority = priority
+	return nil
+}
+
+// StatusRule is an additional business rule checked by UpdateStatus before a transition is applied.
+// Rules that depend on other tasks (e.g. subtasks) are built from those tasks by the caller.
+type StatusRule func(t *Task, newStatus value_objects.TaskStatus) error
+
 // UpdateStatus changes the task status with business rule validation.
-// Prevents invalid status transitions (e.g., DONE to TODO).
+// Prevents invalid status transitions (e.g., DONE to TODO) and applies any extra rules given.
 // Returns an error if the status is invalid or transition is not allowed.
-func (t *Task) UpdateStatus(newStatus value_objects.TaskStatus) error {
+func (t *Task) UpdateStatus(newStatus value_objects.TaskStatus, rules ...StatusRule) error {
+	return t.UpdateStatusBy("", newStatus, rules...)
+}
+
+// UpdateStatusBy changes the task status like UpdateStatus and records the change,
+// made by actor, in the task history. Setting the current status again records nothing.
+func (t *Task) UpdateStatusBy(actor string, newStatus value_objects.TaskStatus, rules ...StatusRule) error {
 	if !newStatus.IsValid() {
 		return ErrInvalidStatus
 	}
 	if t.Status == value_objects.StatusDone && newStatus == value_objects.StatusTodo {
 		return ErrInvalidTransition
 	}
+	for _, rule := range rules {
+		if err := rule(t, newStatus); err != nil {
+			return err
+		}
+	}
+	if newStatus != t.Status {
+		t.History = append(t.History, StatusChange{From: t.Status, To: newStatus, At: time.Now(), Actor: actor})
+	}
 	t.Status = newStatus
 	return nil
 }
+
+// AddTags normalizes the given raw tags and attaches the ones the task does not carry yet.
+// Either all tags are added or none: an invalid tag or exceeding MaxTagsPerTask leaves the task unchanged.
+func (t *Task) AddTags(raw ...string) error {
+	tags := append([]value_objects.Tag(nil), t.Tags...)
+	for _, r := range raw {
+		tag, err := value_objects.NewTag(r)
+		if err != nil {
+			return err
+		}
+		if !containsTag(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) > MaxTagsPerTask {
+		return ErrTooManyTags
+	}
+	t.Tags = tags
+	return nil
+}
+
+// RemoveTag detaches a tag from the task. Removing a tag the task does not carry is a no-op.
+func (t *Task) RemoveTag(raw string) error {
+	tag, err := value_objects.NewTag(raw)
+	if err != nil {
+		return err
+	}
+	for i, existing := range t.Tags {
+		if existing == tag {
+			t.Tags = append(t.Tags[:i:i], t.Tags[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+// HasTag reports whether the task carries the given normalized tag.
+func (t *Task) HasTag(tag value_objects.Tag) bool {
+	return containsTag(t.Tags, tag)
+}
+
+func containsTag(tags []value_objects.Tag, tag value_objects.Tag) bool {
+	for _, existing := range tags {
+		if existing == tag {
+			return true
+		}
+	}
+	return false
+}
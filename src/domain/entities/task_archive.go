@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"fmt"
+	"time"
+)
+
+// Sentinel errors for archiving
+var (
+	ErrArchiveNotDone = fmt.Errorf("%w: only done tasks can be archived", ErrInvalidInput)
+	ErrArchiveSubtask = fmt.Errorf("%w: subtasks are archived with their top-level task", ErrInvalidInput)
+)
+
+// Archive marks a done task as archived at the given time.
+func (t *Task) Archive(at time.Time) error {
+	if t.Status != value_objects.StatusDone {
+		return ErrArchiveNotDone
+	}
+	t.ArchivedAt = &at
+	return nil
+}
+
+// IsArchived reports whether the task has been archived.
+func (t *Task) IsArchived() bool {
+	return t.ArchivedAt != nil
+}
+
+// ArchiveDue reports whether a done task has been done for at least the given duration at now.
+// Tasks without a recorded completion count from their creation.
+func (t *Task) ArchiveDue(after time.Duration, now time.Time) bool {
+	if t.Status != value_objects.StatusDone {
+		return false
+	}
+	doneAt := t.CreatedAt
+	if completedAt := t.CompletedAt(); completedAt != nil {
+		doneAt = *completedAt
+	}
+	return now.Sub(doneAt) >= after
+}
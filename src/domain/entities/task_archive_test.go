@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTask_Archive(t *testing.T) {
+	task, _ := NewTask("t", "")
+	now := time.Now()
+	if err := task.Archive(now); !errors.Is(err, ErrArchiveNotDone) {
+		t.Errorf("Expected ErrArchiveNotDone, got %v", err)
+	}
+	if task.ArchiveDue(0, now) {
+		t.Errorf("Expected an open task never to be due")
+	}
+	task.UpdateStatus(value_objects.StatusDoing)
+	task.UpdateStatus(value_objects.StatusDone)
+	completedAt := *task.CompletedAt()
+	if task.ArchiveDue(time.Hour, completedAt.Add(59*time.Minute)) {
+		t.Errorf("Expected task not to be due before the delay")
+	}
+	if !task.ArchiveDue(time.Hour, completedAt.Add(time.Hour)) {
+		t.Errorf("Expected task to be due after the delay")
+	}
+	if err := task.Archive(now); err != nil || !task.IsArchived() {
+		t.Errorf("Expected done task to be archived, got %v", err)
+	}
+}
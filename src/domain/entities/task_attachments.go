@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxAttachmentsPerTask is the maximum number of files attached to a single task.
+const MaxAttachmentsPerTask = 20
+
+// MaxAttachmentNameLength is the maximum number of characters in an attachment file name.
+const MaxAttachmentNameLength = 255
+
+// Sentinel errors for attachment business rules
+var (
+	ErrInvalidAttachmentName = fmt.Errorf("%w: invalid attachment file name", ErrInvalidInput)
+	ErrTooManyAttachments    = fmt.Errorf("%w: too many attachments", ErrInvalidInput)
+)
+
+// Attachment describes a file attached to a task.
+// The content itself lives in a blob store and is addressed by Digest.
+type Attachment struct {
+	ID          value_objects.AttachmentId
+	Name        string
+	ContentType string
+	Size        int64
+	// Digest is the hex-encoded SHA-256 of the content.
+	Digest     string
+	UploadedAt time.Time
+}
+
+// AddAttachment records a file stored under digest as attached to the task.
+// The name is reduced to its last path element so clients cannot smuggle directories into downloads.
+func (t *Task) AddAttachment(name, contentType string, size int64, digest string) (*Attachment, error) {
+	name = attachmentBaseName(name)
+	if name == "" || name == "." || name == ".." || utf8.RuneCountInString(name) > MaxAttachmentNameLength ||
+		strings.IndexFunc(name, unicode.IsControl) >= 0 {
+		return nil, ErrInvalidAttachmentName
+	}
+	if len(t.Attachments) >= MaxAttachmentsPerTask {
+		return nil, ErrTooManyAttachments
+	}
+	attachment := Attachment{
+		ID:          value_objects.NewAttachmentId(),
+		Name:        name,
+		ContentType: contentType,
+		Size:        size,
+		Digest:      digest,
+		UploadedAt:  time.Now(),
+	}
+	t.Attachments = append(t.Attachments, attachment)
+	return &attachment, nil
+}
+
+// RemoveAttachment detaches a file from the task and returns it.
+// The boolean is false if the task has no such attachment.
+func (t *Task) RemoveAttachment(id value_objects.AttachmentId) (Attachment, bool) {
+	for i, attachment := range t.Attachments {
+		if attachment.ID == id {
+			t.Attachments = append(t.Attachments[:i:i], t.Attachments[i+1:]...)
+			return attachment, true
+		}
+	}
+	return Attachment{}, false
+}
+
+// FindAttachment returns the attachment with the given ID, or nil if the task has none.
+func (t *Task) FindAttachment(id value_objects.AttachmentId) *Attachment {
+	for i := range t.Attachments {
+		if t.Attachments[i].ID == id {
+			return &t.Attachments[i]
+		}
+	}
+	return nil
+}
+
+func attachmentBaseName(name string) string {
+	name = strings.TrimSpace(name)
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.TrimSpace(name)
+}
@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestAddAttachment_SanitizesName(t *testing.T) {
+	task, _ := NewTask("Paint", "")
+	attachment, err := task.AddAttachment(`C:\Users\me\..\colors.png`, "image/png", 42, "abc")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if attachment.Name != "colors.png" || attachment.Size != 42 || attachment.Digest != "abc" {
+		t.Errorf("Unexpected attachment: %+v", attachment)
+	}
+	if found := task.FindAttachment(attachment.ID); found == nil || found.Name != "colors.png" {
+		t.Errorf("Expected attachment to be stored on the task")
+	}
+}
+
+func TestAddAttachment_InvalidName(t *testing.T) {
+	task, _ := NewTask("Paint", "")
+	for _, name := range []string{"", "  ", "dir/", "..", "a\nb"} {
+		if _, err := task.AddAttachment(name, "text/plain", 1, "abc"); !errors.Is(err, ErrInvalidAttachmentName) {
+			t.Errorf("AddAttachment(%q) expected ErrInvalidAttachmentName, got %v", name, err)
+		}
+	}
+}
+
+func TestAddAttachment_Limit(t *testing.T) {
+	task, _ := NewTask("Paint", "")
+	for i := 0; i < MaxAttachmentsPerTask; i++ {
+		if _, err := task.AddAttachment(fmt.Sprintf("f%d.txt", i), "text/plain", 1, "abc"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if _, err := task.AddAttachment("one-more.txt", "text/plain", 1, "abc"); !errors.Is(err, ErrTooManyAttachments) {
+		t.Errorf("Expected ErrTooManyAttachments, got %v", err)
+	}
+}
+
+func TestRemoveAttachment(t *testing.T) {
+	task, _ := NewTask("Paint", "")
+	first, _ := task.AddAttachment("a.txt", "text/plain", 1, "aaa")
+	second, _ := task.AddAttachment("b.txt", "text/plain", 1, "bbb")
+	removed, ok := task.RemoveAttachment(first.ID)
+	if !ok || removed.Digest != "aaa" {
+		t.Fatalf("Expected to remove first attachment, got %+v (ok=%v)", removed, ok)
+	}
+	if len(task.Attachments) != 1 || task.Attachments[0].ID != second.ID {
+		t.Errorf("Expected only the second attachment to remain, got %+v", task.Attachments)
+	}
+	if _, ok := task.RemoveAttachment(first.ID); ok {
+		t.Errorf("Expected removing a missing attachment to report false")
+	}
+}
@@ -0,0 +1,123 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxChecklistItemsPerTask is the maximum number of checklist items in a single task.
+const MaxChecklistItemsPerTask = 100
+
+// MaxChecklistItemLength is the maximum number of characters in the text of a checklist item.
+const MaxChecklistItemLength = 500
+
+// Sentinel errors for checklist business rules
+var (
+	ErrEmptyChecklistItem       = fmt.Errorf("%w: checklist item cannot be empty", ErrInvalidInput)
+	ErrChecklistItemTooLong     = fmt.Errorf("%w: checklist item too long", ErrInvalidInput)
+	ErrTooManyChecklistItems    = fmt.Errorf("%w: too many checklist items", ErrInvalidInput)
+	ErrInvalidChecklistPosition = fmt.Errorf("%w: invalid checklist position", ErrInvalidInput)
+	ErrUncheckedItems           = fmt.Errorf("%w: cannot complete a task with unchecked checklist items", ErrInvalidInput)
+)
+
+// ChecklistItem is a lightweight step of a task that is ticked off instead of tracked on the board.
+type ChecklistItem struct {
+	ID      value_objects.ChecklistItemId
+	Text    string
+	Checked bool
+}
+
+// AddChecklistItem appends an unchecked item to the checklist of the task.
+func (t *Task) AddChecklistItem(text string) (*ChecklistItem, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, ErrEmptyChecklistItem
+	}
+	if utf8.RuneCountInString(text) > MaxChecklistItemLength {
+		return nil, ErrChecklistItemTooLong
+	}
+	if len(t.Checklist) >= MaxChecklistItemsPerTask {
+		return nil, ErrTooManyChecklistItems
+	}
+	item := ChecklistItem{ID: value_objects.NewChecklistItemId(), Text: text}
+	t.Checklist = append(t.Checklist, item)
+	return &item, nil
+}
+
+// FindChecklistItem returns the checklist item with the given ID, or nil if the task has none.
+func (t *Task) FindChecklistItem(id value_objects.ChecklistItemId) *ChecklistItem {
+	for i := range t.Checklist {
+		if t.Checklist[i].ID == id {
+			return &t.Checklist[i]
+		}
+	}
+	return nil
+}
+
+// CheckChecklistItem checks or unchecks an item and returns it, or nil if the task has no such item.
+func (t *Task) CheckChecklistItem(id value_objects.ChecklistItemId, checked bool) *ChecklistItem {
+	item := t.FindChecklistItem(id)
+	if item != nil {
+		item.Checked = checked
+	}
+	return item
+}
+
+// RemoveChecklistItem removes an item from the checklist of the task.
+// The boolean is false if the task has no such item.
+func (t *Task) RemoveChecklistItem(id value_objects.ChecklistItemId) bool {
+	for i, item := range t.Checklist {
+		if item.ID == id {
+			t.Checklist = append(t.Checklist[:i:i], t.Checklist[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// MoveChecklistItem moves an item to the given zero-based position, shifting the items in between.
+// Moving an item the task does not have is a no-op.
+func (t *Task) MoveChecklistItem(id value_objects.ChecklistItemId, position int) error {
+	if position < 0 || position >= len(t.Checklist) {
+		return ErrInvalidChecklistPosition
+	}
+	from := -1
+	for i, item := range t.Checklist {
+		if item.ID == id {
+			from = i
+			break
+		}
+	}
+	if from < 0 {
+		return nil
+	}
+	item := t.Checklist[from]
+	items := append(t.Checklist[:from:from], t.Checklist[from+1:]...)
+	items = append(items[:position], append([]ChecklistItem{item}, items[position:]...)...)
+	t.Checklist = items
+	return nil
+}
+
+// ChecklistProgress returns the number of checked items and the total number of items.
+func (t *Task) ChecklistProgress() (checked, total int) {
+	for _, item := range t.Checklist {
+		if item.Checked {
+			checked++
+		}
+	}
+	return checked, len(t.Checklist)
+}
+
+// ChecklistComplete is a StatusRule preventing a task from moving to DONE
+// while any of its checklist items is unchecked.
+func ChecklistComplete(t *Task, newStatus value_objects.TaskStatus) error {
+	if newStatus != value_objects.StatusDone {
+		return nil
+	}
+	if checked, total := t.ChecklistProgress(); checked < total {
+		return ErrUncheckedItems
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func checklistTexts(task *Task) string {
+	texts := make([]string, len(task.Checklist))
+	for i, item := range task.Checklist {
+		texts[i] = item.Text
+	}
+	return strings.Join(texts, ",")
+}
+
+func TestChecklist_AddCheckMoveRemove(t *testing.T) {
+	task, _ := NewTask("pack", "")
+	if _, err := task.AddChecklistItem(" "); !errors.Is(err, ErrEmptyChecklistItem) {
+		t.Errorf("Expected ErrEmptyChecklistItem, got %v", err)
+	}
+	if _, err := task.AddChecklistItem(strings.Repeat("x", MaxChecklistItemLength+1)); !errors.Is(err, ErrChecklistItemTooLong) {
+		t.Errorf("Expected ErrChecklistItemTooLong, got %v", err)
+	}
+	a, _ := task.AddChecklistItem(" passport ")
+	b, _ := task.AddChecklistItem("charger")
+	c, _ := task.AddChecklistItem("socks")
+	if checklistTexts(task) != "passport,charger,socks" {
+		t.Fatalf("Unexpected checklist %q", checklistTexts(task))
+	}
+
+	if item := task.CheckChecklistItem(b.ID, true); item == nil || !item.Checked {
+		t.Fatalf("Expected checked item, got %+v", item)
+	}
+	if checked, total := task.ChecklistProgress(); checked != 1 || total != 3 {
+		t.Errorf("Expected 1/3, got %d/%d", checked, total)
+	}
+
+	if err := task.MoveChecklistItem(c.ID, 0); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := task.MoveChecklistItem(c.ID, 2); err != nil || checklistTexts(task) != "passport,charger,socks" {
+		t.Errorf("Unexpected checklist %q (err=%v)", checklistTexts(task), err)
+	}
+	if err := task.MoveChecklistItem(a.ID, 3); !errors.Is(err, ErrInvalidChecklistPosition) {
+		t.Errorf("Expected ErrInvalidChecklistPosition, got %v", err)
+	}
+
+	if !task.RemoveChecklistItem(a.ID) || task.RemoveChecklistItem(a.ID) {
+		t.Errorf("Expected the item to be removed exactly once")
+	}
+	if task.FindChecklistItem(a.ID) != nil || task.CheckChecklistItem(a.ID, true) != nil {
+		t.Errorf("Expected removed item to be gone")
+	}
+}
+
+func TestChecklistComplete(t *testing.T) {
+	task, _ := NewTask("pack", "")
+	item, _ := task.AddChecklistItem("passport")
+	if err := task.UpdateStatus(value_objects.StatusDoing, ChecklistComplete); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := task.UpdateStatus(value_objects.StatusDone, ChecklistComplete); !errors.Is(err, ErrUncheckedItems) {
+		t.Fatalf("Expected ErrUncheckedItems, got %v", err)
+	}
+	task.CheckChecklistItem(item.ID, true)
+	if err := task.UpdateStatus(value_objects.StatusDone, ChecklistComplete); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
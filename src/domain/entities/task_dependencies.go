@@ -0,0 +1,113 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"fmt"
+	"sort"
+)
+
+// Sentinel errors for task dependency business rules
+var (
+	ErrDependencyCycle = fmt.Errorf("%w: dependency would create a cycle", ErrInvalidInput)
+	ErrBlocked         = fmt.Errorf("%w: cannot start a task while it is blocked by open tasks", ErrInvalidInput)
+)
+
+// AddBlocker declares that the task is blocked by blocker.
+// blockerUpstream lists every task the blocker itself transitively depends on;
+// it is used to reject dependencies that would create a cycle.
+// Adding an existing dependency is a no-op.
+func (t *Task) AddBlocker(blocker *Task, blockerUpstream []value_objects.TaskId) error {
+	if blocker.ID == t.ID {
+		return ErrDependencyCycle
+	}
+	for _, id := range blockerUpstream {
+		if id == t.ID {
+			return ErrDependencyCycle
+		}
+	}
+	if t.IsBlockedBy(blocker.ID) {
+		return nil
+	}
+	t.BlockedBy = append(t.BlockedBy, blocker.ID)
+	return nil
+}
+
+// RemoveBlocker removes a dependency. Removing an unknown dependency is a no-op.
+func (t *Task) RemoveBlocker(blockerID value_objects.TaskId) {
+	for i, id := range t.BlockedBy {
+		if id == blockerID {
+			t.BlockedBy = append(t.BlockedBy[:i:i], t.BlockedBy[i+1:]...)
+			return
+		}
+	}
+}
+
+// IsBlockedBy reports whether the task declares a dependency on the given task.
+func (t *Task) IsBlockedBy(id value_objects.TaskId) bool {
+	for _, existing := range t.BlockedBy {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
+
+// BlockersDone returns a StatusRule preventing a task from moving to DOING
+// while any of its blockers is not DONE.
+func BlockersDone(blockers []*Task) StatusRule {
+	return func(t *Task, newStatus value_objects.TaskStatus) error {
+		if newStatus != value_objects.StatusDoing || t.Status == value_objects.StatusDoing {
+			return nil
+		}
+		for _, blocker := range blockers {
+			if blocker.Status != value_objects.StatusDone {
+				return ErrBlocked
+			}
+		}
+		return nil
+	}
+}
+
+// OrderByDependencies sorts tasks topologically so that every task comes after
+// the tasks blocking it. Dependencies on tasks outside the given list are ignored,
+// which lets callers pass only open tasks. Among tasks whose blockers are all
+// placed, older tasks come first. Returns ErrDependencyCycle if the tasks contain a cycle.
+func OrderByDependencies(tasks []*Task) ([]*Task, error) {
+	byID := make(map[value_objects.TaskId]*Task, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID] = task
+	}
+	pending := make(map[value_objects.TaskId]int, len(tasks))
+	dependents := make(map[value_objects.TaskId][]*Task)
+	var ready []*Task
+	for _, task := range tasks {
+		for _, blockerID := range task.BlockedBy {
+			if _, ok := byID[blockerID]; ok {
+				pending[task.ID]++
+				dependents[blockerID] = append(dependents[blockerID], task)
+			}
+		}
+		if pending[task.ID] == 0 {
+			ready = append(ready, task)
+		}
+	}
+	ordered := make([]*Task, 0, len(tasks))
+	for len(ready) > 0 {
+		sort.SliceStable(ready, func(i, j int) bool {
+			return ready[i].CreatedAt.Before(ready[j].CreatedAt)
+		})
+		next := ready[0]
+		ready = ready[1:]
+		ordered = append(ordered, next)
+		for _, dependent := range dependents[next.ID] {
+			pending[dependent.ID]--
+			if pending[dependent.ID] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+	if len(ordered) != len(tasks) {
+		return nil, ErrDependencyCycle
+	}
+	return ordered, nil
+}
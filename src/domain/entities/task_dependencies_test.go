@@ -0,0 +1,92 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAddBlocker(t *testing.T) {
+	a, _ := NewTask("a", "")
+	b, _ := NewTask("b", "")
+
+	if err := a.AddBlocker(b, nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	a.AddBlocker(b, nil)
+	if len(a.BlockedBy) != 1 || !a.IsBlockedBy(b.ID) {
+		t.Errorf("Expected a single dependency on b, got %v", a.BlockedBy)
+	}
+
+	a.RemoveBlocker(b.ID)
+	if a.IsBlockedBy(b.ID) {
+		t.Errorf("Expected dependency to be removed")
+	}
+}
+
+func TestAddBlocker_RejectsCycles(t *testing.T) {
+	a, _ := NewTask("a", "")
+	b, _ := NewTask("b", "")
+
+	if err := a.AddBlocker(a, nil); !errors.Is(err, ErrDependencyCycle) {
+		t.Errorf("Expected ErrDependencyCycle for self dependency, got %v", err)
+	}
+	// b is (transitively) blocked by a, so a cannot be blocked by b.
+	if err := a.AddBlocker(b, []value_objects.TaskId{a.ID}); !errors.Is(err, ErrDependencyCycle) {
+		t.Errorf("Expected ErrDependencyCycle, got %v", err)
+	}
+}
+
+func TestUpdateStatus_BlockersDone(t *testing.T) {
+	task, _ := NewTask("task", "")
+	blocker, _ := NewTask("blocker", "")
+	task.AddBlocker(blocker, nil)
+
+	err := task.UpdateStatus(value_objects.StatusDoing, BlockersDone([]*Task{blocker}))
+	if !errors.Is(err, ErrBlocked) {
+		t.Fatalf("Expected ErrBlocked, got %v", err)
+	}
+
+	blocker.Status = value_objects.StatusDone
+	if err := task.UpdateStatus(value_objects.StatusDoing, BlockersDone([]*Task{blocker})); err != nil {
+		t.Errorf("Expected no error once blocker is done, got %v", err)
+	}
+}
+
+func TestOrderByDependencies(t *testing.T) {
+	base := time.Now()
+	newTask := func(title string, offset int) *Task {
+		task, _ := NewTask(title, "")
+		task.CreatedAt = base.Add(time.Duration(offset) * time.Second)
+		return task
+	}
+	deploy := newTask("deploy", 0)
+	build := newTask("build", 1)
+	test := newTask("test", 2)
+	docs := newTask("docs", 3)
+	deploy.AddBlocker(test, nil)
+	test.AddBlocker(build, nil)
+	// A dependency on a task outside the list (e.g. already done) is ignored.
+	docs.BlockedBy = append(docs.BlockedBy, value_objects.NewTaskId())
+
+	ordered, err := OrderByDependencies([]*Task{deploy, build, test, docs})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	var titles []string
+	for _, task := range ordered {
+		titles = append(titles, task.Title)
+	}
+	want := []string{"build", "test", "deploy", "docs"}
+	for i := range want {
+		if titles[i] != want[i] {
+			t.Fatalf("Expected order %v, got %v", want, titles)
+		}
+	}
+
+	build.AddBlocker(deploy, nil)
+	if _, err := OrderByDependencies([]*Task{deploy, build, test}); !errors.Is(err, ErrDependencyCycle) {
+		t.Errorf("Expected ErrDependencyCycle, got %v", err)
+	}
+}
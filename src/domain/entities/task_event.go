@@ -0,0 +1,25 @@
+package entities
+
+import "clean-architecture-golang/domain/value_objects"
+
+// TaskEventType names what happened to an active task.
+type TaskEventType string
+
+const (
+	// TaskAdded records a task created, restored from the trash or brought back from the archive.
+	TaskAdded TaskEventType = "task_added"
+	// TaskUpdated records a change to an active task.
+	TaskUpdated TaskEventType = "task_updated"
+	// TaskRemoved records a task deleted, moved to the trash or archived.
+	TaskRemoved TaskEventType = "task_removed"
+)
+
+// TaskEvent is a domain event recording a change to the active tasks.
+// Sequence numbers events in commit order. Task is the state of the task after the change
+// and is nil for TaskRemoved.
+type TaskEvent struct {
+	Sequence uint64
+	Type     TaskEventType
+	TaskID   value_objects.TaskId
+	Task     *Task
+}
@@ -0,0 +1,84 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"strings"
+	"time"
+)
+
+// Matches reports whether the task satisfies the filter.
+// Statuses compare in workflow order (todo < doing < done) and priorities from low to urgent. Creation dates compare by their
+// calendar day in UTC and due dates by their calendar day in their own location; a task without
+// a due date matches no due condition.
+func (t *Task) Matches(filter value_objects.TaskFilter) bool {
+	switch {
+	case filter.Condition != nil:
+		return t.matchesCondition(*filter.Condition)
+	case filter.Not != nil:
+		return !t.Matches(*filter.Not)
+	case len(filter.Or) > 0:
+		for _, operand := range filter.Or {
+			if t.Matches(operand) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, operand := range filter.And {
+		if !t.Matches(operand) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *Task) matchesCondition(c value_objects.FilterCondition) bool {
+	switch c.Field {
+	case value_objects.FilterStatus:
+		return c.Op.Holds(statusOrder(t.Status) - statusOrder(value_objects.TaskStatus(c.Value)))
+	case value_objects.FilterPriority:
+		return c.Op.Holds(t.Priority.Level() - value_objects.Priority(c.Value).Level())
+	case value_objects.FilterTag:
+		return c.Op.Holds(boolCompare(t.HasTag(value_objects.Tag(c.Value))))
+	case value_objects.FilterProject:
+		return c.Op.Holds(boolCompare(string(t.ProjectID) == c.Value))
+	case value_objects.FilterTitle:
+		title := strings.ToLower(t.Title)
+		if c.Op == value_objects.OpMatch {
+			return strings.Contains(title, c.Value)
+		}
+		return c.Op.Holds(boolCompare(title == c.Value))
+	case value_objects.FilterCreated:
+		return c.Op.Holds(calendarDay(t.CreatedAt.UTC()).Compare(c.Date))
+	case value_objects.FilterDue:
+		if t.DueDate == nil {
+			return false
+		}
+		return c.Op.Holds(calendarDay(*t.DueDate).Compare(c.Date))
+	}
+	return false
+}
+
+// statusOrder returns the position of a status in the workflow.
+func statusOrder(status value_objects.TaskStatus) int {
+	for i, candidate := range value_objects.AllStatuses() {
+		if candidate == status {
+			return i
+		}
+	}
+	return -1
+}
+
+// boolCompare turns an equality test into a comparison result for value_objects.FilterOp.Holds.
+func boolCompare(equal bool) int {
+	if equal {
+		return 0
+	}
+	return 1
+}
+
+// calendarDay returns midnight UTC of the calendar day of t in its own location.
+func calendarDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
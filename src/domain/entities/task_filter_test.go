@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"testing"
+	"time"
+)
+
+func TestTask_Matches(t *testing.T) {
+	project, _ := NewProject("Home", "", ProjectSettings{})
+	task, _ := NewTask("Paint the Fence", "")
+	task.AddTags("home")
+	task.MoveToProject(project)
+	task.UpdateStatus(value_objects.StatusDoing)
+	task.SetPriority(value_objects.PriorityHigh)
+	task.CreatedAt = time.Date(2026, 1, 15, 23, 30, 0, 0, time.UTC)
+	loc := time.FixedZone("UTC+9", 9*60*60)
+	due := time.Date(2026, 2, 1, 8, 0, 0, 0, loc) // still January 31st in UTC
+	task.SetDueDate(&due)
+	undated, _ := NewTask("Call", "")
+
+	cases := map[string]bool{
+		"status:doing":                              true,
+		"status>=doing AND status<done":             true,
+		"status>doing":                              false,
+		"status:doing AND priority>=high":           true,
+		"priority>high":                             false,
+		"tag:home AND NOT tag:work":                 true,
+		"tag!=home":                                 false,
+		"project:" + string(project.ID):             true,
+		"project:none":                              false,
+		"title:fence":                               true,
+		`title="paint the fence"`:                   true,
+		"title=paint":                               false,
+		"created>2026-01-01 created<=2026-01-15":    true,
+		"created:2026-01-16":                        false,
+		"due:2026-02-01":                            true,
+		"tag:work OR (status:doing due>2026-01-31)": true,
+	}
+	for expr, want := range cases {
+		filter, err := value_objects.ParseTaskFilter(expr)
+		if err != nil {
+			t.Fatalf("ParseTaskFilter(%q): %v", expr, err)
+		}
+		if got := task.Matches(filter); got != want {
+			t.Errorf("Matches(%q) = %v, want %v", expr, got, want)
+		}
+	}
+
+	for expr, want := range map[string]bool{"due<2030-01-01": false, "NOT due:2026-02-01": true, "project:none": true, "priority:medium": true} {
+		filter, _ := value_objects.ParseTaskFilter(expr)
+		if got := undated.Matches(filter); got != want {
+			t.Errorf("Matches(%q) on a task without due date or project = %v, want %v", expr, got, want)
+		}
+	}
+	if !undated.Matches(value_objects.TaskFilter{}) {
+		t.Errorf("expected the zero filter to match every task")
+	}
+}
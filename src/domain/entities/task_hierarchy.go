@@ -0,0 +1,88 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"fmt"
+)
+
+// Sentinel errors for parent/child business rules
+var (
+	ErrParentCycle  = fmt.Errorf("%w: a task cannot be its own ancestor", ErrInvalidInput)
+	ErrParentDone   = fmt.Errorf("%w: cannot add a subtask to a completed task", ErrInvalidInput)
+	ErrOpenChildren = fmt.Errorf("%w: cannot complete a task with open subtasks", ErrInvalidInput)
+	ErrParentClosed = fmt.Errorf("%w: cannot reopen a subtask of a completed task", ErrInvalidInput)
+)
+
+// SetParent makes the task a subtask of parent, or a top-level task when parent is nil.
+// parentAncestors lists the IDs of all ancestors of parent; it is used to reject
+// assignments that would create a cycle in the hierarchy.
+// A completed task cannot receive new subtasks.
+func (t *Task) SetParent(parent *Task, parentAncestors []value_objects.TaskId) error {
+	if parent == nil {
+		t.ParentID = ""
+		return nil
+	}
+	if parent.ID == t.ID {
+		return ErrParentCycle
+	}
+	for _, ancestor := range parentAncestors {
+		if ancestor == t.ID {
+			return ErrParentCycle
+		}
+	}
+	if parent.Status == value_objects.StatusDone && t.Status != value_objects.StatusDone {
+		return ErrParentDone
+	}
+	t.ParentID = parent.ID
+	return nil
+}
+
+// IsSubtask reports whether the task has a parent.
+func (t *Task) IsSubtask() bool {
+	return t.ParentID != ""
+}
+
+// ChildrenClosed returns a StatusRule preventing a task from moving to DONE
+// while any of its children is not DONE.
+func ChildrenClosed(children []*Task) StatusRule {
+	return func(t *Task, newStatus value_objects.TaskStatus) error {
+		if newStatus != value_objects.StatusDone {
+			return nil
+		}
+		for _, child := range children {
+			if child.Status != value_objects.StatusDone {
+				return ErrOpenChildren
+			}
+		}
+		return nil
+	}
+}
+
+// ParentOpen returns a StatusRule preventing a DONE subtask from leaving DONE while its parent
+// is DONE, as a completed task cannot have open subtasks. The parent is reopened first.
+func ParentOpen(parent *Task) StatusRule {
+	return func(t *Task, newStatus value_objects.TaskStatus) error {
+		if t.Status == value_objects.StatusDone && newStatus != value_objects.StatusDone &&
+			parent.Status == value_objects.StatusDone {
+			return ErrParentClosed
+		}
+		return nil
+	}
+}
+
+// CompletionPercent rolls up the completion of the task from the completion of its children.
+// A task without children is either 0% or 100% complete depending on its status;
+// a task with children is the average of their completion, and 100% once it is DONE.
+func (t *Task) CompletionPercent(childPercents []int) int {
+	if t.Status == value_objects.StatusDone {
+		return 100
+	}
+	if len(childPercents) == 0 {
+		return 0
+	}
+	total := 0
+	for _, percent := range childPercents {
+		total += percent
+	}
+	return total / len(childPercents)
+}
@@ -0,0 +1,89 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"testing"
+)
+
+func TestSetParent(t *testing.T) {
+	parent, _ := NewTask("parent", "")
+	child, _ := NewTask("child", "")
+
+	if err := child.SetParent(parent, nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if child.ParentID != parent.ID || !child.IsSubtask() {
+		t.Errorf("Expected parent %s, got %s", parent.ID, child.ParentID)
+	}
+
+	if err := child.SetParent(nil, nil); err != nil || child.IsSubtask() {
+		t.Errorf("Expected task to become top-level, got parent %q (err %v)", child.ParentID, err)
+	}
+}
+
+func TestSetParent_RejectsCycles(t *testing.T) {
+	root, _ := NewTask("root", "")
+	middle, _ := NewTask("middle", "")
+	middle.SetParent(root, nil)
+
+	if err := root.SetParent(root, nil); !errors.Is(err, ErrParentCycle) {
+		t.Errorf("Expected ErrParentCycle for self-parent, got %v", err)
+	}
+	// root -> middle would make root its own grandparent.
+	if err := root.SetParent(middle, []value_objects.TaskId{root.ID}); !errors.Is(err, ErrParentCycle) {
+		t.Errorf("Expected ErrParentCycle, got %v", err)
+	}
+	if root.IsSubtask() {
+		t.Errorf("Expected root to stay top-level")
+	}
+}
+
+func TestSetParent_RejectsDoneParent(t *testing.T) {
+	parent, _ := NewTask("parent", "")
+	parent.Status = value_objects.StatusDone
+	child, _ := NewTask("child", "")
+
+	if err := child.SetParent(parent, nil); !errors.Is(err, ErrParentDone) {
+		t.Errorf("Expected ErrParentDone, got %v", err)
+	}
+}
+
+func TestUpdateStatus_ChildrenClosed(t *testing.T) {
+	parent, _ := NewTask("parent", "")
+	open, _ := NewTask("open", "")
+	done, _ := NewTask("done", "")
+	done.Status = value_objects.StatusDone
+
+	err := parent.UpdateStatus(value_objects.StatusDone, ChildrenClosed([]*Task{done, open}))
+	if !errors.Is(err, ErrOpenChildren) {
+		t.Fatalf("Expected ErrOpenChildren, got %v", err)
+	}
+	if parent.Status != value_objects.StatusTodo {
+		t.Errorf("Expected status to be unchanged, got %s", parent.Status)
+	}
+
+	// Other transitions are not affected by open children.
+	if err := parent.UpdateStatus(value_objects.StatusDoing, ChildrenClosed([]*Task{open})); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	open.Status = value_objects.StatusDone
+	if err := parent.UpdateStatus(value_objects.StatusDone, ChildrenClosed([]*Task{done, open})); err != nil {
+		t.Errorf("Expected no error once children are done, got %v", err)
+	}
+}
+
+func TestCompletionPercent(t *testing.T) {
+	task, _ := NewTask("t", "")
+	if got := task.CompletionPercent(nil); got != 0 {
+		t.Errorf("Expected 0 for open leaf, got %d", got)
+	}
+	if got := task.CompletionPercent([]int{100, 0, 50}); got != 50 {
+		t.Errorf("Expected 50, got %d", got)
+	}
+	task.Status = value_objects.StatusDone
+	if got := task.CompletionPercent(nil); got != 100 {
+		t.Errorf("Expected 100 for done task, got %d", got)
+	}
+}
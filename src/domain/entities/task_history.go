@@ -0,0 +1,76 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"time"
+)
+
+// StatusChange records one status transition of a task.
+type StatusChange struct {
+	From value_objects.TaskStatus
+	To   value_objects.TaskStatus
+	At   time.Time
+	// Actor is the user who made the change; it is empty when unknown.
+	Actor string
+}
+
+// StartedAt returns when the task first moved to DOING, or nil if it never did.
+func (t *Task) StartedAt() *time.Time {
+	for _, change := range t.History {
+		if change.To == value_objects.StatusDoing {
+			return copyTime(&change.At)
+		}
+	}
+	return nil
+}
+
+// CompletedAt returns when the task last moved to DONE, or nil if it is not done.
+func (t *Task) CompletedAt() *time.Time {
+	if t.Status != value_objects.StatusDone {
+		return nil
+	}
+	for i := len(t.History) - 1; i >= 0; i-- {
+		if t.History[i].To == value_objects.StatusDone {
+			return copyTime(&t.History[i].At)
+		}
+	}
+	return nil
+}
+
+// StatusAt returns the status the task had at the given time by replaying its history.
+// The boolean is false if the task did not exist yet.
+func (t *Task) StatusAt(at time.Time) (value_objects.TaskStatus, bool) {
+	if at.Before(t.CreatedAt) {
+		return "", false
+	}
+	status := t.Status
+	if len(t.History) > 0 {
+		status = t.History[0].From
+	}
+	for _, change := range t.History {
+		if change.At.After(at) {
+			break
+		}
+		status = change.To
+	}
+	return status, true
+}
+
+// LeadTime returns the time from creation to completion of a done task.
+func (t *Task) LeadTime() (time.Duration, bool) {
+	completed := t.CompletedAt()
+	if completed == nil {
+		return 0, false
+	}
+	return completed.Sub(t.CreatedAt), true
+}
+
+// CycleTime returns the time from first starting work to completion of a done task.
+// Tasks completed without ever being in DOING have no cycle time.
+func (t *Task) CycleTime() (time.Duration, bool) {
+	started, completed := t.StartedAt(), t.CompletedAt()
+	if started == nil || completed == nil || completed.Before(*started) {
+		return 0, false
+	}
+	return completed.Sub(*started), true
+}
@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"testing"
+	"time"
+)
+
+func TestUpdateStatusBy_RecordsHistory(t *testing.T) {
+	task, _ := NewTask("Paint", "")
+	if task.StartedAt() != nil || task.CompletedAt() != nil {
+		t.Fatalf("Expected a new task to have no derived timestamps")
+	}
+
+	if err := task.UpdateStatusBy("alice", value_objects.StatusDoing); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Setting the same status again is not a change.
+	task.UpdateStatusBy("alice", value_objects.StatusDoing)
+	if err := task.UpdateStatus(value_objects.StatusDone); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	task.UpdateStatusBy("bob", value_objects.StatusTodo) // rejected, not recorded
+
+	if len(task.History) != 2 {
+		t.Fatalf("Expected 2 history entries, got %+v", task.History)
+	}
+	first, second := task.History[0], task.History[1]
+	if first.From != value_objects.StatusTodo || first.To != value_objects.StatusDoing || first.Actor != "alice" {
+		t.Errorf("Unexpected first change: %+v", first)
+	}
+	if second.From != value_objects.StatusDoing || second.To != value_objects.StatusDone || second.Actor != "" {
+		t.Errorf("Unexpected second change: %+v", second)
+	}
+	if started := task.StartedAt(); started == nil || !started.Equal(first.At) {
+		t.Errorf("Expected StartedAt %v, got %v", first.At, started)
+	}
+	if completed := task.CompletedAt(); completed == nil || !completed.Equal(second.At) {
+		t.Errorf("Expected CompletedAt %v, got %v", second.At, completed)
+	}
+}
+
+func TestCompletedAt_ClearedWhenReopened(t *testing.T) {
+	task, _ := NewTask("Paint", "")
+	task.UpdateStatus(value_objects.StatusDone)
+	task.UpdateStatus(value_objects.StatusDoing)
+	if task.CompletedAt() != nil {
+		t.Errorf("Expected a reopened task to have no CompletedAt")
+	}
+	if task.StartedAt() == nil {
+		t.Errorf("Expected StartedAt once the task moved to doing")
+	}
+}
+
+func TestStatusAtAndFlowTimes(t *testing.T) {
+	created := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	task := &Task{ID: value_objects.NewTaskId(), Status: value_objects.StatusDone, CreatedAt: created}
+	task.History = []StatusChange{
+		{From: value_objects.StatusTodo, To: value_objects.StatusDoing, At: created.Add(24 * time.Hour)},
+		{From: value_objects.StatusDoing, To: value_objects.StatusDone, At: created.Add(72 * time.Hour)},
+	}
+
+	if _, ok := task.StatusAt(created.Add(-time.Hour)); ok {
+		t.Errorf("Expected no status before creation")
+	}
+	for offset, want := range map[time.Duration]value_objects.TaskStatus{
+		0:              value_objects.StatusTodo,
+		30 * time.Hour: value_objects.StatusDoing,
+		72 * time.Hour: value_objects.StatusDone,
+	} {
+		if got, _ := task.StatusAt(created.Add(offset)); got != want {
+			t.Errorf("StatusAt(+%v) = %s, want %s", offset, got, want)
+		}
+	}
+	if lead, ok := task.LeadTime(); !ok || lead != 72*time.Hour {
+		t.Errorf("Expected lead time 72h, got %v (ok=%v)", lead, ok)
+	}
+	if cycle, ok := task.CycleTime(); !ok || cycle != 48*time.Hour {
+		t.Errorf("Expected cycle time 48h, got %v (ok=%v)", cycle, ok)
+	}
+
+	skipped := &Task{Status: value_objects.StatusDone, CreatedAt: created,
+		History: []StatusChange{{From: value_objects.StatusTodo, To: value_objects.StatusDone, At: created.Add(time.Hour)}}}
+	if _, ok := skipped.CycleTime(); ok {
+		t.Errorf("Expected no cycle time for a task never in doing")
+	}
+}
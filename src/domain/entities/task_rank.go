@@ -0,0 +1,36 @@
+package entities
+
+import "clean-architecture-golang/domain/value_objects"
+
+// PlaceBetween ranks the task between two neighbours of its status column.
+// A nil lower places it at the top, a nil upper at the bottom.
+// It fails with value_objects.ErrInvalidRank when the neighbours leave no room, e.g. because
+// they share a rank or have none; RebalanceRanks on the column makes room again.
+func (t *Task) PlaceBetween(lower, upper *Task) error {
+	var lowerRank, upperRank value_objects.Rank
+	if lower != nil {
+		if lower.Rank == "" {
+			return value_objects.ErrInvalidRank
+		}
+		lowerRank = lower.Rank
+	}
+	if upper != nil {
+		if upper.Rank == "" {
+			return value_objects.ErrInvalidRank
+		}
+		upperRank = upper.Rank
+	}
+	rank, err := value_objects.RankBetween(lowerRank, upperRank)
+	if err != nil {
+		return err
+	}
+	t.Rank = rank
+	return nil
+}
+
+// RebalanceRanks gives the tasks of a column, in their current order, evenly spaced ranks.
+func RebalanceRanks(column []*Task) {
+	for i, rank := range value_objects.EvenRanks(len(column)) {
+		column[i].Rank = rank
+	}
+}
@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"testing"
+)
+
+func TestPlaceBetween(t *testing.T) {
+	lower := &Task{Rank: "a"}
+	upper := &Task{Rank: "b"}
+	task := &Task{}
+	if err := task.PlaceBetween(lower, upper); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if task.Rank <= lower.Rank || task.Rank >= upper.Rank {
+		t.Errorf("Expected rank between a and b, got %q", task.Rank)
+	}
+	if err := task.PlaceBetween(nil, nil); err != nil || task.Rank == "" {
+		t.Errorf("Expected a rank in an empty column, got %q (err=%v)", task.Rank, err)
+	}
+	if err := task.PlaceBetween(&Task{Rank: "c"}, &Task{Rank: "c"}); !errors.Is(err, value_objects.ErrInvalidRank) {
+		t.Errorf("Expected ErrInvalidRank between equal ranks, got %v", err)
+	}
+	if err := task.PlaceBetween(&Task{}, nil); !errors.Is(err, value_objects.ErrInvalidRank) {
+		t.Errorf("Expected ErrInvalidRank after an unranked task, got %v", err)
+	}
+}
+
+func TestRebalanceRanks(t *testing.T) {
+	column := []*Task{{Rank: "c"}, {Rank: "c"}, {}}
+	RebalanceRanks(column)
+	if !(column[0].Rank < column[1].Rank && column[1].Rank < column[2].Rank) {
+		t.Errorf("Expected strictly ascending ranks, got %q %q %q", column[0].Rank, column[1].Rank, column[2].Rank)
+	}
+}
@@ -0,0 +1,77 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"fmt"
+	"time"
+)
+
+// ErrRecurrenceWithoutDueDate is returned when a recurrence rule is set on a task without a due date.
+var ErrRecurrenceWithoutDueDate = fmt.Errorf("%w: a recurring task needs a due date", ErrInvalidInput)
+
+// SetDueDate changes the due date of the task. A nil due date removes it,
+// which is only allowed for tasks that do not recur.
+func (t *Task) SetDueDate(due *time.Time) error {
+	if due == nil && t.Recurrence != nil {
+		return ErrRecurrenceWithoutDueDate
+	}
+	t.DueDate = copyTime(due)
+	return nil
+}
+
+// SetRecurrence makes the task the first occurrence of a recurring series starting at its due date.
+// A nil rule stops the task from recurring.
+func (t *Task) SetRecurrence(rule *value_objects.RecurrenceRule) error {
+	if rule == nil {
+		t.Recurrence = nil
+		t.RecurrenceStart = nil
+		return nil
+	}
+	if t.DueDate == nil {
+		return ErrRecurrenceWithoutDueDate
+	}
+	copied := *rule
+	t.Recurrence = &copied
+	t.RecurrenceStart = copyTime(t.DueDate)
+	return nil
+}
+
+// SpawnNextOccurrence creates the next occurrence of a recurring task.
+// The new task is a fresh TODO task with the same title, description, priority, tags and project,
+// and the same checklist with every item unchecked, due on the next date of the series. The series moves on to the new task, so the
+// current task stops recurring and completing it again does not spawn twice.
+// Returns false if the task does not recur or the series has ended.
+func (t *Task) SpawnNextOccurrence() (*Task, bool) {
+	if t.Recurrence == nil || t.DueDate == nil || t.RecurrenceStart == nil {
+		return nil, false
+	}
+	nextDue, ok := t.Recurrence.Next(*t.RecurrenceStart, *t.DueDate)
+	if !ok {
+		t.SetRecurrence(nil)
+		return nil, false
+	}
+	next, err := NewTask(t.Title, t.Description)
+	if err != nil {
+		return nil, false
+	}
+	next.Priority = t.Priority
+	next.Tags = append([]value_objects.Tag(nil), t.Tags...)
+	next.ProjectID = t.ProjectID
+	for _, item := range t.Checklist {
+		next.Checklist = append(next.Checklist, ChecklistItem{ID: value_objects.NewChecklistItemId(), Text: item.Text})
+	}
+	next.DueDate = &nextDue
+	next.Recurrence = t.Recurrence
+	next.RecurrenceStart = t.RecurrenceStart
+	t.Recurrence = nil
+	t.RecurrenceStart = nil
+	return next, true
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	copied := *t
+	return &copied
+}
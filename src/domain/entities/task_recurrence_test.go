@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSetRecurrence_RequiresDueDate(t *testing.T) {
+	task, _ := NewTask("chores", "")
+	rule, _ := value_objects.ParseRecurrenceRule("FREQ=WEEKLY")
+
+	if err := task.SetRecurrence(&rule); !errors.Is(err, ErrRecurrenceWithoutDueDate) {
+		t.Fatalf("Expected ErrRecurrenceWithoutDueDate, got %v", err)
+	}
+
+	due := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	task.SetDueDate(&due)
+	if err := task.SetRecurrence(&rule); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := task.SetDueDate(nil); !errors.Is(err, ErrRecurrenceWithoutDueDate) {
+		t.Errorf("Expected removing the due date of a recurring task to fail, got %v", err)
+	}
+}
+
+func TestSpawnNextOccurrence(t *testing.T) {
+	task, _ := NewTask("chores", "weekly")
+	task.AddTags("home")
+	task.ProjectID = value_objects.NewProjectId()
+	item, _ := task.AddChecklistItem("vacuum")
+	task.AddChecklistItem("dust")
+	task.CheckChecklistItem(item.ID, true)
+	due := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	task.SetDueDate(&due)
+	rule, _ := value_objects.ParseRecurrenceRule("FREQ=WEEKLY;COUNT=2")
+	task.SetRecurrence(&rule)
+
+	next, ok := task.SpawnNextOccurrence()
+	if !ok {
+		t.Fatalf("Expected a next occurrence")
+	}
+	if next.ID == task.ID || next.Status != value_objects.StatusTodo || next.Title != "chores" || !next.HasTag("home") {
+		t.Errorf("Unexpected next occurrence: %+v", next)
+	}
+	if next.ProjectID != task.ProjectID {
+		t.Errorf("Expected the next occurrence in project %s, got %q", task.ProjectID, next.ProjectID)
+	}
+	if len(next.Checklist) != 2 || next.Checklist[0].Text != "vacuum" || next.Checklist[1].Text != "dust" {
+		t.Fatalf("Expected the checklist to be copied, got %+v", next.Checklist)
+	}
+	if next.Checklist[0].Checked || next.Checklist[1].Checked || next.Checklist[0].ID == item.ID {
+		t.Errorf("Expected fresh unchecked checklist items, got %+v", next.Checklist)
+	}
+	if !task.Checklist[0].Checked {
+		t.Errorf("Expected the completed task to keep its checked items")
+	}
+	if !next.DueDate.Equal(due.AddDate(0, 0, 7)) || !next.RecurrenceStart.Equal(due) {
+		t.Errorf("Expected next due %v with series start %v, got %v / %v", due.AddDate(0, 0, 7), due, next.DueDate, next.RecurrenceStart)
+	}
+	if task.Recurrence != nil {
+		t.Errorf("Expected the series to move on to the new task")
+	}
+	if _, ok := task.SpawnNextOccurrence(); ok {
+		t.Errorf("Expected the completed task not to spawn twice")
+	}
+
+	// COUNT=2 is exhausted by the second occurrence.
+	if _, ok := next.SpawnNextOccurrence(); ok {
+		t.Errorf("Expected the series to end after COUNT occurrences")
+	}
+}
@@ -2,6 +2,8 @@ package entities
 
 import (
 	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -64,3 +66,60 @@ func TestUpdateStatus(t *testing.T) {
 		t.Error("Expected error for done to todo transition")
 	}
 }
+
+func TestAddTags_NormalizesAndDeduplicates(t *testing.T) {
+	task, _ := NewTask("Test", "Desc")
+
+	if err := task.AddTags("Home", "home", " HOME "); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := task.AddTags("work", "Home"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(task.Tags) != 2 || task.Tags[0] != "home" || task.Tags[1] != "work" {
+		t.Errorf("Expected tags [home work], got %v", task.Tags)
+	}
+}
+
+func TestAddTags_InvalidTagLeavesTaskUnchanged(t *testing.T) {
+	task, _ := NewTask("Test", "Desc")
+	task.AddTags("home")
+
+	err := task.AddTags("work", "")
+	if !errors.Is(err, value_objects.ErrInvalidTag) {
+		t.Fatalf("Expected ErrInvalidTag, got %v", err)
+	}
+	if len(task.Tags) != 1 {
+		t.Errorf("Expected tags to be unchanged, got %v", task.Tags)
+	}
+}
+
+func TestAddTags_TooMany(t *testing.T) {
+	task, _ := NewTask("Test", "Desc")
+	for i := 0; i < MaxTagsPerTask; i++ {
+		if err := task.AddTags(fmt.Sprintf("tag-%d", i)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	if err := task.AddTags("one-more"); !errors.Is(err, ErrTooManyTags) {
+		t.Errorf("Expected ErrTooManyTags, got %v", err)
+	}
+}
+
+func TestRemoveTag(t *testing.T) {
+	task, _ := NewTask("Test", "Desc")
+	task.AddTags("home", "work")
+
+	if err := task.RemoveTag("HOME"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if task.HasTag("home") || !task.HasTag("work") {
+		t.Errorf("Expected only 'work' to remain, got %v", task.Tags)
+	}
+
+	if err := task.RemoveTag("missing"); err != nil {
+		t.Errorf("Expected removing a missing tag to succeed, got %v", err)
+	}
+}
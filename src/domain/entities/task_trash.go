@@ -0,0 +1,23 @@
+package entities
+
+import "time"
+
+// MoveToTrash marks the task as deleted at the given time.
+func (t *Task) MoveToTrash(at time.Time) {
+	t.DeletedAt = &at
+}
+
+// Restore clears the deletion mark of a trashed task.
+func (t *Task) Restore() {
+	t.DeletedAt = nil
+}
+
+// IsTrashed reports whether the task has been moved to the trash.
+func (t *Task) IsTrashed() bool {
+	return t.DeletedAt != nil
+}
+
+// TrashExpired reports whether the task has been in the trash for longer than retention at now.
+func (t *Task) TrashExpired(retention time.Duration, now time.Time) bool {
+	return t.DeletedAt != nil && now.Sub(*t.DeletedAt) >= retention
+}
@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTask_TrashAndRestore(t *testing.T) {
+	task, _ := NewTask("t", "")
+	deletedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	if task.TrashExpired(time.Hour, deletedAt.Add(2*time.Hour)) {
+		t.Errorf("Expected an active task never to expire")
+	}
+	task.MoveToTrash(deletedAt)
+	if !task.IsTrashed() {
+		t.Fatalf("Expected task to be trashed")
+	}
+	if task.TrashExpired(time.Hour, deletedAt.Add(59*time.Minute)) {
+		t.Errorf("Expected task to stay in the trash within the retention")
+	}
+	if !task.TrashExpired(time.Hour, deletedAt.Add(time.Hour)) {
+		t.Errorf("Expected task to expire after the retention")
+	}
+	task.Restore()
+	if task.IsTrashed() || task.DeletedAt != nil {
+		t.Errorf("Expected restore to clear DeletedAt")
+	}
+}
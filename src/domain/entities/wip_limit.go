@@ -0,0 +1,95 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrWIPLimitReached is returned when a status column is already full. It does not wrap
+// ErrInvalidInput: the move is valid but conflicts with the tasks already in the column.
+var ErrWIPLimitReached = errors.New("work in progress limit reached")
+
+// ErrInvalidWIPLimit is returned when a work-in-progress limit configuration cannot be parsed.
+var ErrInvalidWIPLimit = fmt.Errorf("%w: invalid work in progress limit", ErrInvalidInput)
+
+// WIPLimit caps the number of tasks in a status column. With PerUser the cap applies to the
+// tasks each user moved into the column rather than to the column as a whole.
+type WIPLimit struct {
+	Max     int
+	PerUser bool
+}
+
+// WIPLimits maps status columns to their limits; columns without an entry are unlimited.
+type WIPLimits map[value_objects.TaskStatus]WIPLimit
+
+// EnteredBy returns the user who moved the task into its current status,
+// or an empty string if it never changed status or the actor is unknown.
+func (t *Task) EnteredBy() string {
+	if len(t.History) == 0 {
+		return ""
+	}
+	last := t.History[len(t.History)-1]
+	if last.To != t.Status {
+		return ""
+	}
+	return last.Actor
+}
+
+// Check returns ErrWIPLimitReached if the task does not fit its status column next to the
+// given tasks of that column. The task itself is ignored if it is part of the column.
+func (l WIPLimits) Check(task *Task, column []*Task) error {
+	limit, ok := l[task.Status]
+	if !ok {
+		return nil
+	}
+	user := task.EnteredBy()
+	count := 0
+	for _, other := range column {
+		if other.ID == task.ID || other.Status != task.Status {
+			continue
+		}
+		if limit.PerUser && other.EnteredBy() != user {
+			continue
+		}
+		count++
+	}
+	if count < limit.Max {
+		return nil
+	}
+	if limit.PerUser {
+		return fmt.Errorf("%w: at most %d tasks in %s per user", ErrWIPLimitReached, limit.Max, task.Status)
+	}
+	return fmt.Errorf("%w: at most %d tasks in %s", ErrWIPLimitReached, limit.Max, task.Status)
+}
+
+// ParseWIPLimits parses a comma-separated list of limits such as "doing=3/user,todo=20".
+// A "/user" suffix makes the limit apply per user. An empty string means no limits.
+func ParseWIPLimits(s string) (WIPLimits, error) {
+	limits := WIPLimits{}
+	if strings.TrimSpace(s) == "" {
+		return limits, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q is not status=max", ErrInvalidWIPLimit, part)
+		}
+		status := value_objects.TaskStatus(strings.ToLower(strings.TrimSpace(name)))
+		if !status.IsValid() {
+			return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidWIPLimit, name)
+		}
+		if _, exists := limits[status]; exists {
+			return nil, fmt.Errorf("%w: duplicate status %q", ErrInvalidWIPLimit, name)
+		}
+		value, perUser := strings.CutSuffix(strings.TrimSpace(value), "/user")
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("%w: %q is not a positive number", ErrInvalidWIPLimit, value)
+		}
+		limits[status] = WIPLimit{Max: n, PerUser: perUser}
+	}
+	return limits, nil
+}
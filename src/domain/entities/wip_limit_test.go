@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"testing"
+)
+
+func taskInDoing(actor string) *Task {
+	task, _ := NewTask("t", "")
+	task.UpdateStatusBy(actor, value_objects.StatusDoing)
+	return task
+}
+
+func TestWIPLimits_Check(t *testing.T) {
+	column := []*Task{taskInDoing("alice"), taskInDoing("bob")}
+
+	columnLimit := WIPLimits{value_objects.StatusDoing: {Max: 2}}
+	if err := columnLimit.Check(taskInDoing("carol"), column); !errors.Is(err, ErrWIPLimitReached) {
+		t.Errorf("Expected ErrWIPLimitReached for a full column, got %v", err)
+	}
+	if err := columnLimit.Check(column[0], column); err != nil {
+		t.Errorf("Expected a task already in the column to fit, got %v", err)
+	}
+
+	perUser := WIPLimits{value_objects.StatusDoing: {Max: 1, PerUser: true}}
+	if err := perUser.Check(taskInDoing("carol"), column); err != nil {
+		t.Errorf("Expected carol to fit, got %v", err)
+	}
+	if err := perUser.Check(taskInDoing("alice"), column); !errors.Is(err, ErrWIPLimitReached) {
+		t.Errorf("Expected ErrWIPLimitReached for alice, got %v", err)
+	}
+
+	todo, _ := NewTask("t", "")
+	if err := perUser.Check(todo, nil); err != nil {
+		t.Errorf("Expected an unlimited column to accept the task, got %v", err)
+	}
+}
+
+func TestParseWIPLimits(t *testing.T) {
+	limits, err := ParseWIPLimits(" doing=3/user , TODO=20")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if limits[value_objects.StatusDoing] != (WIPLimit{Max: 3, PerUser: true}) || limits[value_objects.StatusTodo] != (WIPLimit{Max: 20}) {
+		t.Errorf("Unexpected limits: %v", limits)
+	}
+	if limits, err := ParseWIPLimits(""); err != nil || len(limits) != 0 {
+		t.Errorf("Expected no limits, got %v (err=%v)", limits, err)
+	}
+	for _, s := range []string{"doing", "later=3", "doing=0", "doing=x/user", "doing=1,doing=2"} {
+		if _, err := ParseWIPLimits(s); !errors.Is(err, ErrInvalidWIPLimit) {
+			t.Errorf("ParseWIPLimits(%q) expected ErrInvalidWIPLimit, got %v", s, err)
+		}
+	}
+}
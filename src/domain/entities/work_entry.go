@@ -0,0 +1,119 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Sentinel errors for time tracking business rules
+var (
+	ErrMissingUser         = fmt.Errorf("%w: time tracking requires a user", ErrInvalidInput)
+	ErrInvalidWorkInterval = fmt.Errorf("%w: work must end after it starts and not in the future", ErrInvalidInput)
+	ErrTimerRunning        = fmt.Errorf("%w: a timer is already running", ErrInvalidInput)
+	ErrTimerNotRunning     = fmt.Errorf("%w: no timer is running", ErrInvalidInput)
+	ErrOverlappingWork     = fmt.Errorf("%w: work overlaps an existing entry", ErrInvalidInput)
+)
+
+// WorkEntry records time a user spent on a task.
+// Entries created by a timer are running until stopped; manual entries are logged with both ends.
+type WorkEntry struct {
+	ID     value_objects.WorkEntryId
+	TaskID value_objects.TaskId
+	User   string
+	Start  time.Time
+	// End is nil while the timer is running.
+	End  *time.Time
+	Note string
+}
+
+// StartTimer creates a running work entry for user on the task.
+func StartTimer(taskID value_objects.TaskId, user string, now time.Time) (*WorkEntry, error) {
+	user = strings.TrimSpace(user)
+	if user == "" {
+		return nil, ErrMissingUser
+	}
+	return &WorkEntry{
+		ID:     value_objects.NewWorkEntryId(),
+		TaskID: taskID,
+		User:   user,
+		Start:  now,
+	}, nil
+}
+
+// NewWorkEntry creates a manually logged work entry covering [start, end).
+// The interval must not be empty and must not end after now.
+func NewWorkEntry(taskID value_objects.TaskId, user string, start, end time.Time, note string, now time.Time) (*WorkEntry, error) {
+	entry, err := StartTimer(taskID, user, start)
+	if err != nil {
+		return nil, err
+	}
+	if !end.After(start) || end.After(now) {
+		return nil, ErrInvalidWorkInterval
+	}
+	entry.End = &end
+	entry.Note = strings.TrimSpace(note)
+	return entry, nil
+}
+
+// IsRunning reports whether the entry is a timer that has not been stopped.
+func (e *WorkEntry) IsRunning() bool {
+	return e.End == nil
+}
+
+// Stop ends a running timer at now.
+func (e *WorkEntry) Stop(now time.Time) error {
+	if !e.IsRunning() {
+		return ErrTimerNotRunning
+	}
+	if now.Before(e.Start) {
+		return ErrInvalidWorkInterval
+	}
+	e.End = &now
+	return nil
+}
+
+// Duration returns the logged time; a running timer counts until now.
+func (e *WorkEntry) Duration(now time.Time) time.Duration {
+	return e.endOr(now).Sub(e.Start)
+}
+
+// Interval returns the covered time span, treating a running timer as ending at now.
+func (e *WorkEntry) Interval(now time.Time) (time.Time, time.Time) {
+	return e.Start, e.endOr(now)
+}
+
+func (e *WorkEntry) endOr(now time.Time) time.Time {
+	if e.End != nil {
+		return *e.End
+	}
+	if now.Before(e.Start) {
+		return e.Start
+	}
+	return now
+}
+
+// CheckNoOverlap enforces that a user never tracks two things at once.
+// existing holds the other entries of the same user. A running timer is open-ended,
+// so a second timer is rejected with ErrTimerRunning and any entry reaching past its start
+// with ErrOverlappingWork.
+func CheckNoOverlap(entry *WorkEntry, existing []*WorkEntry) error {
+	for _, other := range existing {
+		if other.ID == entry.ID || other.User != entry.User {
+			continue
+		}
+		if entry.IsRunning() && other.IsRunning() {
+			return ErrTimerRunning
+		}
+		if overlaps(entry, other) {
+			return ErrOverlappingWork
+		}
+	}
+	return nil
+}
+
+// overlaps reports whether the half-open intervals of a and b intersect; running entries never end.
+func overlaps(a, b *WorkEntry) bool {
+	return (b.End == nil || a.Start.Before(*b.End)) && (a.End == nil || b.Start.Before(*a.End))
+}
@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"errors"
+	"testing"
+	"time"
+)
+
+func at(hour, minute int) time.Time {
+	return time.Date(2026, 10, 19, hour, minute, 0, 0, time.UTC)
+}
+
+func TestNewWorkEntry_Validation(t *testing.T) {
+	taskID := value_objects.NewTaskId()
+	now := at(18, 0)
+	if _, err := NewWorkEntry(taskID, "", at(9, 0), at(10, 0), "", now); !errors.Is(err, ErrMissingUser) {
+		t.Errorf("Expected ErrMissingUser, got %v", err)
+	}
+	if _, err := NewWorkEntry(taskID, "alice", at(10, 0), at(10, 0), "", now); !errors.Is(err, ErrInvalidWorkInterval) {
+		t.Errorf("Expected ErrInvalidWorkInterval for an empty interval, got %v", err)
+	}
+	if _, err := NewWorkEntry(taskID, "alice", at(17, 0), at(19, 0), "", now); !errors.Is(err, ErrInvalidWorkInterval) {
+		t.Errorf("Expected ErrInvalidWorkInterval for work ending in the future, got %v", err)
+	}
+	entry, err := NewWorkEntry(taskID, "alice", at(9, 0), at(10, 30), " notes ", now)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if entry.Duration(now) != 90*time.Minute || entry.Note != "notes" || entry.IsRunning() {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+}
+
+func TestTimer_StartStop(t *testing.T) {
+	entry, err := StartTimer(value_objects.NewTaskId(), "alice", at(9, 0))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !entry.IsRunning() || entry.Duration(at(9, 15)) != 15*time.Minute {
+		t.Errorf("Expected a running timer counting until now")
+	}
+	if err := entry.Stop(at(10, 0)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := entry.Stop(at(11, 0)); !errors.Is(err, ErrTimerNotRunning) {
+		t.Errorf("Expected ErrTimerNotRunning, got %v", err)
+	}
+	if entry.Duration(at(12, 0)) != time.Hour {
+		t.Errorf("Expected a stopped timer to keep its duration, got %v", entry.Duration(at(12, 0)))
+	}
+}
+
+func TestCheckNoOverlap(t *testing.T) {
+	taskID := value_objects.NewTaskId()
+	now := at(18, 0)
+	morning, _ := NewWorkEntry(taskID, "alice", at(9, 0), at(12, 0), "", now)
+	running, _ := StartTimer(taskID, "alice", at(14, 0))
+	existing := []*WorkEntry{morning, running}
+
+	adjacent, _ := NewWorkEntry(taskID, "alice", at(12, 0), at(13, 0), "", now)
+	if err := CheckNoOverlap(adjacent, existing); err != nil {
+		t.Errorf("Expected touching intervals to be allowed, got %v", err)
+	}
+	inside, _ := NewWorkEntry(taskID, "alice", at(11, 0), at(13, 0), "", now)
+	if err := CheckNoOverlap(inside, existing); !errors.Is(err, ErrOverlappingWork) {
+		t.Errorf("Expected ErrOverlappingWork, got %v", err)
+	}
+	afterTimer, _ := NewWorkEntry(taskID, "alice", at(15, 0), at(16, 0), "", now)
+	if err := CheckNoOverlap(afterTimer, existing); !errors.Is(err, ErrOverlappingWork) {
+		t.Errorf("Expected work after a running timer's start to overlap, got %v", err)
+	}
+	second, _ := StartTimer(value_objects.NewTaskId(), "alice", at(15, 0))
+	if err := CheckNoOverlap(second, existing); !errors.Is(err, ErrTimerRunning) {
+		t.Errorf("Expected ErrTimerRunning, got %v", err)
+	}
+	otherUser, _ := StartTimer(taskID, "bob", at(15, 0))
+	if err := CheckNoOverlap(otherUser, existing); err != nil {
+		t.Errorf("Expected other users to be unaffected, got %v", err)
+	}
+	if err := running.Stop(at(16, 0)); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckNoOverlap(running, existing); err != nil {
+		t.Errorf("Expected an entry not to overlap itself, got %v", err)
+	}
+}
@@ -0,0 +1,27 @@
+package value_objects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentId represents a unique identifier for a file attached to a task.
+// It uses UUID (RFC 4122) string format.
+type AttachmentId string
+
+// NewAttachmentId generates a new UUID-based AttachmentId.
+func NewAttachmentId() AttachmentId {
+	return AttachmentId(uuid.NewString())
+}
+
+// ErrInvalidAttachmentId indicates the provided attachment id is invalid or malformed.
+var ErrInvalidAttachmentId = errors.New("invalid attachment id")
+
+// ParseAttachmentId validates and parses a string into an AttachmentId.
+func ParseAttachmentId(s string) (AttachmentId, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", ErrInvalidAttachmentId
+	}
+	return AttachmentId(s), nil
+}
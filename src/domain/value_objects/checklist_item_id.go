@@ -0,0 +1,27 @@
+package value_objects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ChecklistItemId represents a unique identifier for an item of a task checklist.
+// It uses UUID (RFC 4122) string format.
+type ChecklistItemId string
+
+// NewChecklistItemId generates a new UUID-based ChecklistItemId.
+func NewChecklistItemId() ChecklistItemId {
+	return ChecklistItemId(uuid.NewString())
+}
+
+// ErrInvalidChecklistItemId indicates the provided checklist item id is invalid or malformed.
+var ErrInvalidChecklistItemId = errors.New("invalid checklist item id")
+
+// ParseChecklistItemId validates and parses a string into an ChecklistItemId.
+func ParseChecklistItemId(s string) (ChecklistItemId, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", ErrInvalidChecklistItemId
+	}
+	return ChecklistItemId(s), nil
+}
@@ -0,0 +1,27 @@
+package value_objects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// CommentId represents a unique identifier for a comment.
+// It uses UUID (RFC 4122) string format.
+type CommentId string
+
+// NewCommentId generates a new UUID-based CommentId.
+func NewCommentId() CommentId {
+	return CommentId(uuid.NewString())
+}
+
+// ErrInvalidCommentId indicates the provided comment id is invalid or malformed.
+var ErrInvalidCommentId = errors.New("invalid comment id")
+
+// ParseCommentId validates and parses a string into a CommentId.
+func ParseCommentId(s string) (CommentId, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", ErrInvalidCommentId
+	}
+	return CommentId(s), nil
+}
@@ -0,0 +1,56 @@
+package value_objects
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrInvalidPriority indicates a priority that is not one of the allowed levels.
+var ErrInvalidPriority = errors.New("invalid priority, expected low, medium, high or urgent")
+
+// Priority represents how urgent a task is.
+type Priority string
+
+const (
+	PriorityLow    Priority = "low"
+	PriorityMedium Priority = "medium"
+	PriorityHigh   Priority = "high"
+	PriorityUrgent Priority = "urgent"
+)
+
+// DefaultPriority is the priority of tasks created without one.
+const DefaultPriority = PriorityMedium
+
+// ParsePriority parses a priority level, ignoring case.
+func ParsePriority(s string) (Priority, error) {
+	priority := Priority(strings.ToLower(s))
+	if !priority.IsValid() {
+		return "", ErrInvalidPriority
+	}
+	return priority, nil
+}
+
+// String returns the string representation of the priority.
+func (p Priority) String() string {
+	return string(p)
+}
+
+// IsValid checks if the priority is one of the allowed levels.
+func (p Priority) IsValid() bool {
+	return p.Level() >= 0
+}
+
+// Level returns the position of the priority from low to urgent, or -1 for an invalid priority.
+func (p Priority) Level() int {
+	for i, candidate := range AllPriorities() {
+		if candidate == p {
+			return i
+		}
+	}
+	return -1
+}
+
+// AllPriorities returns every priority from low to urgent.
+func AllPriorities() []Priority {
+	return []Priority{PriorityLow, PriorityMedium, PriorityHigh, PriorityUrgent}
+}
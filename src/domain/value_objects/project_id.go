@@ -0,0 +1,27 @@
+package value_objects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ProjectId represents a unique identifier for a project.
+// It uses UUID (RFC 4122) string format.
+type ProjectId string
+
+// NewProjectId generates a new UUID-based ProjectId.
+func NewProjectId() ProjectId {
+	return ProjectId(uuid.NewString())
+}
+
+// ErrInvalidProjectId indicates the provided project id is invalid or malformed.
+var ErrInvalidProjectId = errors.New("invalid project id")
+
+// ParseProjectId validates and parses a string into a ProjectId.
+func ParseProjectId(s string) (ProjectId, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", ErrInvalidProjectId
+	}
+	return ProjectId(s), nil
+}
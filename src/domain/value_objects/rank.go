@@ -0,0 +1,110 @@
+package value_objects
+
+import (
+	"errors"
+	"strings"
+)
+
+// rankDigits is the alphabet of ranks, in ascending order.
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// ErrInvalidRank indicates a malformed rank or a pair of ranks with no room between them.
+var ErrInvalidRank = errors.New("invalid rank")
+
+// Rank orders tasks within a status column.
+// A rank is read as the digits of a base-36 fraction between 0 and 1 ("i" is 0.5), so
+// plain string comparison orders ranks and a new rank fits between any two different ones
+// without renumbering its neighbours. Ranks never end in "0", which keeps that comparison exact.
+type Rank string
+
+// ParseRank validates a rank.
+func ParseRank(s string) (Rank, error) {
+	if s == "" || strings.HasSuffix(s, "0") {
+		return "", ErrInvalidRank
+	}
+	for _, c := range s {
+		if !strings.ContainsRune(rankDigits, c) {
+			return "", ErrInvalidRank
+		}
+	}
+	return Rank(s), nil
+}
+
+// String returns the string representation of the rank.
+func (r Rank) String() string {
+	return string(r)
+}
+
+// RankBetween returns a rank strictly between lower and upper.
+// An empty lower means the start of the column and an empty upper its end.
+// It fails with ErrInvalidRank if lower does not sort before upper.
+func RankBetween(lower, upper Rank) (Rank, error) {
+	if upper != "" && lower >= upper {
+		return "", ErrInvalidRank
+	}
+	return Rank(midpoint(string(lower), string(upper))), nil
+}
+
+// EvenRanks returns n ascending ranks spread evenly over the whole range, so that
+// a rebalanced column leaves room on both sides of every task.
+func EvenRanks(n int) []Rank {
+	base := len(rankDigits)
+	width, capacity := 1, base
+	for capacity <= n {
+		width++
+		capacity *= base
+	}
+	ranks := make([]Rank, n)
+	for i := 0; i < n; i++ {
+		value := (i + 1) * capacity / (n + 1)
+		digits := make([]byte, width)
+		for d := width - 1; d >= 0; d-- {
+			digits[d] = rankDigits[value%base]
+			value /= base
+		}
+		ranks[i] = Rank(strings.TrimRight(string(digits), "0"))
+	}
+	return ranks
+}
+
+// midpoint returns a digit string between a and b, where an empty b stands for 1.
+// Both arguments must be free of trailing zeros and a must sort before b.
+func midpoint(a, b string) string {
+	// Skip the common prefix, reading missing digits of a as zeros.
+	n := 0
+	for n < len(b) && rankDigit(a, n) == strings.IndexByte(rankDigits, b[n]) {
+		n++
+	}
+	if n > 0 {
+		rest := ""
+		if n < len(a) {
+			rest = a[n:]
+		}
+		return b[:n] + midpoint(rest, b[n:])
+	}
+	digitA := rankDigit(a, 0)
+	digitB := len(rankDigits)
+	if b != "" {
+		digitB = strings.IndexByte(rankDigits, b[0])
+	}
+	if digitB-digitA > 1 {
+		return string(rankDigits[(digitA+digitB)/2])
+	}
+	// The first digits are consecutive: b's first digit alone works if b has more digits,
+	// otherwise keep a's digit and find room after it.
+	if len(b) > 1 {
+		return b[:1]
+	}
+	rest := ""
+	if len(a) > 1 {
+		rest = a[1:]
+	}
+	return string(rankDigits[digitA]) + midpoint(rest, "")
+}
+
+func rankDigit(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	return strings.IndexByte(rankDigits, s[i])
+}
@@ -0,0 +1,92 @@
+package value_objects
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestRankBetween(t *testing.T) {
+	cases := []struct{ lower, upper, want Rank }{
+		{"", "", "i"},
+		{"i", "", "r"},
+		{"", "i", "9"},
+		{"a", "b", "ai"},
+		{"a", "az", "ah"},
+		{"az", "b", "azi"},
+		{"1", "11", "10i"},
+		{"", "1", "0i"},
+	}
+	for _, c := range cases {
+		got, err := RankBetween(c.lower, c.upper)
+		if err != nil {
+			t.Fatalf("RankBetween(%q, %q) failed: %v", c.lower, c.upper, err)
+		}
+		if got != c.want {
+			t.Errorf("RankBetween(%q, %q) = %q, want %q", c.lower, c.upper, got, c.want)
+		}
+	}
+}
+
+func TestRankBetween_InvalidOrder(t *testing.T) {
+	for _, pair := range [][2]Rank{{"b", "a"}, {"a", "a"}} {
+		if _, err := RankBetween(pair[0], pair[1]); !errors.Is(err, ErrInvalidRank) {
+			t.Errorf("RankBetween(%q, %q) expected ErrInvalidRank, got %v", pair[0], pair[1], err)
+		}
+	}
+}
+
+func TestRankBetween_RandomInsertsStayOrdered(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	ranks := []Rank{}
+	for i := 0; i < 500; i++ {
+		pos := rng.Intn(len(ranks) + 1)
+		var lower, upper Rank
+		if pos > 0 {
+			lower = ranks[pos-1]
+		}
+		if pos < len(ranks) {
+			upper = ranks[pos]
+		}
+		rank, err := RankBetween(lower, upper)
+		if err != nil {
+			t.Fatalf("insert %d failed: %v", i, err)
+		}
+		if _, err := ParseRank(rank.String()); err != nil {
+			t.Fatalf("generated invalid rank %q", rank)
+		}
+		if rank <= lower || (upper != "" && rank >= upper) {
+			t.Fatalf("rank %q is not between %q and %q", rank, lower, upper)
+		}
+		ranks = append(ranks[:pos], append([]Rank{rank}, ranks[pos:]...)...)
+	}
+}
+
+func TestEvenRanks(t *testing.T) {
+	for _, n := range []int{0, 1, 3, 35, 36, 1000} {
+		ranks := EvenRanks(n)
+		if len(ranks) != n {
+			t.Fatalf("EvenRanks(%d) returned %d ranks", n, len(ranks))
+		}
+		if !sort.SliceIsSorted(ranks, func(i, j int) bool { return ranks[i] < ranks[j] }) {
+			t.Errorf("EvenRanks(%d) is not ascending", n)
+		}
+		for i, rank := range ranks {
+			if _, err := ParseRank(rank.String()); err != nil {
+				t.Errorf("EvenRanks(%d)[%d] = %q is invalid", n, i, rank)
+			}
+			if i > 0 && ranks[i-1] == rank {
+				t.Errorf("EvenRanks(%d) has duplicate %q", n, rank)
+			}
+		}
+	}
+}
+
+func TestParseRank_Invalid(t *testing.T) {
+	for _, s := range []string{"", "a0", "A", "a-b"} {
+		if _, err := ParseRank(s); !errors.Is(err, ErrInvalidRank) {
+			t.Errorf("ParseRank(%q) expected ErrInvalidRank, got %v", s, err)
+		}
+	}
+}
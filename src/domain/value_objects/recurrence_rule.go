@@ -0,0 +1,372 @@
+package value_objects
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRecurrence indicates the provided recurrence rule is malformed or unsupported.
+var ErrInvalidRecurrence = errors.New("invalid recurrence rule")
+
+// Frequency is the FREQ part of a recurrence rule.
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+	FrequencyYearly  Frequency = "YEARLY"
+)
+
+// maxRecurrencePeriods bounds the number of periods scanned when looking for the next occurrence.
+const maxRecurrencePeriods = 100000
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// WeekdayNum is a BYDAY entry such as "MO", "2TU" or "-1FR".
+// Ordinal is zero for every matching weekday in the period, otherwise the n-th
+// (or, if negative, n-th last) matching weekday of the month.
+type WeekdayNum struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// String returns the iCalendar representation of the entry.
+func (w WeekdayNum) String() string {
+	code := strings.ToUpper(w.Weekday.String()[:2])
+	if w.Ordinal == 0 {
+		return code
+	}
+	return strconv.Itoa(w.Ordinal) + code
+}
+
+// RecurrenceRule is the supported subset of an RFC 5545 RRULE:
+// FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, BYDAY, COUNT and UNTIL.
+//
+// Occurrences keep the wall-clock time of the series start in its location, so a
+// weekly 09:00 task stays at 09:00 across daylight saving changes. Following RFC 5545,
+// dates that do not exist (e.g. the 31st in a 30-day month, or February 29th in a
+// common year) are skipped, a time falling into a DST gap is shifted forward by the
+// length of the gap, and an ambiguous time resolves to its first occurrence.
+type RecurrenceRule struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []WeekdayNum
+	// Count limits the total number of occurrences, including the first one. Zero means unlimited.
+	Count int
+	// Until is the last instant an occurrence may fall on. The zero value means unlimited.
+	Until time.Time
+	// untilIsDate reports whether Until was given as a DATE, which includes the whole
+	// day in the location of the series start.
+	untilIsDate bool
+}
+
+// ParseRecurrenceRule parses an RRULE value such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH".
+// An optional "RRULE:" prefix is accepted. UNTIL must be a UTC date-time
+// ("20261231T235959Z") or a date ("20261231").
+func ParseRecurrenceRule(s string) (RecurrenceRule, error) {
+	s = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(s)), "RRULE:")
+	rule := RecurrenceRule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" || seen[name] {
+			return RecurrenceRule{}, invalidRecurrence("malformed part %q", part)
+		}
+		seen[name] = true
+		var err error
+		switch name {
+		case "FREQ":
+			rule.Freq = Frequency(value)
+			switch rule.Freq {
+			case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+			default:
+				err = invalidRecurrence("unsupported FREQ %q", value)
+			}
+		case "INTERVAL":
+			rule.Interval, err = parsePositive(name, value)
+		case "COUNT":
+			rule.Count, err = parsePositive(name, value)
+		case "UNTIL":
+			rule.Until, rule.untilIsDate, err = parseUntil(value)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(value)
+		default:
+			err = invalidRecurrence("unsupported part %q", name)
+		}
+		if err != nil {
+			return RecurrenceRule{}, err
+		}
+	}
+	if err := rule.validate(); err != nil {
+		return RecurrenceRule{}, err
+	}
+	return rule, nil
+}
+
+func (r RecurrenceRule) validate() error {
+	if r.Freq == "" {
+		return invalidRecurrence("FREQ is required")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return invalidRecurrence("COUNT and UNTIL cannot be combined")
+	}
+	for _, day := range r.ByDay {
+		if day.Ordinal != 0 && r.Freq != FrequencyMonthly {
+			return invalidRecurrence("BYDAY ordinals are only supported with FREQ=MONTHLY")
+		}
+	}
+	if len(r.ByDay) > 0 && r.Freq == FrequencyYearly {
+		return invalidRecurrence("BYDAY is not supported with FREQ=YEARLY")
+	}
+	return nil
+}
+
+// String returns the canonical RRULE representation of the rule.
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			days[i] = day.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		if r.untilIsDate {
+			parts = append(parts, "UNTIL="+r.Until.Format("20060102"))
+		} else {
+			parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+		}
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next returns the first occurrence of the series starting at dtstart that is strictly
+// after the given instant. The series start itself is the first occurrence.
+// The boolean is false when the series has ended because of COUNT or UNTIL.
+func (r RecurrenceRule) Next(dtstart, after time.Time) (time.Time, bool) {
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	// The series start is always the first occurrence, even if it does not match BYDAY.
+	emitted := 1
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		for _, candidate := range r.expand(dtstart, period*interval) {
+			if !candidate.After(dtstart) {
+				continue
+			}
+			if r.pastUntil(candidate, dtstart.Location()) {
+				return time.Time{}, false
+			}
+			emitted++
+			if r.Count > 0 && emitted > r.Count {
+				return time.Time{}, false
+			}
+			if candidate.After(after) {
+				return candidate, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+func (r RecurrenceRule) pastUntil(candidate time.Time, loc *time.Location) bool {
+	if r.Until.IsZero() {
+		return false
+	}
+	if r.untilIsDate {
+		y, m, d := candidate.In(loc).Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).After(r.Until)
+	}
+	return candidate.After(r.Until)
+}
+
+// expand returns the sorted occurrences of the period that is offset periods after the one containing dtstart.
+func (r RecurrenceRule) expand(dtstart time.Time, offset int) []time.Time {
+	loc := dtstart.Location()
+	year, month, day := dtstart.Date()
+	at := func(y int, m time.Month, d int) time.Time {
+		return wallClock(y, m, d, dtstart, loc)
+	}
+	var dates []time.Time
+	switch r.Freq {
+	case FrequencyDaily:
+		date := at(year, month, day+offset)
+		if len(r.ByDay) == 0 || r.matchesWeekday(date.Weekday()) {
+			dates = append(dates, date)
+		}
+	case FrequencyWeekly:
+		// Weeks start on Monday (the RFC 5545 default WKST).
+		monday := day - daysSinceMonday(dtstart.Weekday()) + 7*offset
+		if len(r.ByDay) == 0 {
+			dates = append(dates, at(year, month, monday+daysSinceMonday(dtstart.Weekday())))
+		}
+		for _, byDay := range r.ByDay {
+			dates = append(dates, at(year, month, monday+daysSinceMonday(byDay.Weekday)))
+		}
+	case FrequencyMonthly:
+		first := time.Date(year, month+time.Month(offset), 1, 0, 0, 0, 0, time.UTC)
+		if len(r.ByDay) == 0 {
+			if day <= daysIn(first.Year(), first.Month()) {
+				dates = append(dates, at(first.Year(), first.Month(), day))
+			}
+		}
+		for _, byDay := range r.ByDay {
+			for _, d := range weekdaysInMonth(first.Year(), first.Month(), byDay) {
+				dates = append(dates, at(first.Year(), first.Month(), d))
+			}
+		}
+	case FrequencyYearly:
+		y := year + offset
+		if day <= daysIn(y, month) {
+			dates = append(dates, at(y, month, day))
+		}
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+	return dedupTimes(dates)
+}
+
+func (r RecurrenceRule) matchesWeekday(weekday time.Weekday) bool {
+	for _, byDay := range r.ByDay {
+		if byDay.Weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// wallClock returns the given date at the wall-clock time of ref in loc, resolving
+// DST gaps and overlaps the way RFC 5545 requires.
+func wallClock(year int, month time.Month, day int, ref time.Time, loc *time.Location) time.Time {
+	hour, minute, sec := ref.Clock()
+	// Normalize the date first, so day overflow (e.g. day 32) is handled independently of the zone.
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	wall := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, sec, ref.Nanosecond(), time.UTC)
+	_, offsetBefore := wall.Add(-26 * time.Hour).In(loc).Zone()
+	_, offsetAfter := wall.Add(26 * time.Hour).In(loc).Zone()
+	var valid []time.Time
+	for _, offset := range []int{offsetBefore, offsetAfter} {
+		candidate := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if sameWallClock(candidate, wall) {
+			valid = append(valid, candidate)
+		}
+	}
+	switch {
+	case len(valid) == 0:
+		// The wall-clock time falls into a gap: interpret it with the offset before the transition.
+		return wall.Add(-time.Duration(offsetBefore) * time.Second).In(loc)
+	case len(valid) == 2 && valid[1].Before(valid[0]):
+		return valid[1]
+	default:
+		return valid[0]
+	}
+}
+
+func sameWallClock(t, wall time.Time) bool {
+	y1, m1, d1 := t.Date()
+	y2, m2, d2 := wall.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2 && t.Hour() == wall.Hour() && t.Minute() == wall.Minute() && t.Second() == wall.Second()
+}
+
+func daysSinceMonday(weekday time.Weekday) int {
+	return (int(weekday) + 6) % 7
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// weekdaysInMonth returns the days of the month matching a BYDAY entry.
+func weekdaysInMonth(year int, month time.Month, byDay WeekdayNum) []int {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	firstMatch := 1 + (int(byDay.Weekday)-int(first.Weekday())+7)%7
+	var days []int
+	for d := firstMatch; d <= daysIn(year, month); d += 7 {
+		days = append(days, d)
+	}
+	switch {
+	case byDay.Ordinal > 0 && byDay.Ordinal <= len(days):
+		return []int{days[byDay.Ordinal-1]}
+	case byDay.Ordinal < 0 && -byDay.Ordinal <= len(days):
+		return []int{days[len(days)+byDay.Ordinal]}
+	case byDay.Ordinal == 0:
+		return days
+	default:
+		return nil
+	}
+}
+
+func dedupTimes(times []time.Time) []time.Time {
+	result := times[:0]
+	for i, t := range times {
+		if i == 0 || !t.Equal(times[i-1]) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func parsePositive(name, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, invalidRecurrence("%s must be a positive integer", name)
+	}
+	return n, nil
+}
+
+func parseUntil(value string) (time.Time, bool, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, false, nil
+	}
+	if t, err := time.Parse("20060102", value); err == nil {
+		return t, true, nil
+	}
+	return time.Time{}, false, invalidRecurrence("UNTIL must be a UTC date-time or a date")
+}
+
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+	for _, entry := range strings.Split(value, ",") {
+		if len(entry) < 2 {
+			return nil, invalidRecurrence("invalid BYDAY entry %q", entry)
+		}
+		weekday, ok := weekdayCodes[entry[len(entry)-2:]]
+		if !ok {
+			return nil, invalidRecurrence("invalid BYDAY entry %q", entry)
+		}
+		day := WeekdayNum{Weekday: weekday}
+		if prefix := entry[:len(entry)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, invalidRecurrence("invalid BYDAY entry %q", entry)
+			}
+			day.Ordinal = n
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+func invalidRecurrence(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRecurrence, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,233 @@
+package value_objects
+
+import (
+	"errors"
+	"testing"
+	"time"
+	_ "time/tzdata" // make the DST tests independent of the host's zoneinfo
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("load location %s: %v", name, err)
+	}
+	return loc
+}
+
+func mustParseRule(t *testing.T, s string) RecurrenceRule {
+	t.Helper()
+	rule, err := ParseRecurrenceRule(s)
+	if err != nil {
+		t.Fatalf("ParseRecurrenceRule(%q) failed: %v", s, err)
+	}
+	return rule
+}
+
+// occurrences returns up to n occurrences of the series, starting with dtstart.
+func occurrences(rule RecurrenceRule, dtstart time.Time, n int) []time.Time {
+	result := []time.Time{dtstart}
+	for len(result) < n {
+		next, ok := rule.Next(dtstart, result[len(result)-1])
+		if !ok {
+			break
+		}
+		result = append(result, next)
+	}
+	return result
+}
+
+func assertOccurrences(t *testing.T, got []time.Time, want ...string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d occurrences, got %d: %v", len(want), len(got), got)
+	}
+	for i := range want {
+		if formatted := got[i].Format(time.RFC3339); formatted != want[i] {
+			t.Errorf("occurrence %d: expected %s, got %s", i, want[i], formatted)
+		}
+	}
+}
+
+func TestParseRecurrenceRule_RoundTrip(t *testing.T) {
+	cases := map[string]string{
+		"FREQ=DAILY":                            "FREQ=DAILY",
+		"rrule:freq=weekly;interval=2;byday=MO": "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO",
+		"FREQ=MONTHLY;BYDAY=-1FR;COUNT=3":       "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+		"FREQ=YEARLY;UNTIL=20301231T000000Z":    "FREQ=YEARLY;UNTIL=20301231T000000Z",
+		"FREQ=WEEKLY;UNTIL=20261231;INTERVAL=1": "FREQ=WEEKLY;UNTIL=20261231",
+	}
+	for raw, want := range cases {
+		if got := mustParseRule(t, raw).String(); got != want {
+			t.Errorf("ParseRecurrenceRule(%q).String() = %q, want %q", raw, got, want)
+		}
+	}
+}
+
+func TestParseRecurrenceRule_Invalid(t *testing.T) {
+	invalid := []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=-1",
+		"FREQ=DAILY;COUNT=2;UNTIL=20261231",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYDAY=6MO",
+		"FREQ=YEARLY;BYDAY=MO",
+		"FREQ=DAILY;BYMONTH=1",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;UNTIL=tomorrow",
+	}
+	for _, raw := range invalid {
+		if _, err := ParseRecurrenceRule(raw); !errors.Is(err, ErrInvalidRecurrence) {
+			t.Errorf("ParseRecurrenceRule(%q) expected ErrInvalidRecurrence, got %v", raw, err)
+		}
+	}
+}
+
+func TestNext_Daily(t *testing.T) {
+	rule := mustParseRule(t, "FREQ=DAILY;INTERVAL=3")
+	start := time.Date(2026, 2, 26, 8, 0, 0, 0, time.UTC)
+	assertOccurrences(t, occurrences(rule, start, 3),
+		"2026-02-26T08:00:00Z", "2026-03-01T08:00:00Z", "2026-03-04T08:00:00Z")
+}
+
+func TestNext_DailyFilteredByDay(t *testing.T) {
+	rule := mustParseRule(t, "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR")
+	start := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC) // Friday
+	assertOccurrences(t, occurrences(rule, start, 3),
+		"2026-10-16T09:00:00Z", "2026-10-19T09:00:00Z", "2026-10-20T09:00:00Z")
+}
+
+func TestNext_WeeklyByDayWithInterval(t *testing.T) {
+	rule := mustParseRule(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,FR")
+	start := time.Date(2026, 10, 20, 18, 0, 0, 0, time.UTC) // Tuesday
+	assertOccurrences(t, occurrences(rule, start, 5),
+		"2026-10-20T18:00:00Z", "2026-10-23T18:00:00Z",
+		"2026-11-03T18:00:00Z", "2026-11-06T18:00:00Z",
+		"2026-11-17T18:00:00Z")
+}
+
+func TestNext_WeeklyStartNotOnByDay(t *testing.T) {
+	// The series start counts as the first occurrence even if it is not one of the BYDAY days.
+	rule := mustParseRule(t, "FREQ=WEEKLY;BYDAY=MO;COUNT=2")
+	start := time.Date(2026, 10, 21, 9, 0, 0, 0, time.UTC) // Wednesday
+	assertOccurrences(t, occurrences(rule, start, 5),
+		"2026-10-21T09:00:00Z", "2026-10-26T09:00:00Z")
+}
+
+func TestNext_WeeklyKeepsWallClockAcrossDST(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	rule := mustParseRule(t, "FREQ=WEEKLY")
+	// DST starts on 2026-03-08 and ends on 2026-11-01 in New York.
+	start := time.Date(2026, 3, 1, 9, 0, 0, 0, ny)
+	assertOccurrences(t, occurrences(rule, start, 3),
+		"2026-03-01T09:00:00-05:00", "2026-03-08T09:00:00-04:00", "2026-03-15T09:00:00-04:00")
+
+	start = time.Date(2026, 10, 25, 9, 0, 0, 0, ny)
+	assertOccurrences(t, occurrences(rule, start, 2),
+		"2026-10-25T09:00:00-04:00", "2026-11-01T09:00:00-05:00")
+}
+
+func TestNext_DailyInsideSpringForwardGap(t *testing.T) {
+	for _, name := range []string{"America/New_York", "Europe/Berlin"} {
+		loc := mustLoad(t, name)
+		rule := mustParseRule(t, "FREQ=DAILY")
+		// 02:30 does not exist on the day clocks spring forward; RFC 5545 moves it forward by the gap.
+		var start time.Time
+		var want []string
+		if name == "America/New_York" {
+			start = time.Date(2026, 3, 7, 2, 30, 0, 0, loc)
+			want = []string{"2026-03-07T02:30:00-05:00", "2026-03-08T03:30:00-04:00", "2026-03-09T02:30:00-04:00"}
+		} else {
+			start = time.Date(2026, 3, 28, 2, 30, 0, 0, loc)
+			want = []string{"2026-03-28T02:30:00+01:00", "2026-03-29T03:30:00+02:00", "2026-03-30T02:30:00+02:00"}
+		}
+		assertOccurrences(t, occurrences(rule, start, 3), want...)
+	}
+}
+
+func TestNext_DailyInsideFallBackOverlap(t *testing.T) {
+	for _, name := range []string{"America/New_York", "Europe/Berlin"} {
+		loc := mustLoad(t, name)
+		rule := mustParseRule(t, "FREQ=DAILY")
+		// The repeated hour resolves to its first occurrence (still on summer time).
+		var start time.Time
+		var want string
+		if name == "America/New_York" {
+			start = time.Date(2026, 10, 31, 1, 30, 0, 0, loc)
+			want = "2026-11-01T01:30:00-04:00"
+		} else {
+			start = time.Date(2026, 10, 24, 2, 30, 0, 0, loc)
+			want = "2026-10-25T02:30:00+02:00"
+		}
+		next, ok := rule.Next(start, start)
+		if !ok || next.Format(time.RFC3339) != want {
+			t.Errorf("%s: expected %s, got %s (ok=%v)", name, want, next.Format(time.RFC3339), ok)
+		}
+	}
+}
+
+func TestNext_MonthlySkipsMissingDays(t *testing.T) {
+	rule := mustParseRule(t, "FREQ=MONTHLY")
+	start := time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC)
+	assertOccurrences(t, occurrences(rule, start, 4),
+		"2026-01-31T12:00:00Z", "2026-03-31T12:00:00Z", "2026-05-31T12:00:00Z", "2026-07-31T12:00:00Z")
+
+	rule = mustParseRule(t, "FREQ=MONTHLY;INTERVAL=2")
+	start = time.Date(2026, 12, 30, 12, 0, 0, 0, time.UTC)
+	// February never has a 30th, so 2027-02 is skipped but still counts as a period.
+	assertOccurrences(t, occurrences(rule, start, 3),
+		"2026-12-30T12:00:00Z", "2027-04-30T12:00:00Z", "2027-06-30T12:00:00Z")
+}
+
+func TestNext_MonthlyByDayOrdinals(t *testing.T) {
+	rule := mustParseRule(t, "FREQ=MONTHLY;BYDAY=-1FR")
+	start := time.Date(2026, 1, 30, 17, 0, 0, 0, time.UTC)
+	assertOccurrences(t, occurrences(rule, start, 4),
+		"2026-01-30T17:00:00Z", "2026-02-27T17:00:00Z", "2026-03-27T17:00:00Z", "2026-04-24T17:00:00Z")
+
+	rule = mustParseRule(t, "FREQ=MONTHLY;BYDAY=5MO")
+	start = time.Date(2026, 3, 30, 9, 0, 0, 0, time.UTC)
+	// Only months with five Mondays have an occurrence.
+	assertOccurrences(t, occurrences(rule, start, 3),
+		"2026-03-30T09:00:00Z", "2026-06-29T09:00:00Z", "2026-08-31T09:00:00Z")
+}
+
+func TestNext_YearlyLeapDay(t *testing.T) {
+	rule := mustParseRule(t, "FREQ=YEARLY")
+	start := time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)
+	assertOccurrences(t, occurrences(rule, start, 3),
+		"2028-02-29T00:00:00Z", "2032-02-29T00:00:00Z", "2036-02-29T00:00:00Z")
+}
+
+func TestNext_CountAndUntil(t *testing.T) {
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+
+	rule := mustParseRule(t, "FREQ=DAILY;COUNT=3")
+	if got := occurrences(rule, start, 10); len(got) != 3 {
+		t.Errorf("expected COUNT=3 to yield 3 occurrences, got %d", len(got))
+	}
+
+	rule = mustParseRule(t, "FREQ=DAILY;UNTIL=20261021T090000Z")
+	assertOccurrences(t, occurrences(rule, start, 10),
+		"2026-10-19T09:00:00Z", "2026-10-20T09:00:00Z", "2026-10-21T09:00:00Z")
+
+	// A DATE value includes the whole day in the series' own location.
+	tokyo := mustLoad(t, "Asia/Tokyo")
+	rule = mustParseRule(t, "FREQ=DAILY;UNTIL=20261020")
+	assertOccurrences(t, occurrences(rule, time.Date(2026, 10, 19, 23, 0, 0, 0, tokyo), 10),
+		"2026-10-19T23:00:00+09:00", "2026-10-20T23:00:00+09:00")
+}
+
+func TestNext_AfterSkipsPastOccurrences(t *testing.T) {
+	rule := mustParseRule(t, "FREQ=WEEKLY;BYDAY=MO")
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	next, ok := rule.Next(start, time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC))
+	if !ok || !next.Equal(time.Date(2026, 10, 26, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected 2026-10-26T09:00Z, got %v (ok=%v)", next, ok)
+	}
+}
@@ -0,0 +1,41 @@
+package value_objects
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxTagLength is the maximum number of characters allowed in a tag.
+const MaxTagLength = 32
+
+// ErrInvalidTag indicates the provided tag is empty, too long or contains unsupported characters.
+var ErrInvalidTag = errors.New("invalid tag")
+
+// Tag represents a free-form label attached to a task.
+// Tags are always stored in their normalized form.
+type Tag string
+
+// NewTag normalizes and validates a raw tag.
+// Leading and trailing whitespace is trimmed, letters are lower-cased and
+// inner runs of whitespace are replaced by a single dash, so "  Home Office "
+// becomes "home-office". Only letters, digits, '-', '_' and ':' are allowed.
+func NewTag(raw string) (Tag, error) {
+	fields := strings.Fields(strings.ToLower(raw))
+	normalized := strings.Join(fields, "-")
+	if normalized == "" || utf8.RuneCountInString(normalized) > MaxTagLength {
+		return "", ErrInvalidTag
+	}
+	for _, r := range normalized {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' && r != ':' {
+			return "", ErrInvalidTag
+		}
+	}
+	return Tag(normalized), nil
+}
+
+// String returns the string representation of the tag.
+func (t Tag) String() string {
+	return string(t)
+}
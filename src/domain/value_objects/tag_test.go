@@ -0,0 +1,40 @@
+package value_objects
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewTag_Normalizes(t *testing.T) {
+	cases := map[string]Tag{
+		"home":            "home",
+		"  Home Office  ": "home-office",
+		"Prio:HIGH":       "prio:high",
+		"ÉTÉ":             "été",
+	}
+	for raw, want := range cases {
+		got, err := NewTag(raw)
+		if err != nil {
+			t.Fatalf("NewTag(%q) unexpected error: %v", raw, err)
+		}
+		if got != want {
+			t.Errorf("NewTag(%q) = %q, want %q", raw, got, want)
+		}
+	}
+}
+
+func TestNewTag_Invalid(t *testing.T) {
+	invalid := []string{"", "   ", "a,b", "with/slash", strings.Repeat("x", MaxTagLength+1)}
+	for _, raw := range invalid {
+		if _, err := NewTag(raw); !errors.Is(err, ErrInvalidTag) {
+			t.Errorf("NewTag(%q) expected ErrInvalidTag, got %v", raw, err)
+		}
+	}
+}
+
+func TestNewTag_LengthCountsRunes(t *testing.T) {
+	if _, err := NewTag(strings.Repeat("é", MaxTagLength)); err != nil {
+		t.Fatalf("expected %d multi-byte runes to be accepted, got %v", MaxTagLength, err)
+	}
+}
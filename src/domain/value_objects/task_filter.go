@@ -0,0 +1,466 @@
+package value_objects
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidFilter indicates a task filter expression is malformed or uses an unsupported field or value.
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// MaxFilterLength is the maximum number of characters in a filter expression.
+const MaxFilterLength = 1000
+
+// maxFilterDepth bounds the nesting of parentheses and NOT in a filter expression.
+const maxFilterDepth = 32
+
+// filterDateLayout is the layout of the dates compared by created and due conditions.
+const filterDateLayout = "2006-01-02"
+
+// FilterSyntaxError describes why a filter expression was rejected and where.
+// Pos is the 1-based character position of the offending input.
+type FilterSyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *FilterSyntaxError) Error() string {
+	return fmt.Sprintf("%v at position %d: %s", ErrInvalidFilter, e.Pos, e.Msg)
+}
+
+// Unwrap makes errors.Is(err, ErrInvalidFilter) hold for syntax errors.
+func (e *FilterSyntaxError) Unwrap() error {
+	return ErrInvalidFilter
+}
+
+// FilterField is a task attribute tested by a filter condition.
+type FilterField string
+
+const (
+	FilterStatus   FilterField = "status"
+	FilterPriority FilterField = "priority"
+	FilterTag      FilterField = "tag"
+	FilterProject  FilterField = "project"
+	FilterTitle    FilterField = "title"
+	FilterCreated  FilterField = "created"
+	FilterDue      FilterField = "due"
+)
+
+// filterFields lists the supported fields with the operators each accepts.
+var filterFields = map[FilterField][]FilterOp{
+	FilterStatus:   {OpMatch, OpEqual, OpNotEqual, OpLess, OpLessEqual, OpGreater, OpGreaterEqual},
+	FilterPriority: {OpMatch, OpEqual, OpNotEqual, OpLess, OpLessEqual, OpGreater, OpGreaterEqual},
+	FilterTag:      {OpMatch, OpEqual, OpNotEqual},
+	FilterProject:  {OpMatch, OpEqual, OpNotEqual},
+	FilterTitle:    {OpMatch, OpEqual, OpNotEqual},
+	FilterCreated:  {OpMatch, OpEqual, OpNotEqual, OpLess, OpLessEqual, OpGreater, OpGreaterEqual},
+	FilterDue:      {OpMatch, OpEqual, OpNotEqual, OpLess, OpLessEqual, OpGreater, OpGreaterEqual},
+}
+
+// FilterOp is the comparison of a filter condition. OpMatch tests equality,
+// except for titles where it tests whether the title contains the value.
+type FilterOp string
+
+const (
+	OpMatch        FilterOp = ":"
+	OpEqual        FilterOp = "="
+	OpNotEqual     FilterOp = "!="
+	OpLess         FilterOp = "<"
+	OpLessEqual    FilterOp = "<="
+	OpGreater      FilterOp = ">"
+	OpGreaterEqual FilterOp = ">="
+)
+
+// Holds reports whether the operator accepts a comparison result: cmp is negative, zero or
+// positive when the tested attribute is less than, equal to or greater than the value.
+func (op FilterOp) Holds(cmp int) bool {
+	switch op {
+	case OpMatch, OpEqual:
+		return cmp == 0
+	case OpNotEqual:
+		return cmp != 0
+	case OpLess:
+		return cmp < 0
+	case OpLessEqual:
+		return cmp <= 0
+	case OpGreater:
+		return cmp > 0
+	case OpGreaterEqual:
+		return cmp >= 0
+	}
+	return false
+}
+
+// FilterCondition compares one task attribute with a value.
+// Value is normalized: statuses, priorities and titles are lower-cased, tags follow NewTag, a project of
+// "none" is empty and dates are kept in Date at midnight UTC.
+type FilterCondition struct {
+	Field FilterField
+	Op    FilterOp
+	Value string
+	Date  time.Time
+}
+
+// TaskFilter is a parsed filter expression: a single Condition, or the conjunction (And),
+// disjunction (Or) or negation (Not) of other filters. The zero TaskFilter matches every task.
+type TaskFilter struct {
+	Condition *FilterCondition
+	And       []TaskFilter
+	Or        []TaskFilter
+	Not       *TaskFilter
+}
+
+// String returns the filter in the syntax accepted by ParseTaskFilter.
+func (f TaskFilter) String() string {
+	switch {
+	case f.Condition != nil:
+		value := f.Condition.Value
+		if f.Condition.Field == FilterProject && value == "" {
+			value = "none"
+		}
+		return string(f.Condition.Field) + string(f.Condition.Op) + quoteFilterValue(value)
+	case f.Not != nil:
+		return "NOT " + f.Not.group()
+	case len(f.And) > 0:
+		parts := make([]string, len(f.And))
+		for i, operand := range f.And {
+			parts[i] = operand.group()
+		}
+		return strings.Join(parts, " AND ")
+	case len(f.Or) > 0:
+		parts := make([]string, len(f.Or))
+		for i, operand := range f.Or {
+			parts[i] = operand.String()
+		}
+		return strings.Join(parts, " OR ")
+	}
+	return ""
+}
+
+// group returns the filter as an operand of AND or NOT, in parentheses unless it is a single condition or negation.
+func (f TaskFilter) group() string {
+	if f.Condition != nil || f.Not != nil {
+		return f.String()
+	}
+	return "(" + f.String() + ")"
+}
+
+func quoteFilterValue(value string) string {
+	if value != "" && strings.IndexFunc(value, isFilterSeparator) < 0 && !isFilterKeyword(value) {
+		return value
+	}
+	return strconv.Quote(value)
+}
+
+// ParseTaskFilter parses a filter expression such as
+//
+//	status:doing AND priority>=high AND (tag:home OR tag:garden) AND created>2026-01-01
+//
+// Conditions are written field, operator, value with no spaces in between; values containing
+// spaces or operator characters are written in double quotes. Conditions are combined with
+// AND, OR and NOT (case-insensitive) and parentheses; AND binds tighter than OR and is implied
+// between conditions written next to each other.
+func ParseTaskFilter(s string) (TaskFilter, error) {
+	if n := utf8.RuneCountInString(s); n > MaxFilterLength {
+		return TaskFilter{}, &FilterSyntaxError{Pos: MaxFilterLength + 1, Msg: fmt.Sprintf("filter is longer than %d characters", MaxFilterLength)}
+	}
+	tokens, err := lexFilter(s)
+	if err != nil {
+		return TaskFilter{}, err
+	}
+	p := &filterParser{tokens: tokens}
+	if p.peek().kind == filterEOF {
+		return TaskFilter{}, &FilterSyntaxError{Pos: 1, Msg: "filter is empty"}
+	}
+	filter, err := p.parseOr()
+	if err != nil {
+		return TaskFilter{}, err
+	}
+	if token := p.peek(); token.kind != filterEOF {
+		return TaskFilter{}, token.errorf("unexpected %s", token)
+	}
+	return filter, nil
+}
+
+type filterTokenKind int
+
+const (
+	filterEOF filterTokenKind = iota
+	filterWord
+	filterString
+	filterOperator
+	filterOpen
+	filterClose
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+	// pos is the 1-based character position of the token.
+	pos int
+}
+
+func (t filterToken) String() string {
+	if t.kind == filterEOF {
+		return "end of filter"
+	}
+	return strconv.Quote(t.text)
+}
+
+func (t filterToken) errorf(format string, args ...interface{}) error {
+	return &FilterSyntaxError{Pos: t.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (t filterToken) isKeyword(keyword string) bool {
+	return t.kind == filterWord && strings.EqualFold(t.text, keyword)
+}
+
+func isFilterSeparator(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune(`()":=!<>`, r)
+}
+
+func isFilterKeyword(word string) bool {
+	return strings.EqualFold(word, "AND") || strings.EqualFold(word, "OR") || strings.EqualFold(word, "NOT")
+}
+
+// lexFilter splits a filter expression into tokens.
+func lexFilter(s string) ([]filterToken, error) {
+	runes := []rune(s)
+	var tokens []filterToken
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		pos := i + 1
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{kind: filterOpen, text: "(", pos: pos})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{kind: filterClose, text: ")", pos: pos})
+			i++
+		case r == '"':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(runes) && runes[j] != '"'; j++ {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				b.WriteRune(runes[j])
+			}
+			if j == len(runes) {
+				return nil, &FilterSyntaxError{Pos: pos, Msg: "unterminated quoted value"}
+			}
+			tokens = append(tokens, filterToken{kind: filterString, text: b.String(), pos: pos})
+			i = j + 1
+		case r == ':' || r == '=':
+			tokens = append(tokens, filterToken{kind: filterOperator, text: string(r), pos: pos})
+			i++
+		case r == '!' || r == '<' || r == '>':
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				tokens = append(tokens, filterToken{kind: filterOperator, text: string(r) + "=", pos: pos})
+				i += 2
+				continue
+			}
+			if r == '!' {
+				return nil, &FilterSyntaxError{Pos: pos, Msg: `expected "=" after "!"`}
+			}
+			tokens = append(tokens, filterToken{kind: filterOperator, text: string(r), pos: pos})
+			i++
+		default:
+			j := i
+			for j < len(runes) && !isFilterSeparator(runes[j]) {
+				j++
+			}
+			tokens = append(tokens, filterToken{kind: filterWord, text: string(runes[i:j]), pos: pos})
+			i = j
+		}
+	}
+	return append(tokens, filterToken{kind: filterEOF, pos: len(runes) + 1}), nil
+}
+
+// filterParser is a recursive descent parser over the tokens of a filter expression.
+type filterParser struct {
+	tokens []filterToken
+	next   int
+	depth  int
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.next]
+}
+
+func (p *filterParser) advance() filterToken {
+	token := p.tokens[p.next]
+	if token.kind != filterEOF {
+		p.next++
+	}
+	return token
+}
+
+// parseOr parses operands joined by OR.
+func (p *filterParser) parseOr() (TaskFilter, error) {
+	operand, err := p.parseAnd()
+	if err != nil {
+		return TaskFilter{}, err
+	}
+	operands := []TaskFilter{operand}
+	for p.peek().isKeyword("OR") {
+		p.advance()
+		operand, err := p.parseAnd()
+		if err != nil {
+			return TaskFilter{}, err
+		}
+		operands = append(operands, operand)
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return TaskFilter{Or: operands}, nil
+}
+
+// parseAnd parses operands joined by AND or written next to each other.
+func (p *filterParser) parseAnd() (TaskFilter, error) {
+	operand, err := p.parseUnary()
+	if err != nil {
+		return TaskFilter{}, err
+	}
+	operands := []TaskFilter{operand}
+	for {
+		token := p.peek()
+		if token.isKeyword("AND") {
+			p.advance()
+		} else if !(token.kind == filterOpen || token.kind == filterWord && !token.isKeyword("OR")) {
+			break
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return TaskFilter{}, err
+		}
+		operands = append(operands, operand)
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return TaskFilter{And: operands}, nil
+}
+
+// parseUnary parses a negation, a parenthesized expression or a condition.
+func (p *filterParser) parseUnary() (TaskFilter, error) {
+	token := p.peek()
+	switch {
+	case token.isKeyword("NOT"), token.kind == filterOpen:
+		if p.depth == maxFilterDepth {
+			return TaskFilter{}, token.errorf("filter is nested more than %d levels deep", maxFilterDepth)
+		}
+		p.depth++
+		defer func() { p.depth-- }()
+		p.advance()
+		if token.kind == filterOpen {
+			inner, err := p.parseOr()
+			if err != nil {
+				return TaskFilter{}, err
+			}
+			if closing := p.advance(); closing.kind != filterClose {
+				return TaskFilter{}, closing.errorf("expected \")\" to close the \"(\" at position %d, found %s", token.pos, closing)
+			}
+			return inner, nil
+		}
+		inner, err := p.parseUnary()
+		if err != nil {
+			return TaskFilter{}, err
+		}
+		return TaskFilter{Not: &inner}, nil
+	case token.kind == filterWord && !isFilterKeyword(token.text):
+		return p.parseCondition()
+	}
+	return TaskFilter{}, token.errorf("expected a condition such as status:todo, found %s", token)
+}
+
+// parseCondition parses a field, an operator and a value, and validates the value for the field.
+func (p *filterParser) parseCondition() (TaskFilter, error) {
+	fieldToken := p.advance()
+	field := FilterField(strings.ToLower(fieldToken.text))
+	ops, ok := filterFields[field]
+	if !ok {
+		return TaskFilter{}, fieldToken.errorf("unknown field %s, expected one of status, priority, tag, project, title, created or due", fieldToken)
+	}
+	opToken := p.advance()
+	if opToken.kind != filterOperator {
+		return TaskFilter{}, opToken.errorf("expected an operator after %s, found %s", fieldToken, opToken)
+	}
+	op := FilterOp(opToken.text)
+	if !containsFilterOp(ops, op) {
+		return TaskFilter{}, opToken.errorf("operator %s is not supported for %s", opToken, field)
+	}
+	valueToken := p.advance()
+	if valueToken.kind != filterWord && valueToken.kind != filterString {
+		return TaskFilter{}, valueToken.errorf("expected a value after %q, found %s", fieldToken.text+opToken.text, valueToken)
+	}
+	condition := FilterCondition{Field: field, Op: op}
+	if err := condition.setValue(valueToken.text); err != nil {
+		return TaskFilter{}, valueToken.errorf("%v", err)
+	}
+	return TaskFilter{Condition: &condition}, nil
+}
+
+func containsFilterOp(ops []FilterOp, op FilterOp) bool {
+	for _, candidate := range ops {
+		if candidate == op {
+			return true
+		}
+	}
+	return false
+}
+
+// setValue validates and normalizes a raw condition value.
+func (c *FilterCondition) setValue(raw string) error {
+	switch c.Field {
+	case FilterStatus:
+		status := TaskStatus(strings.ToLower(raw))
+		if !status.IsValid() {
+			return fmt.Errorf("unknown status %q, expected todo, doing or done", raw)
+		}
+		c.Value = status.String()
+	case FilterPriority:
+		priority, err := ParsePriority(raw)
+		if err != nil {
+			return fmt.Errorf("unknown priority %q, expected low, medium, high or urgent", raw)
+		}
+		c.Value = priority.String()
+	case FilterTag:
+		tag, err := NewTag(raw)
+		if err != nil {
+			return fmt.Errorf("invalid tag %q", raw)
+		}
+		c.Value = tag.String()
+	case FilterProject:
+		if strings.EqualFold(raw, "none") {
+			c.Value = ""
+			return nil
+		}
+		id, err := ParseProjectId(raw)
+		if err != nil {
+			return fmt.Errorf("invalid project id %q, expected a project id or none", raw)
+		}
+		c.Value = string(id)
+	case FilterTitle:
+		if strings.TrimSpace(raw) == "" {
+			return errors.New("title value cannot be empty")
+		}
+		c.Value = strings.ToLower(raw)
+	case FilterCreated, FilterDue:
+		date, err := time.Parse(filterDateLayout, raw)
+		if err != nil {
+			return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", raw)
+		}
+		c.Value = raw
+		c.Date = date
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package value_objects
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseTaskFilter_Canonical(t *testing.T) {
+	cases := map[string]string{
+		"status:doing":                            "status:doing",
+		"Status:DOING and tag:Home":               "status:doing AND tag:home",
+		"status:todo tag:home":                    "status:todo AND tag:home",
+		"tag:a OR tag:b AND NOT tag:c":            "tag:a OR tag:b AND NOT tag:c",
+		"(tag:a OR tag:b) AND created>2026-01-01": "(tag:a OR tag:b) AND created>2026-01-01",
+		`title:"paint the" AND tag:"prio:high"`:   `title:"paint the" AND tag:"prio:high"`,
+		"NOT (status>=doing OR due<=2026-02-01)":  "NOT (status>=doing OR due<=2026-02-01)",
+		"project:NONE":                            "project:none",
+		"status:doing AND Priority>=HIGH":         "status:doing AND priority>=high",
+		`title:"say \"hi\""`:                      `title:"say \"hi\""`,
+	}
+	for input, want := range cases {
+		filter, err := ParseTaskFilter(input)
+		if err != nil {
+			t.Errorf("ParseTaskFilter(%q) unexpected error: %v", input, err)
+			continue
+		}
+		if got := filter.String(); got != want {
+			t.Errorf("ParseTaskFilter(%q) = %q, want %q", input, got, want)
+		}
+		if again, err := ParseTaskFilter(filter.String()); err != nil || again.String() != want {
+			t.Errorf("expected %q to parse back to itself, got %q (err=%v)", want, again.String(), err)
+		}
+	}
+}
+
+func TestParseTaskFilter_StructureAndValues(t *testing.T) {
+	filter, err := ParseTaskFilter("status:done OR tag:x created=2026-03-04")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(filter.Or) != 2 || filter.Or[0].Condition == nil || len(filter.Or[1].And) != 2 {
+		t.Fatalf("expected AND to bind tighter than OR, got %+v", filter)
+	}
+	created := filter.Or[1].And[1].Condition
+	if created.Field != FilterCreated || created.Op != OpEqual || created.Date.Format("2006-01-02") != "2026-03-04" {
+		t.Errorf("unexpected date condition %+v", created)
+	}
+}
+
+func TestParseTaskFilter_ErrorsWithPositions(t *testing.T) {
+	cases := []struct {
+		input string
+		pos   int
+		msg   string
+	}{
+		{"", 1, "filter is empty"},
+		{"status:doing AND owner:me", 18, `unknown field "owner"`},
+		{"priority>=soon", 11, `unknown priority "soon"`},
+		{"status", 7, `expected an operator after "status", found end of filter`},
+		{"status:", 8, `expected a value after "status:"`},
+		{"status:later", 8, `unknown status "later"`},
+		{"tag>home", 4, `operator ">" is not supported for tag`},
+		{"created>2026-13-01", 9, "invalid date"},
+		{"(tag:a OR tag:b", 16, `expected ")" to close the "(" at position 1`},
+		{"tag:a)", 6, `unexpected ")"`},
+		{"tag:a OR", 9, "expected a condition"},
+		{`title:"open`, 7, "unterminated quoted value"},
+		{"tag!home", 4, `expected "=" after "!"`},
+		{"project:42", 9, "invalid project id"},
+		{"é:x", 1, `unknown field "é"`},
+		{"tag:x AND tag:ü AND nope:1", 21, `unknown field "nope"`},
+	}
+	for _, c := range cases {
+		_, err := ParseTaskFilter(c.input)
+		var syntaxErr *FilterSyntaxError
+		if !errors.As(err, &syntaxErr) || !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("ParseTaskFilter(%q): expected a FilterSyntaxError, got %v", c.input, err)
+			continue
+		}
+		if syntaxErr.Pos != c.pos || !strings.Contains(syntaxErr.Msg, c.msg) {
+			t.Errorf("ParseTaskFilter(%q) = %d: %s, want %d: %s", c.input, syntaxErr.Pos, syntaxErr.Msg, c.pos, c.msg)
+		}
+	}
+}
+
+func TestParseTaskFilter_Limits(t *testing.T) {
+	if _, err := ParseTaskFilter(strings.Repeat("a", MaxFilterLength+1)); !errors.Is(err, ErrInvalidFilter) {
+		t.Errorf("expected overlong filter to be rejected, got %v", err)
+	}
+	deep := strings.Repeat("(", maxFilterDepth+1) + "tag:a" + strings.Repeat(")", maxFilterDepth+1)
+	if _, err := ParseTaskFilter(deep); !errors.Is(err, ErrInvalidFilter) {
+		t.Errorf("expected deeply nested filter to be rejected, got %v", err)
+	}
+	nested := strings.Repeat("(", maxFilterDepth) + "tag:a" + strings.Repeat(")", maxFilterDepth)
+	if _, err := ParseTaskFilter(nested); err != nil {
+		t.Errorf("expected %d levels to be accepted, got %v", maxFilterDepth, err)
+	}
+}
+
+func TestFilterOp_Holds(t *testing.T) {
+	if !OpLessEqual.Holds(0) || OpLess.Holds(0) || !OpNotEqual.Holds(-1) || !OpMatch.Holds(0) || OpGreater.Holds(-1) {
+		t.Errorf("unexpected comparison results")
+	}
+}
@@ -23,3 +23,8 @@ func (s TaskStatus) IsValid() bool {
 		return false
 	}
 }
+
+// AllStatuses returns every status in workflow order.
+func AllStatuses() []TaskStatus {
+	return []TaskStatus{StatusTodo, StatusDoing, StatusDone}
+}
@@ -0,0 +1,27 @@
+package value_objects
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// WorkEntryId represents a unique identifier for a logged work entry.
+// It uses UUID (RFC 4122) string format.
+type WorkEntryId string
+
+// NewWorkEntryId generates a new UUID-based WorkEntryId.
+func NewWorkEntryId() WorkEntryId {
+	return WorkEntryId(uuid.NewString())
+}
+
+// ErrInvalidWorkEntryId indicates the provided work entry id is invalid or malformed.
+var ErrInvalidWorkEntryId = errors.New("invalid work entry id")
+
+// ParseWorkEntryId validates and parses a string into a WorkEntryId.
+func ParseWorkEntryId(s string) (WorkEntryId, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", ErrInvalidWorkEntryId
+	}
+	return WorkEntryId(s), nil
+}
@@ -2,4 +2,7 @@ module clean-architecture-golang
 
 go 1.21
 
-require github.com/google/uuid v1.3.0
+require (
+	github.com/google/uuid v1.3.0
+	golang.org/x/text v0.21.0
+)
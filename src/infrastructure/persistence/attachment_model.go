@@ -0,0 +1,53 @@
+package persistence
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"time"
+)
+
+// AttachmentModel represents the database schema for the attachments stored with a task.
+type AttachmentModel struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Digest      string    `json:"digest"`
+	UploadedAt  time.Time `json:"uploaded_at"`
+}
+
+func attachmentsToDomain(models []AttachmentModel) []entities.Attachment {
+	if len(models) == 0 {
+		return nil
+	}
+	result := make([]entities.Attachment, len(models))
+	for i, m := range models {
+		result[i] = entities.Attachment{
+			ID:          value_objects.AttachmentId(m.ID),
+			Name:        m.Name,
+			ContentType: m.ContentType,
+			Size:        m.Size,
+			Digest:      m.Digest,
+			UploadedAt:  m.UploadedAt,
+		}
+	}
+	return result
+}
+
+func attachmentsFromDomain(attachments []entities.Attachment) []AttachmentModel {
+	if len(attachments) == 0 {
+		return nil
+	}
+	result := make([]AttachmentModel, len(attachments))
+	for i, a := range attachments {
+		result[i] = AttachmentModel{
+			ID:          string(a.ID),
+			Name:        a.Name,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			Digest:      a.Digest,
+			UploadedAt:  a.UploadedAt,
+		}
+	}
+	return result
+}
@@ -0,0 +1,43 @@
+package persistence
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+// ChecklistItemModel represents the database schema for the checklist items stored with a task.
+type ChecklistItemModel struct {
+	ID      string `json:"id"`
+	Text    string `json:"text"`
+	Checked bool   `json:"checked"`
+}
+
+func checklistToDomain(models []ChecklistItemModel) []entities.ChecklistItem {
+	if len(models) == 0 {
+		return nil
+	}
+	result := make([]entities.ChecklistItem, len(models))
+	for i, m := range models {
+		result[i] = entities.ChecklistItem{
+			ID:      value_objects.ChecklistItemId(m.ID),
+			Text:    m.Text,
+			Checked: m.Checked,
+		}
+	}
+	return result
+}
+
+func checklistFromDomain(items []entities.ChecklistItem) []ChecklistItemModel {
+	if len(items) == 0 {
+		return nil
+	}
+	result := make([]ChecklistItemModel, len(items))
+	for i, item := range items {
+		result[i] = ChecklistItemModel{
+			ID:      string(item.ID),
+			Text:    item.Text,
+			Checked: item.Checked,
+		}
+	}
+	return result
+}
@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"time"
+)
+
+// CommentModel represents the database schema for comments.
+type CommentModel struct {
+	ID        string     `json:"id"`
+	TaskID    string     `json:"task_id"`
+	Author    string     `json:"author"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// ToDomain converts a CommentModel to a domain Comment entity.
+func (m *CommentModel) ToDomain() *entities.Comment {
+	return &entities.Comment{
+		ID:        value_objects.CommentId(m.ID),
+		TaskID:    value_objects.TaskId(m.TaskID),
+		Author:    m.Author,
+		Body:      m.Body,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: timeIn(m.UpdatedAt, time.UTC),
+	}
+}
+
+// CommentFromDomain converts a domain Comment entity to a CommentModel.
+func CommentFromDomain(comment *entities.Comment) *CommentModel {
+	return &CommentModel{
+		ID:        string(comment.ID),
+		TaskID:    string(comment.TaskID),
+		Author:    comment.Author,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: timeIn(comment.UpdatedAt, time.UTC),
+	}
+}
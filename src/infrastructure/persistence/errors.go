@@ -0,0 +1,15 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrCorruptModel indicates a stored model holding a value that no longer parses. The parse error is
+// kept in the message only, so it is not mistaken for invalid input of the current request.
+var ErrCorruptModel = errors.New("corrupt stored model")
+
+// corruptModel returns ErrCorruptModel for the field described by format and args, and its parse error.
+func corruptModel(format string, err error, args ...interface{}) error {
+	return fmt.Errorf("%w: %s: %v", ErrCorruptModel, fmt.Sprintf(format, args...), err)
+}
@@ -0,0 +1,43 @@
+package persistence
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"time"
+)
+
+// ProjectModel represents the database schema for projects.
+type ProjectModel struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	DefaultTags []string  `json:"default_tags,omitempty"`
+	StartTimers bool      `json:"start_timers,omitempty"`
+}
+
+// ToDomain converts a ProjectModel to a domain Project entity.
+func (m *ProjectModel) ToDomain() *entities.Project {
+	return &entities.Project{
+		ID:          value_objects.ProjectId(m.ID),
+		Name:        m.Name,
+		Description: m.Description,
+		CreatedAt:   m.CreatedAt,
+		Settings: entities.ProjectSettings{
+			DefaultTags: tagsToDomain(m.DefaultTags),
+			StartTimers: m.StartTimers,
+		},
+	}
+}
+
+// ProjectFromDomain converts a domain Project entity to a ProjectModel.
+func ProjectFromDomain(project *entities.Project) *ProjectModel {
+	return &ProjectModel{
+		ID:          string(project.ID),
+		Name:        project.Name,
+		Description: project.Description,
+		CreatedAt:   project.CreatedAt,
+		DefaultTags: tagsFromDomain(project.Settings.DefaultTags),
+		StartTimers: project.Settings.StartTimers,
+	}
+}
@@ -0,0 +1,51 @@
+package persistence
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"time"
+)
+
+// SavedViewModel represents the database schema for saved views.
+// The filter is stored in its canonical expression syntax.
+type SavedViewModel struct {
+	Owner     string    `json:"owner"`
+	Name      string    `json:"name"`
+	Filter    string    `json:"filter"`
+	Sort      string    `json:"sort"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToDomain converts a SavedViewModel to a domain SavedView entity.
+// Returns ErrCorruptModel if the stored filter or sort cannot be parsed.
+func (m *SavedViewModel) ToDomain() (*entities.SavedView, error) {
+	view := &entities.SavedView{
+		Owner:     m.Owner,
+		Name:      m.Name,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	filter, err := value_objects.ParseTaskFilter(m.Filter)
+	if err != nil {
+		return nil, corruptModel("view %s/%s filter %q", err, m.Owner, m.Name, m.Filter)
+	}
+	sort, err := entities.ParseViewSort(m.Sort)
+	if err != nil {
+		return nil, corruptModel("view %s/%s sort %q", err, m.Owner, m.Name, m.Sort)
+	}
+	view.Filter, view.Sort = filter, sort
+	return view, nil
+}
+
+// SavedViewFromDomain converts a domain SavedView entity to a SavedViewModel.
+func SavedViewFromDomain(view *entities.SavedView) *SavedViewModel {
+	return &SavedViewModel{
+		Owner:     view.Owner,
+		Name:      view.Name,
+		Filter:    view.Filter.String(),
+		Sort:      view.Sort.String(),
+		CreatedAt: view.CreatedAt,
+		UpdatedAt: view.UpdatedAt,
+	}
+}
@@ -0,0 +1,24 @@
+package persistence
+
+import (
+	"errors"
+	"testing"
+
+	"clean-architecture-golang/domain/entities"
+)
+
+func TestSavedViewModel_ReportsCorruptFilter(t *testing.T) {
+	view, err := entities.NewSavedView("alice", "open", "status!=done", "-due")
+	if err != nil {
+		t.Fatalf("new view failed: %v", err)
+	}
+	model := SavedViewFromDomain(view)
+	if back, err := model.ToDomain(); err != nil || back.Filter.String() != view.Filter.String() || back.Sort != view.Sort {
+		t.Fatalf("expected the view back, got %+v %v", back, err)
+	}
+
+	model.Filter = "status!="
+	if _, err := model.ToDomain(); !errors.Is(err, ErrCorruptModel) || errors.Is(err, entities.ErrInvalidInput) {
+		t.Errorf("expected ErrCorruptModel only, got %v", err)
+	}
+}
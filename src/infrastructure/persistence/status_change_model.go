@@ -0,0 +1,47 @@
+package persistence
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"time"
+)
+
+// StatusChangeModel represents the database schema for an entry of a task's status history.
+type StatusChangeModel struct {
+	From  string    `json:"from"`
+	To    string    `json:"to"`
+	At    time.Time `json:"at"`
+	Actor string    `json:"actor,omitempty"`
+}
+
+func historyToDomain(models []StatusChangeModel) []entities.StatusChange {
+	if len(models) == 0 {
+		return nil
+	}
+	result := make([]entities.StatusChange, len(models))
+	for i, m := range models {
+		result[i] = entities.StatusChange{
+			From:  value_objects.TaskStatus(m.From),
+			To:    value_objects.TaskStatus(m.To),
+			At:    m.At,
+			Actor: m.Actor,
+		}
+	}
+	return result
+}
+
+func historyFromDomain(history []entities.StatusChange) []StatusChangeModel {
+	if len(history) == 0 {
+		return nil
+	}
+	result := make([]StatusChangeModel, len(history))
+	for i, change := range history {
+		result[i] = StatusChangeModel{
+			From:  change.From.String(),
+			To:    change.To.String(),
+			At:    change.At,
+			Actor: change.Actor,
+		}
+	}
+	return result
+}
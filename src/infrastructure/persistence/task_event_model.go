@@ -0,0 +1,22 @@
+package persistence
+
+import "time"
+
+// TaskEventModel represents the database schema for an event of a task's event stream.
+// Version numbers the events of a stream from 1. Data holds the fields the event sets and leaves
+// the others zero; it is nil for events that carry no fields.
+type TaskEventModel struct {
+	TaskID  string     `json:"task_id"`
+	Version int        `json:"version"`
+	Type    string     `json:"type"`
+	At      time.Time  `json:"at"`
+	Data    *TaskModel `json:"data,omitempty"`
+}
+
+// TaskSnapshotModel represents the database schema for the state of a task after the event of its
+// stream numbered Version. Task is nil if the task was deleted at that point.
+type TaskSnapshotModel struct {
+	TaskID  string     `json:"task_id"`
+	Version int        `json:"version"`
+	Task    *TaskModel `json:"task,omitempty"`
+}
@@ -0,0 +1,130 @@
+package persistence
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"fmt"
+	"strings"
+)
+
+// TaskFilterSQL translates a filter into a WHERE clause for a SQL backend, with ? placeholders and
+// their arguments in order. It matches the same tasks as entities.Task.Matches over these tables:
+//
+//	tasks(id, title, status, priority, project_id, created_at, due_day)
+//	task_tags(task_id, tag)
+//
+// The columns follow TaskModel, with an empty project_id for tasks outside any project. created_at
+// is stored in UTC and due_day holds the calendar day of the due date in its own timezone as
+// YYYY-MM-DD, or NULL for tasks without a due date.
+func TaskFilterSQL(filter value_objects.TaskFilter) (string, []interface{}) {
+	var args []interface{}
+	where := filterSQL(filter, &args)
+	return where, args
+}
+
+func filterSQL(filter value_objects.TaskFilter, args *[]interface{}) string {
+	switch {
+	case filter.Condition != nil:
+		return conditionSQL(*filter.Condition, args)
+	case filter.Not != nil:
+		return "NOT (" + filterSQL(*filter.Not, args) + ")"
+	case len(filter.Or) > 0:
+		return joinFilterSQL(filter.Or, " OR ", args)
+	case len(filter.And) > 0:
+		return joinFilterSQL(filter.And, " AND ", args)
+	}
+	return "1 = 1"
+}
+
+func joinFilterSQL(operands []value_objects.TaskFilter, separator string, args *[]interface{}) string {
+	parts := make([]string, len(operands))
+	for i, operand := range operands {
+		parts[i] = "(" + filterSQL(operand, args) + ")"
+	}
+	return strings.Join(parts, separator)
+}
+
+func conditionSQL(c value_objects.FilterCondition, args *[]interface{}) string {
+	switch c.Field {
+	case value_objects.FilterStatus:
+		*args = append(*args, orderOf(value_objects.TaskStatus(c.Value), value_objects.AllStatuses()))
+		return fmt.Sprintf("%s %s ?", orderSQL("status", value_objects.AllStatuses()), sqlOp(c.Op))
+	case value_objects.FilterPriority:
+		*args = append(*args, value_objects.Priority(c.Value).Level())
+		return fmt.Sprintf("%s %s ?", orderSQL("priority", value_objects.AllPriorities()), sqlOp(c.Op))
+	case value_objects.FilterTag:
+		*args = append(*args, c.Value)
+		exists := "EXISTS (SELECT 1 FROM task_tags WHERE task_tags.task_id = tasks.id AND task_tags.tag = ?)"
+		if c.Op == value_objects.OpNotEqual {
+			return "NOT " + exists
+		}
+		return exists
+	case value_objects.FilterProject:
+		*args = append(*args, c.Value)
+		return "project_id " + sqlOp(c.Op) + " ?"
+	case value_objects.FilterTitle:
+		if c.Op == value_objects.OpMatch {
+			*args = append(*args, "%"+escapeLike(c.Value)+"%")
+			return `LOWER(title) LIKE ? ESCAPE '\'`
+		}
+		*args = append(*args, c.Value)
+		return "LOWER(title) " + sqlOp(c.Op) + " ?"
+	case value_objects.FilterCreated:
+		// Compare with the bounds of the day in UTC, so an index on created_at can be used.
+		start, end := c.Date, c.Date.AddDate(0, 0, 1)
+		switch c.Op {
+		case value_objects.OpMatch, value_objects.OpEqual:
+			*args = append(*args, start, end)
+			return "created_at >= ? AND created_at < ?"
+		case value_objects.OpNotEqual:
+			*args = append(*args, start, end)
+			return "(created_at < ? OR created_at >= ?)"
+		case value_objects.OpLess, value_objects.OpGreaterEqual:
+			*args = append(*args, start)
+		default:
+			*args = append(*args, end)
+		}
+		op := map[value_objects.FilterOp]string{
+			value_objects.OpLess: "<", value_objects.OpGreaterEqual: ">=",
+			value_objects.OpLessEqual: "<", value_objects.OpGreater: ">=",
+		}[c.Op]
+		return "created_at " + op + " ?"
+	case value_objects.FilterDue:
+		*args = append(*args, c.Date.Format("2006-01-02"))
+		return "due_day IS NOT NULL AND due_day " + sqlOp(c.Op) + " ?"
+	}
+	return "1 = 0"
+}
+
+// orderSQL returns an expression numbering the values of column in the given order.
+func orderSQL[T ~string](column string, order []T) string {
+	var b strings.Builder
+	b.WriteString("CASE " + column)
+	for i, value := range order {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %d", value, i)
+	}
+	b.WriteString(" END")
+	return b.String()
+}
+
+func orderOf[T comparable](value T, order []T) int {
+	for i, candidate := range order {
+		if candidate == value {
+			return i
+		}
+	}
+	return -1
+}
+
+func sqlOp(op value_objects.FilterOp) string {
+	switch op {
+	case value_objects.OpMatch, value_objects.OpEqual:
+		return "="
+	case value_objects.OpNotEqual:
+		return "<>"
+	}
+	return string(op)
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -0,0 +1,47 @@
+package persistence
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"clean-architecture-golang/domain/value_objects"
+)
+
+func TestTaskFilterSQL(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	next := day.AddDate(0, 0, 1)
+	statusOrder := "CASE status WHEN 'todo' THEN 0 WHEN 'doing' THEN 1 WHEN 'done' THEN 2 END"
+	priorityOrder := "CASE priority WHEN 'low' THEN 0 WHEN 'medium' THEN 1 WHEN 'high' THEN 2 WHEN 'urgent' THEN 3 END"
+	tagExists := "EXISTS (SELECT 1 FROM task_tags WHERE task_tags.task_id = tasks.id AND task_tags.tag = ?)"
+
+	cases := []struct {
+		query string
+		where string
+		args  []interface{}
+	}{
+		{"status!=done AND priority>=high", "(" + statusOrder + " <> ?) AND (" + priorityOrder + " >= ?)", []interface{}{2, 2}},
+		{"tag:work OR NOT tag:home", "(" + tagExists + ") OR (NOT (" + tagExists + "))", []interface{}{"work", "home"}},
+		{"tag!=work", "NOT " + tagExists, []interface{}{"work"}},
+		{"project:none", "project_id = ?", []interface{}{""}},
+		{`title:"50%_off"`, `LOWER(title) LIKE ? ESCAPE '\'`, []interface{}{`%50\%\_off%`}},
+		{"title=Mow", "LOWER(title) = ?", []interface{}{"mow"}},
+		{"created:2024-03-01", "created_at >= ? AND created_at < ?", []interface{}{day, next}},
+		{"created!=2024-03-01", "(created_at < ? OR created_at >= ?)", []interface{}{day, next}},
+		{"created<2024-03-01", "created_at < ?", []interface{}{day}},
+		{"created<=2024-03-01", "created_at < ?", []interface{}{next}},
+		{"created>2024-03-01", "created_at >= ?", []interface{}{next}},
+		{"created>=2024-03-01", "created_at >= ?", []interface{}{day}},
+		{"due<=2024-03-01", "due_day IS NOT NULL AND due_day <= ?", []interface{}{"2024-03-01"}},
+	}
+	for _, c := range cases {
+		filter, err := value_objects.ParseTaskFilter(c.query)
+		if err != nil {
+			t.Fatalf("%q: parse failed: %v", c.query, err)
+		}
+		where, args := TaskFilterSQL(filter)
+		if where != c.where || !reflect.DeepEqual(args, c.args) {
+			t.Errorf("%q: expected %s %v, got %s %v", c.query, c.where, c.args, where, args)
+		}
+	}
+}
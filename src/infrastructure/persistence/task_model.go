@@ -15,27 +15,156 @@ type TaskModel struct {
 	Title       string    `json:"title"`
 	Description string    `json:"description"`
 	Status      string    `json:"status"`
+	Priority    string    `json:"priority,omitempty"`
 	CreatedAt   time.Time `json:"created_at"`
+	Tags        []string  `json:"tags,omitempty"`
+	ProjectID   string    `json:"project_id,omitempty"`
+	ParentID    string    `json:"parent_id,omitempty"`
+	BlockedBy   []string  `json:"blocked_by,omitempty"`
+	// DueTimezone keeps the IANA location of DueDate, which JSON timestamps do not preserve.
+	DueDate         *time.Time           `json:"due_date,omitempty"`
+	DueTimezone     string               `json:"due_timezone,omitempty"`
+	Recurrence      string               `json:"recurrence,omitempty"`
+	RecurrenceStart *time.Time           `json:"recurrence_start,omitempty"`
+	Attachments     []AttachmentModel    `json:"attachments,omitempty"`
+	Checklist       []ChecklistItemModel `json:"checklist,omitempty"`
+	History         []StatusChangeModel  `json:"history,omitempty"`
+	Rank            string               `json:"rank,omitempty"`
+	DeletedAt       *time.Time           `json:"deleted_at,omitempty"`
+	ArchivedAt      *time.Time           `json:"archived_at,omitempty"`
 }
 
 // ToDomain converts a TaskModel to a domain Task entity.
-func (m *TaskModel) ToDomain() *entities.Task {
-	return &entities.Task{
+// Models saved before priorities existed get value_objects.DefaultPriority.
+// Returns ErrCorruptModel if the priority, the due timezone or the recurrence cannot be parsed.
+func (m *TaskModel) ToDomain() (*entities.Task, error) {
+	task := &entities.Task{
 		ID:          value_objects.TaskId(m.ID),
 		Title:       m.Title,
 		Description: m.Description,
 		Status:      value_objects.TaskStatus(m.Status),
 		CreatedAt:   m.CreatedAt,
+		Tags:        tagsToDomain(m.Tags),
+		ProjectID:   value_objects.ProjectId(m.ProjectID),
+		ParentID:    value_objects.TaskId(m.ParentID),
+		BlockedBy:   idsToDomain(m.BlockedBy),
+		Attachments: attachmentsToDomain(m.Attachments),
+		Checklist:   checklistToDomain(m.Checklist),
+		History:     historyToDomain(m.History),
+		Rank:        value_objects.Rank(m.Rank),
+		DeletedAt:   timeIn(m.DeletedAt, time.UTC),
+		ArchivedAt:  timeIn(m.ArchivedAt, time.UTC),
 	}
+	task.Priority = value_objects.DefaultPriority
+	if m.Priority != "" {
+		priority, err := value_objects.ParsePriority(m.Priority)
+		if err != nil {
+			return nil, corruptModel("task %s priority %q", err, m.ID, m.Priority)
+		}
+		task.Priority = priority
+	}
+	loc := time.UTC
+	if m.DueTimezone != "" {
+		loaded, err := time.LoadLocation(m.DueTimezone)
+		if err != nil {
+			return nil, corruptModel("task %s due timezone %q", err, m.ID, m.DueTimezone)
+		}
+		loc = loaded
+	}
+	task.DueDate = timeIn(m.DueDate, loc)
+	task.RecurrenceStart = timeIn(m.RecurrenceStart, loc)
+	if m.Recurrence != "" {
+		rule, err := value_objects.ParseRecurrenceRule(m.Recurrence)
+		if err != nil {
+			return nil, corruptModel("task %s recurrence %q", err, m.ID, m.Recurrence)
+		}
+		task.Recurrence = &rule
+	}
+	return task, nil
 }
 
 // FromDomain converts a domain Task entity to a TaskModel.
 func FromDomain(task *entities.Task) *TaskModel {
-	return &TaskModel{
+	model := &TaskModel{
 		ID:          string(task.ID),
 		Title:       task.Title,
 		Description: task.Description,
 		Status:      task.Status.String(),
+		Priority:    task.Priority.String(),
 		CreatedAt:   task.CreatedAt,
+		Tags:        tagsFromDomain(task.Tags),
+		ProjectID:   string(task.ProjectID),
+		ParentID:    string(task.ParentID),
+		BlockedBy:   idsFromDomain(task.BlockedBy),
+		Attachments: attachmentsFromDomain(task.Attachments),
+		Checklist:   checklistFromDomain(task.Checklist),
+		History:     historyFromDomain(task.History),
+		Rank:        task.Rank.String(),
+		DeletedAt:   timeIn(task.DeletedAt, time.UTC),
+		ArchivedAt:  timeIn(task.ArchivedAt, time.UTC),
+	}
+	if task.DueDate != nil {
+		model.DueDate = timeIn(task.DueDate, task.DueDate.Location())
+		model.DueTimezone = task.DueDate.Location().String()
+	}
+	if task.RecurrenceStart != nil {
+		model.RecurrenceStart = timeIn(task.RecurrenceStart, task.RecurrenceStart.Location())
+	}
+	if task.Recurrence != nil {
+		model.Recurrence = task.Recurrence.String()
+	}
+	return model
+}
+
+// timeIn returns a copy of t expressed in loc, or nil if t is nil.
+func timeIn(t *time.Time, loc *time.Location) *time.Time {
+	if t == nil {
+		return nil
+	}
+	converted := t.In(loc)
+	return &converted
+}
+
+func tagsToDomain(tags []string) []value_objects.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	result := make([]value_objects.Tag, len(tags))
+	for i, tag := range tags {
+		result[i] = value_objects.Tag(tag)
+	}
+	return result
+}
+
+func tagsFromDomain(tags []value_objects.Tag) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	result := make([]string, len(tags))
+	for i, tag := range tags {
+		result[i] = tag.String()
+	}
+	return result
+}
+
+func idsToDomain(ids []string) []value_objects.TaskId {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]value_objects.TaskId, len(ids))
+	for i, id := range ids {
+		result[i] = value_objects.TaskId(id)
+	}
+	return result
+}
+
+func idsFromDomain(ids []value_objects.TaskId) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = string(id)
 	}
+	return result
 }
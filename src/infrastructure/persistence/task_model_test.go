@@ -1,10 +1,13 @@
 package persistence
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	_ "time/tzdata"
 )
 
 func TestModelRoundTrip(t *testing.T) {
@@ -21,7 +24,10 @@ func TestModelRoundTrip(t *testing.T) {
 		t.Fatalf("model mismatch after FromDomain")
 	}
 
-	d := m.ToDomain()
+	d, err := m.ToDomain()
+	if err != nil {
+		t.Fatalf("ToDomain failed: %v", err)
+	}
 	if d.ID != orig.ID || d.Title != orig.Title || d.Status != orig.Status {
 		t.Fatalf("domain mismatch after ToDomain")
 	}
@@ -29,3 +35,80 @@ func TestModelRoundTrip(t *testing.T) {
 		t.Fatalf("CreatedAt mismatch")
 	}
 }
+
+func TestModelRoundTrip_Schedule(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("load location failed: %v", err)
+	}
+	orig, _ := entities.NewTask("t", "d")
+	due := time.Date(2026, 10, 19, 9, 0, 0, 0, loc)
+	orig.SetDueDate(&due)
+	rule, _ := value_objects.ParseRecurrenceRule("FREQ=WEEKLY;BYDAY=MO,TH")
+	orig.SetRecurrence(&rule)
+
+	// Simulate a storage round trip that drops the location of the timestamps.
+	m := FromDomain(orig)
+	utc := m.DueDate.UTC()
+	m.DueDate = &utc
+
+	d, err := m.ToDomain()
+	if err != nil {
+		t.Fatalf("ToDomain failed: %v", err)
+	}
+	if d.DueDate.Location().String() != "America/New_York" || !d.DueDate.Equal(due) {
+		t.Fatalf("DueDate mismatch: %v", d.DueDate)
+	}
+	if d.Recurrence == nil || d.Recurrence.String() != rule.String() || !d.RecurrenceStart.Equal(due) {
+		t.Fatalf("Recurrence mismatch: %v from %v", d.Recurrence, d.RecurrenceStart)
+	}
+}
+
+func TestModel_ReportsCorruptSchedule(t *testing.T) {
+	orig, _ := entities.NewTask("t", "d")
+	due := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	orig.SetDueDate(&due)
+
+	for name, corrupt := range map[string]func(m *TaskModel){
+		"timezone":   func(m *TaskModel) { m.DueTimezone = "Mars/Olympus" },
+		"recurrence": func(m *TaskModel) { m.Recurrence = "FREQ=SOMETIMES" },
+	} {
+		m := FromDomain(orig)
+		corrupt(m)
+		if _, err := m.ToDomain(); !errors.Is(err, ErrCorruptModel) {
+			t.Errorf("%s: expected ErrCorruptModel, got %v", name, err)
+		}
+	}
+}
+
+func TestModelRoundTrip_AttachmentsAndHistory(t *testing.T) {
+	orig, _ := entities.NewTask("t", "d")
+	orig.AddAttachment("a.txt", "text/plain", 3, "abc")
+	orig.UpdateStatusBy("alice", value_objects.StatusDoing)
+
+	d, err := FromDomain(orig).ToDomain()
+	if err != nil {
+		t.Fatalf("ToDomain failed: %v", err)
+	}
+	if len(d.Attachments) != 1 || d.Attachments[0] != orig.Attachments[0] {
+		t.Fatalf("attachments mismatch: %+v", d.Attachments)
+	}
+	if len(d.History) != 1 || d.History[0] != orig.History[0] {
+		t.Fatalf("history mismatch: %+v", d.History)
+	}
+}
+
+func TestModelRoundTrip_Checklist(t *testing.T) {
+	orig, _ := entities.NewTask("t", "d")
+	first, _ := orig.AddChecklistItem("first")
+	orig.AddChecklistItem("second")
+	orig.CheckChecklistItem(first.ID, true)
+
+	d, err := FromDomain(orig).ToDomain()
+	if err != nil {
+		t.Fatalf("ToDomain failed: %v", err)
+	}
+	if len(d.Checklist) != 2 || d.Checklist[0] != orig.Checklist[0] || d.Checklist[1] != orig.Checklist[1] {
+		t.Fatalf("checklist mismatch: %+v", d.Checklist)
+	}
+}
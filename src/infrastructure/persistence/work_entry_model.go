@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"time"
+)
+
+// WorkEntryModel represents the database schema for logged work.
+type WorkEntryModel struct {
+	ID     string     `json:"id"`
+	TaskID string     `json:"task_id"`
+	User   string     `json:"user"`
+	Start  time.Time  `json:"start"`
+	End    *time.Time `json:"end,omitempty"`
+	Note   string     `json:"note,omitempty"`
+}
+
+// ToDomain converts a WorkEntryModel to a domain WorkEntry entity.
+func (m *WorkEntryModel) ToDomain() *entities.WorkEntry {
+	return &entities.WorkEntry{
+		ID:     value_objects.WorkEntryId(m.ID),
+		TaskID: value_objects.TaskId(m.TaskID),
+		User:   m.User,
+		Start:  m.Start,
+		End:    timeIn(m.End, time.UTC),
+		Note:   m.Note,
+	}
+}
+
+// WorkEntryFromDomain converts a domain WorkEntry entity to a WorkEntryModel.
+func WorkEntryFromDomain(entry *entities.WorkEntry) *WorkEntryModel {
+	return &WorkEntryModel{
+		ID:     string(entry.ID),
+		TaskID: string(entry.TaskID),
+		User:   entry.User,
+		Start:  entry.Start,
+		End:    timeIn(entry.End, time.UTC),
+		Note:   entry.Note,
+	}
+}
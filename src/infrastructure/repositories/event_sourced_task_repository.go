@@ -0,0 +1,300 @@
+package repositories
+
+import (
+	"bytes"
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/persistence"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultSnapshotInterval is how many events of a task are appended between two snapshots by default.
+const DefaultSnapshotInterval = 50
+
+// Task event types. EventStatusChanged sets the status and appends the new entries of the status
+// history; EventHistoryReplaced sets the status and the whole history when entries were rewritten.
+// The other field events set the fields of their group.
+const (
+	EventTaskCreated        = "task_created"
+	EventTaskDeleted        = "task_deleted"
+	EventStatusChanged      = "status_changed"
+	EventHistoryReplaced    = "history_replaced"
+	EventTitleChanged       = "title_changed"
+	EventDescriptionChanged = "description_changed"
+	EventPriorityChanged    = "priority_changed"
+	EventTagsChanged        = "tags_changed"
+	EventProjectChanged     = "project_changed"
+	EventParentChanged      = "parent_changed"
+	EventBlockersChanged    = "blockers_changed"
+	EventDueDateChanged     = "due_date_changed"
+	EventRecurrenceChanged  = "recurrence_changed"
+	EventAttachmentsChanged = "attachments_changed"
+	EventChecklistChanged   = "checklist_changed"
+	EventRankChanged        = "rank_changed"
+	EventDeletedAtChanged   = "deleted_at_changed"
+	EventArchivedAtChanged  = "archived_at_changed"
+)
+
+// taskField is a group of task fields set by one type of event.
+type taskField struct {
+	event string
+	copy  func(dst, src *persistence.TaskModel)
+}
+
+// taskFields lists the field groups compared by Save. ID and CreatedAt are set by EventTaskCreated
+// only, and the status and its history are compared separately.
+var taskFields = []taskField{
+	{EventTitleChanged, func(dst, src *persistence.TaskModel) { dst.Title = src.Title }},
+	{EventDescriptionChanged, func(dst, src *persistence.TaskModel) { dst.Description = src.Description }},
+	{EventPriorityChanged, func(dst, src *persistence.TaskModel) { dst.Priority = src.Priority }},
+	{EventTagsChanged, func(dst, src *persistence.TaskModel) { dst.Tags = src.Tags }},
+	{EventProjectChanged, func(dst, src *persistence.TaskModel) { dst.ProjectID = src.ProjectID }},
+	{EventParentChanged, func(dst, src *persistence.TaskModel) { dst.ParentID = src.ParentID }},
+	{EventBlockersChanged, func(dst, src *persistence.TaskModel) { dst.BlockedBy = src.BlockedBy }},
+	{EventDueDateChanged, func(dst, src *persistence.TaskModel) {
+		dst.DueDate, dst.DueTimezone = src.DueDate, src.DueTimezone
+	}},
+	{EventRecurrenceChanged, func(dst, src *persistence.TaskModel) {
+		dst.Recurrence, dst.RecurrenceStart = src.Recurrence, src.RecurrenceStart
+	}},
+	{EventAttachmentsChanged, func(dst, src *persistence.TaskModel) { dst.Attachments = src.Attachments }},
+	{EventChecklistChanged, func(dst, src *persistence.TaskModel) { dst.Checklist = src.Checklist }},
+	{EventRankChanged, func(dst, src *persistence.TaskModel) { dst.Rank = src.Rank }},
+	{EventDeletedAtChanged, func(dst, src *persistence.TaskModel) { dst.DeletedAt = src.DeletedAt }},
+	{EventArchivedAtChanged, func(dst, src *persistence.TaskModel) { dst.ArchivedAt = src.ArchivedAt }},
+}
+
+// EventSourcedTaskRepository implements ports.TaskRepository and ports.TaskTimeline by keeping the events of every task
+// rather than its current state. Save compares the task with its current state and appends an event
+// per changed field group; reads rebuild tasks by folding their events, starting from the latest
+// snapshot, which is taken every snapshotInterval events of a task. As events are never removed,
+// FindByIdAsOf and FindByStatusAsOf rebuild the tasks as they were at any earlier time.
+// Queries other than by ID fold every stream, so the repository suits modest stores or a read model
+// kept alongside it.
+type EventSourcedTaskRepository struct {
+	streams          map[string]*taskStream
+	snapshotInterval int
+	now              func() time.Time
+	mutex            sync.RWMutex
+}
+
+// taskStream holds the events of a task, oldest first, and its snapshots, by increasing version.
+type taskStream struct {
+	events    []persistence.TaskEventModel
+	snapshots []persistence.TaskSnapshotModel
+}
+
+// Ensure EventSourcedTaskRepository implements the repository ports at compile time.
+var (
+	_ ports.TaskRepository = (*EventSourcedTaskRepository)(nil)
+	_ ports.TaskTimeline   = (*EventSourcedTaskRepository)(nil)
+)
+
+// NewEventSourcedTaskRepository creates an empty event-sourced repository taking a snapshot of a task
+// every snapshotInterval events; an interval below 1 disables snapshots.
+func NewEventSourcedTaskRepository(snapshotInterval int) *EventSourcedTaskRepository {
+	return &EventSourcedTaskRepository{
+		streams:          make(map[string]*taskStream),
+		snapshotInterval: snapshotInterval,
+		now:              time.Now,
+	}
+}
+
+// Save appends the events that turn the current state of the task into the given one.
+// Saving an unchanged task appends nothing.
+func (r *EventSourcedTaskRepository) Save(task *entities.Task) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	model := persistence.FromDomain(task)
+	stream, exists := r.streams[model.ID]
+	if !exists {
+		stream = &taskStream{}
+		r.streams[model.ID] = stream
+	}
+	current := stream.replay(len(stream.events))
+	r.append(stream, model.ID, current, deriveEvents(current, model))
+	return nil
+}
+
+// FindById rebuilds the current state of a task from its events.
+func (r *EventSourcedTaskRepository) FindById(id value_objects.TaskId) (*entities.Task, error) {
+	return r.FindByIdAsOf(id, time.Time{})
+}
+
+// FindByIdAsOf rebuilds a task from the events appended up to and including at; a zero time
+// rebuilds its current state. Returns ErrNotFound if the task did not exist at that time.
+func (r *EventSourcedTaskRepository) FindByIdAsOf(id value_objects.TaskId, at time.Time) (*entities.Task, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	stream, exists := r.streams[string(id)]
+	if !exists {
+		return nil, ErrNotFound
+	}
+	model := stream.replay(stream.versionAt(at))
+	if model == nil {
+		return nil, ErrNotFound
+	}
+	return model.ToDomain()
+}
+
+// FindByStatus rebuilds the tasks of a status column, ordered by rank within the column.
+// Tasks sharing a rank are ordered by creation time.
+func (r *EventSourcedTaskRepository) FindByStatus(status value_objects.TaskStatus) ([]*entities.Task, error) {
+	return r.FindByStatusAsOf(status, time.Time{})
+}
+
+// FindByStatusAsOf rebuilds the tasks that had the status at the given time, ordered like
+// FindByStatus; a zero time rebuilds the current column.
+func (r *EventSourcedTaskRepository) FindByStatusAsOf(status value_objects.TaskStatus, at time.Time) ([]*entities.Task, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	var models []*persistence.TaskModel
+	for _, stream := range r.streams {
+		if model := stream.replay(stream.versionAt(at)); model != nil && model.Status == status.String() {
+			models = append(models, model)
+		}
+	}
+	return byRank(models)
+}
+
+// Delete appends an event removing the task. Its earlier events are kept.
+func (r *EventSourcedTaskRepository) Delete(id value_objects.TaskId) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	stream, exists := r.streams[string(id)]
+	if !exists {
+		return ErrNotFound
+	}
+	current := stream.replay(len(stream.events))
+	if current == nil {
+		return ErrNotFound
+	}
+	r.append(stream, string(id), current, []persistence.TaskEventModel{{Type: EventTaskDeleted}})
+	return nil
+}
+
+// Events returns the events of a task, oldest first.
+func (r *EventSourcedTaskRepository) Events(id value_objects.TaskId) []persistence.TaskEventModel {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	stream, exists := r.streams[string(id)]
+	if !exists {
+		return nil
+	}
+	return append([]persistence.TaskEventModel(nil), stream.events...)
+}
+
+// append numbers and timestamps the events, adds them to the stream whose current state is given and
+// takes the snapshots that fall due. Event times never decrease within a stream, so replays can stop at the first later event.
+// Callers must hold the write lock.
+func (r *EventSourcedTaskRepository) append(stream *taskStream, id string, state *persistence.TaskModel, events []persistence.TaskEventModel) {
+	if len(events) == 0 {
+		return
+	}
+	at := r.now()
+	if n := len(stream.events); n > 0 && at.Before(stream.events[n-1].At) {
+		at = stream.events[n-1].At
+	}
+	for _, event := range events {
+		event.TaskID = id
+		event.Version = len(stream.events) + 1
+		event.At = at
+		stream.events = append(stream.events, event)
+		state = applyEvent(state, event)
+		if r.snapshotInterval > 0 && event.Version%r.snapshotInterval == 0 {
+			stream.snapshots = append(stream.snapshots, persistence.TaskSnapshotModel{TaskID: id, Version: event.Version, Task: state})
+		}
+	}
+}
+
+// versionAt returns the number of events appended up to and including at, or all of them for a zero time.
+func (s *taskStream) versionAt(at time.Time) int {
+	if at.IsZero() {
+		return len(s.events)
+	}
+	return sort.Search(len(s.events), func(i int) bool { return s.events[i].At.After(at) })
+}
+
+// replay folds the first version events of the stream, starting from the latest snapshot taken at
+// or before that version. Returns nil if the task does not exist at that version.
+func (s *taskStream) replay(version int) *persistence.TaskModel {
+	var state *persistence.TaskModel
+	from := 0
+	if i := sort.Search(len(s.snapshots), func(i int) bool { return s.snapshots[i].Version > version }); i > 0 {
+		state, from = s.snapshots[i-1].Task, s.snapshots[i-1].Version
+	}
+	for _, event := range s.events[from:version] {
+		state = applyEvent(state, event)
+	}
+	return state
+}
+
+// applyEvent returns the state of a task after the event; state is not modified, as it may be shared
+// with a snapshot.
+func applyEvent(state *persistence.TaskModel, event persistence.TaskEventModel) *persistence.TaskModel {
+	switch event.Type {
+	case EventTaskCreated:
+		created := *event.Data
+		return &created
+	case EventTaskDeleted:
+		return nil
+	}
+	if state == nil {
+		return nil
+	}
+	next := *state
+	switch event.Type {
+	case EventStatusChanged:
+		next.Status = event.Data.Status
+		next.History = append(state.History[:len(state.History):len(state.History)], event.Data.History...)
+	case EventHistoryReplaced:
+		next.Status, next.History = event.Data.Status, event.Data.History
+	default:
+		for _, field := range taskFields {
+			if field.event == event.Type {
+				field.copy(&next, event.Data)
+			}
+		}
+	}
+	return &next
+}
+
+// deriveEvents returns the events that turn before, nil for a task that does not exist, into after.
+func deriveEvents(before, after *persistence.TaskModel) []persistence.TaskEventModel {
+	if before == nil {
+		return []persistence.TaskEventModel{{Type: EventTaskCreated, Data: after}}
+	}
+	var events []persistence.TaskEventModel
+	n := len(before.History)
+	switch {
+	case n == 0 || len(after.History) >= n && sameJSON(before.History, after.History[:n]):
+		if before.Status != after.Status || len(after.History) > n {
+			events = append(events, persistence.TaskEventModel{Type: EventStatusChanged,
+				Data: &persistence.TaskModel{Status: after.Status, History: after.History[n:]}})
+		}
+	default:
+		events = append(events, persistence.TaskEventModel{Type: EventHistoryReplaced,
+			Data: &persistence.TaskModel{Status: after.Status, History: after.History}})
+	}
+	for _, field := range taskFields {
+		var was, is persistence.TaskModel
+		field.copy(&was, before)
+		field.copy(&is, after)
+		if !sameJSON(&was, &is) {
+			events = append(events, persistence.TaskEventModel{Type: field.event, Data: &is})
+		}
+	}
+	return events
+}
+
+// sameJSON reports whether a and b have the same JSON encoding, which compares times by instant and
+// offset rather than by their in-memory location.
+func sameJSON(a, b interface{}) bool {
+	encodedA, errA := json.Marshal(a)
+	encodedB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(encodedA, encodedB)
+}
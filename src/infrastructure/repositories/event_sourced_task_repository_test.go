@@ -0,0 +1,118 @@
+package repositories
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/persistence"
+)
+
+func TestEventSourced_SaveAppendsEventsOfTheChange(t *testing.T) {
+	r := NewEventSourcedTaskRepository(DefaultSnapshotInterval)
+	task, _ := entities.NewTask("paint", "")
+	r.Save(task)
+
+	task.Title = "paint the fence"
+	task.AddTags("home")
+	task.UpdateStatusBy("alice", value_objects.StatusDoing)
+	r.Save(task)
+	r.Save(task)
+
+	var types []string
+	for _, event := range r.Events(task.ID) {
+		types = append(types, event.Type)
+	}
+	want := []string{EventTaskCreated, EventStatusChanged, EventTitleChanged, EventTagsChanged}
+	if !reflect.DeepEqual(types, want) {
+		t.Fatalf("expected events %v, got %v", want, types)
+	}
+	if history := r.Events(task.ID)[1].Data.History; len(history) != 1 || history[0].Actor != "alice" {
+		t.Errorf("expected the status event to carry the new history entry only, got %+v", history)
+	}
+
+	found, err := r.FindById(task.ID)
+	if err != nil {
+		t.Fatalf("find by id failed: %v", err)
+	}
+	if !reflect.DeepEqual(persistence.FromDomain(found), persistence.FromDomain(task)) {
+		t.Errorf("expected the folded task to match the saved one, got %+v", found)
+	}
+	if doing, _ := r.FindByStatus(value_objects.StatusDoing); len(doing) != 1 {
+		t.Errorf("expected the task in doing, got %v", doing)
+	}
+
+	if err := r.Delete(task.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := r.FindById(task.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected the deleted task to be gone, got %v", err)
+	}
+	if err := r.Delete(task.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a deleted task, got %v", err)
+	}
+	r.Save(task)
+	if _, err := r.FindById(task.ID); err != nil {
+		t.Errorf("expected saving again to recreate the task, got %v", err)
+	}
+}
+
+func TestEventSourced_RebuildsStateAtAnyTime(t *testing.T) {
+	r := NewEventSourcedTaskRepository(2)
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	clock := start
+	r.now = func() time.Time { return clock }
+
+	task, _ := entities.NewTask("paint", "")
+	r.Save(task)
+	for i, status := range []value_objects.TaskStatus{value_objects.StatusDoing, value_objects.StatusDone} {
+		clock = start.Add(time.Duration(i+1) * time.Hour)
+		task.UpdateStatus(status)
+		task.Description = status.String()
+		r.Save(task)
+	}
+	clock = start.Add(3 * time.Hour)
+	r.Delete(task.ID)
+
+	if len(r.streams[string(task.ID)].snapshots) != 3 {
+		t.Errorf("expected a snapshot every 2 of the 6 events, got %d", len(r.streams[string(task.ID)].snapshots))
+	}
+	for offset, want := range map[time.Duration]value_objects.TaskStatus{
+		0:                       value_objects.StatusTodo,
+		time.Hour + time.Minute: value_objects.StatusDoing,
+		2 * time.Hour:           value_objects.StatusDone,
+	} {
+		found, err := r.FindByIdAsOf(task.ID, start.Add(offset))
+		if err != nil || found.Status != want {
+			t.Errorf("expected %s at +%s, got %+v %v", want, offset, found, err)
+			continue
+		}
+		if want != value_objects.StatusTodo && found.Description != want.String() {
+			t.Errorf("expected the description saved with %s, got %q", want, found.Description)
+		}
+		if column, _ := r.FindByStatusAsOf(want, start.Add(offset)); len(column) != 1 {
+			t.Errorf("expected the task in the %s column at +%s, got %v", want, offset, column)
+		}
+	}
+	if _, err := r.FindByIdAsOf(task.ID, start.Add(-time.Minute)); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected no task before its creation, got %v", err)
+	}
+	if _, err := r.FindByIdAsOf(task.ID, start.Add(3*time.Hour)); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected no task after its deletion, got %v", err)
+	}
+
+	// Snapshots are only a shortcut: folding every event gives the same states.
+	unsnapshotted := &EventSourcedTaskRepository{streams: map[string]*taskStream{
+		string(task.ID): {events: r.streams[string(task.ID)].events},
+	}}
+	for offset := time.Duration(0); offset < 3*time.Hour; offset += 30 * time.Minute {
+		withSnapshots, _ := r.FindByIdAsOf(task.ID, start.Add(offset))
+		folded, _ := unsnapshotted.FindByIdAsOf(task.ID, start.Add(offset))
+		if !reflect.DeepEqual(persistence.FromDomain(withSnapshots), persistence.FromDomain(folded)) {
+			t.Errorf("expected the same state with and without snapshots at +%s", offset)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/persistence"
+	"errors"
+	"sort"
+	"sync"
+)
+
+// ErrCommentNotFound is returned when a comment does not exist.
+var ErrCommentNotFound = errors.New("comment not found")
+
+// InMemoryCommentRepository implements ports.CommentRepository.
+// Comments are indexed by task so listing and cascading deletes do not scan every comment.
+type InMemoryCommentRepository struct {
+	comments map[string]*persistence.CommentModel
+	byTask   map[string]map[string]struct{}
+	mutex    sync.RWMutex
+}
+
+// Ensure InMemoryCommentRepository implements ports.CommentRepository at compile time.
+var _ ports.CommentRepository = (*InMemoryCommentRepository)(nil)
+
+// NewInMemoryCommentRepository creates a new instance of InMemoryCommentRepository.
+func NewInMemoryCommentRepository() *InMemoryCommentRepository {
+	return &InMemoryCommentRepository{
+		comments: make(map[string]*persistence.CommentModel),
+		byTask:   make(map[string]map[string]struct{}),
+	}
+}
+
+// Save persists a comment entity by converting it to a model and storing it.
+func (r *InMemoryCommentRepository) Save(comment *entities.Comment) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	model := persistence.CommentFromDomain(comment)
+	r.comments[model.ID] = model
+	addToIndex(r.byTask, model.TaskID, model.ID)
+	return nil
+}
+
+// FindById retrieves a comment by ID.
+func (r *InMemoryCommentRepository) FindById(id value_objects.CommentId) (*entities.Comment, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	model, exists := r.comments[string(id)]
+	if !exists {
+		return nil, ErrCommentNotFound
+	}
+	return model.ToDomain(), nil
+}
+
+// FindByTask retrieves the comments of a task, oldest first.
+func (r *InMemoryCommentRepository) FindByTask(taskID value_objects.TaskId) ([]*entities.Comment, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	models := make([]*persistence.CommentModel, 0, len(r.byTask[string(taskID)]))
+	for id := range r.byTask[string(taskID)] {
+		models = append(models, r.comments[id])
+	}
+	sort.Slice(models, func(i, j int) bool {
+		if models[i].CreatedAt.Equal(models[j].CreatedAt) {
+			return models[i].ID < models[j].ID
+		}
+		return models[i].CreatedAt.Before(models[j].CreatedAt)
+	})
+	var comments []*entities.Comment
+	for _, model := range models {
+		comments = append(comments, model.ToDomain())
+	}
+	return comments, nil
+}
+
+// CountByTask returns the number of comments on a task.
+func (r *InMemoryCommentRepository) CountByTask(taskID value_objects.TaskId) (int, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return len(r.byTask[string(taskID)]), nil
+}
+
+// Delete removes a comment by ID.
+func (r *InMemoryCommentRepository) Delete(id value_objects.CommentId) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	model, exists := r.comments[string(id)]
+	if !exists {
+		return ErrCommentNotFound
+	}
+	removeFromIndex(r.byTask, model.TaskID, model.ID)
+	delete(r.comments, model.ID)
+	return nil
+}
+
+// DeleteByTask removes every comment of a task.
+func (r *InMemoryCommentRepository) DeleteByTask(taskID value_objects.TaskId) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for id := range r.byTask[string(taskID)] {
+		delete(r.comments, id)
+	}
+	delete(r.byTask, string(taskID))
+	return nil
+}
@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"errors"
+	"testing"
+
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+func TestCommentRepository_FindByTaskAndCascade(t *testing.T) {
+	r := NewInMemoryCommentRepository()
+	taskID := value_objects.NewTaskId()
+	otherID := value_objects.NewTaskId()
+
+	first, _ := entities.NewComment(taskID, "alice", "first")
+	second, _ := entities.NewComment(taskID, "bob", "second")
+	second.CreatedAt = first.CreatedAt.Add(1)
+	other, _ := entities.NewComment(otherID, "carol", "elsewhere")
+	for _, c := range []*entities.Comment{second, first, other} {
+		if err := r.Save(c); err != nil {
+			t.Fatalf("save failed: %v", err)
+		}
+	}
+
+	comments, _ := r.FindByTask(taskID)
+	if len(comments) != 2 || comments[0].ID != first.ID || comments[1].ID != second.ID {
+		t.Fatalf("expected comments oldest first, got %+v", comments)
+	}
+	if n, _ := r.CountByTask(taskID); n != 2 {
+		t.Fatalf("expected 2 comments, got %d", n)
+	}
+
+	if err := r.Delete(first.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := r.FindById(first.ID); !errors.Is(err, ErrCommentNotFound) {
+		t.Fatalf("expected ErrCommentNotFound, got %v", err)
+	}
+	if err := r.Delete(first.ID); !errors.Is(err, ErrCommentNotFound) {
+		t.Fatalf("expected ErrCommentNotFound on second delete, got %v", err)
+	}
+
+	if err := r.DeleteByTask(taskID); err != nil {
+		t.Fatalf("delete by task failed: %v", err)
+	}
+	if n, _ := r.CountByTask(taskID); n != 0 {
+		t.Fatalf("expected no comments after cascade, got %d", n)
+	}
+	if _, err := r.FindById(second.ID); !errors.Is(err, ErrCommentNotFound) {
+		t.Fatalf("expected cascaded comment to be gone, got %v", err)
+	}
+	if n, _ := r.CountByTask(otherID); n != 1 {
+		t.Fatalf("expected other task's comment to survive, got %d", n)
+	}
+}
@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"clean-architecture-golang/application/ports"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultIdempotencyTTL is how long idempotency keys are kept by default.
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// Sentinel errors for idempotency keys
+var (
+	ErrIdempotencyKeyInUse  = errors.New("a request with this idempotency key is still in progress")
+	ErrIdempotencyKeyReused = errors.New("idempotency key was already used with a different request")
+)
+
+type idempotencyRecord struct {
+	fingerprint string
+	response    *ports.IdempotentResponse
+	expiresAt   time.Time
+}
+
+// InMemoryIdempotencyStore implements ports.IdempotencyStore.
+// Expired keys are ignored by Reserve and dropped by Sweep.
+type InMemoryIdempotencyStore struct {
+	records map[string]*idempotencyRecord
+	ttl     time.Duration
+	mutex   sync.Mutex
+}
+
+// Ensure InMemoryIdempotencyStore implements ports.IdempotencyStore at compile time.
+var _ ports.IdempotencyStore = (*InMemoryIdempotencyStore)(nil)
+
+// NewInMemoryIdempotencyStore creates a store keeping keys for ttl.
+func NewInMemoryIdempotencyStore(ttl time.Duration) *InMemoryIdempotencyStore {
+	return &InMemoryIdempotencyStore{records: make(map[string]*idempotencyRecord), ttl: ttl}
+}
+
+// Reserve claims key, or returns the response stored under it.
+func (s *InMemoryIdempotencyStore) Reserve(key, fingerprint string, now time.Time) (*ports.IdempotentResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if record, exists := s.records[key]; exists && now.Before(record.expiresAt) {
+		switch {
+		case record.fingerprint != fingerprint:
+			return nil, ErrIdempotencyKeyReused
+		case record.response == nil:
+			return nil, ErrIdempotencyKeyInUse
+		}
+		return record.response, nil
+	}
+	s.records[key] = &idempotencyRecord{fingerprint: fingerprint, expiresAt: now.Add(s.ttl)}
+	return nil, nil
+}
+
+// Complete stores the response of a reserved key. A key that expired while its request ran is not kept.
+func (s *InMemoryIdempotencyStore) Complete(key string, response ports.IdempotentResponse) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if record, exists := s.records[key]; exists {
+		record.response = &response
+	}
+	return nil
+}
+
+// Sweep drops the keys expired at now and returns how many were dropped.
+func (s *InMemoryIdempotencyStore) Sweep(now time.Time) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	swept := 0
+	for key, record := range s.records {
+		if !now.Before(record.expiresAt) {
+			delete(s.records, key)
+			swept++
+		}
+	}
+	return swept
+}
+
+// Release drops a reserved key.
+func (s *InMemoryIdempotencyStore) Release(key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.records, key)
+	return nil
+}
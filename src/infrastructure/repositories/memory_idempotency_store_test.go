@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"clean-architecture-golang/application/ports"
+)
+
+func TestIdempotencyStore_ReplaysAndExpires(t *testing.T) {
+	s := NewInMemoryIdempotencyStore(time.Hour)
+	now := time.Now()
+
+	if stored, err := s.Reserve("k", "a", now); stored != nil || err != nil {
+		t.Fatalf("expected a free key, got %v (err=%v)", stored, err)
+	}
+	if _, err := s.Reserve("k", "a", now); !errors.Is(err, ErrIdempotencyKeyInUse) {
+		t.Errorf("expected ErrIdempotencyKeyInUse, got %v", err)
+	}
+	s.Complete("k", ports.IdempotentResponse{StatusCode: 200, Body: []byte("ok")})
+	if stored, err := s.Reserve("k", "a", now.Add(time.Minute)); err != nil || string(stored.Body) != "ok" {
+		t.Errorf("expected the stored response, got %v (err=%v)", stored, err)
+	}
+	if _, err := s.Reserve("k", "b", now); !errors.Is(err, ErrIdempotencyKeyReused) {
+		t.Errorf("expected ErrIdempotencyKeyReused, got %v", err)
+	}
+	if stored, err := s.Reserve("k", "b", now.Add(time.Hour)); stored != nil || err != nil {
+		t.Errorf("expected the key to be free once expired, got %v (err=%v)", stored, err)
+	}
+	if swept := s.Sweep(now.Add(2 * time.Hour)); swept != 1 || len(s.records) != 0 {
+		t.Errorf("expected the expired key to be swept, got %d and %d left", swept, len(s.records))
+	}
+
+	s.Reserve("failed", "a", now)
+	s.Release("failed")
+	if stored, err := s.Reserve("failed", "a", now); stored != nil || err != nil {
+		t.Errorf("expected a released key to be free, got %v (err=%v)", stored, err)
+	}
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/persistence"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrProjectNotFound is returned when a project does not exist.
+var ErrProjectNotFound = ports.ErrProjectNotFound
+
+// InMemoryProjectRepository implements ports.ProjectRepository.
+type InMemoryProjectRepository struct {
+	projects map[string]*persistence.ProjectModel
+	mutex    sync.RWMutex
+}
+
+// Ensure InMemoryProjectRepository implements ports.ProjectRepository at compile time.
+var _ ports.ProjectRepository = (*InMemoryProjectRepository)(nil)
+
+// NewInMemoryProjectRepository creates a new instance of InMemoryProjectRepository.
+func NewInMemoryProjectRepository() *InMemoryProjectRepository {
+	return &InMemoryProjectRepository{
+		projects: make(map[string]*persistence.ProjectModel),
+	}
+}
+
+// Save persists a project entity by converting it to a model and storing it.
+func (r *InMemoryProjectRepository) Save(project *entities.Project) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	model := persistence.ProjectFromDomain(project)
+	r.projects[model.ID] = model
+	return nil
+}
+
+// FindById retrieves a project by ID.
+func (r *InMemoryProjectRepository) FindById(id value_objects.ProjectId) (*entities.Project, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	model, exists := r.projects[string(id)]
+	if !exists {
+		return nil, ErrProjectNotFound
+	}
+	return model.ToDomain(), nil
+}
+
+// FindAll retrieves every project ordered by name, ignoring case.
+func (r *InMemoryProjectRepository) FindAll() ([]*entities.Project, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	models := make([]*persistence.ProjectModel, 0, len(r.projects))
+	for _, model := range r.projects {
+		models = append(models, model)
+	}
+	sort.Slice(models, func(i, j int) bool {
+		ni, nj := strings.ToLower(models[i].Name), strings.ToLower(models[j].Name)
+		if ni != nj {
+			return ni < nj
+		}
+		return models[i].ID < models[j].ID
+	})
+	projects := make([]*entities.Project, len(models))
+	for i, model := range models {
+		projects[i] = model.ToDomain()
+	}
+	return projects, nil
+}
+
+// Delete removes a project by ID.
+func (r *InMemoryProjectRepository) Delete(id value_objects.ProjectId) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, exists := r.projects[string(id)]; !exists {
+		return ErrProjectNotFound
+	}
+	delete(r.projects, string(id))
+	return nil
+}
@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"errors"
+	"testing"
+
+	"clean-architecture-golang/domain/entities"
+)
+
+func TestProjectRepository_SaveFindAllDelete(t *testing.T) {
+	r := NewInMemoryProjectRepository()
+	work, _ := entities.NewProject("work", "", entities.ProjectSettings{StartTimers: true})
+	home, _ := entities.NewProject("Home", "", entities.ProjectSettings{})
+	r.Save(work)
+	r.Save(home)
+
+	found, err := r.FindById(work.ID)
+	if err != nil || found.Name != "work" || !found.Settings.StartTimers {
+		t.Fatalf("unexpected project %+v (err=%v)", found, err)
+	}
+	all, _ := r.FindAll()
+	if len(all) != 2 || all[0].Name != "Home" || all[1].Name != "work" {
+		t.Fatalf("expected projects ordered by name ignoring case, got %v", all)
+	}
+	if err := r.Delete(work.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := r.FindById(work.ID); !errors.Is(err, ErrProjectNotFound) {
+		t.Errorf("expected ErrProjectNotFound, got %v", err)
+	}
+	if err := r.Delete(work.ID); !errors.Is(err, ErrProjectNotFound) {
+		t.Errorf("expected ErrProjectNotFound on second delete, got %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/infrastructure/persistence"
+	"sort"
+	"sync"
+)
+
+// ErrViewNotFound is returned when a user has no saved view with the requested name.
+var ErrViewNotFound = ports.ErrViewNotFound
+
+// InMemorySavedViewRepository implements ports.SavedViewRepository.
+type InMemorySavedViewRepository struct {
+	// views maps owners to their views by name.
+	views map[string]map[string]*persistence.SavedViewModel
+	mutex sync.RWMutex
+}
+
+// Ensure InMemorySavedViewRepository implements ports.SavedViewRepository at compile time.
+var _ ports.SavedViewRepository = (*InMemorySavedViewRepository)(nil)
+
+// NewInMemorySavedViewRepository creates a new instance of InMemorySavedViewRepository.
+func NewInMemorySavedViewRepository() *InMemorySavedViewRepository {
+	return &InMemorySavedViewRepository{
+		views: make(map[string]map[string]*persistence.SavedViewModel),
+	}
+}
+
+// Save persists a view, replacing any view of the same owner with the same name.
+func (r *InMemorySavedViewRepository) Save(view *entities.SavedView) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	model := persistence.SavedViewFromDomain(view)
+	views, ok := r.views[model.Owner]
+	if !ok {
+		views = make(map[string]*persistence.SavedViewModel)
+		r.views[model.Owner] = views
+	}
+	views[model.Name] = model
+	return nil
+}
+
+// FindByName retrieves a view of a user by name. Returns persistence.ErrCorruptModel if the stored
+// view cannot be read.
+func (r *InMemorySavedViewRepository) FindByName(owner, name string) (*entities.SavedView, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	model, exists := r.views[owner][name]
+	if !exists {
+		return nil, ErrViewNotFound
+	}
+	return model.ToDomain()
+}
+
+// FindByOwner retrieves the views of a user ordered by name. Returns persistence.ErrCorruptModel
+// if a stored view cannot be read.
+func (r *InMemorySavedViewRepository) FindByOwner(owner string) ([]*entities.SavedView, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	names := make([]string, 0, len(r.views[owner]))
+	for name := range r.views[owner] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	views := make([]*entities.SavedView, len(names))
+	for i, name := range names {
+		view, err := r.views[owner][name].ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		views[i] = view
+	}
+	return views, nil
+}
+
+// Delete removes a view of a user by name.
+func (r *InMemorySavedViewRepository) Delete(owner, name string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	views := r.views[owner]
+	if _, exists := views[name]; !exists {
+		return ErrViewNotFound
+	}
+	delete(views, name)
+	if len(views) == 0 {
+		delete(r.views, owner)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/persistence"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultProjectionInterval is how often the task list projection catches up with the event log by default.
+const DefaultProjectionInterval = 100 * time.Millisecond
+
+// projectionBatchSize is how many events CatchUp reads from the event log at a time.
+const projectionBatchSize = 500
+
+// InMemoryTaskListProjection implements ports.TaskListReadModel with a list of task models per status,
+// kept in column order, and the status of every task. It is updated from a ports.TaskEventLog: CatchUp
+// applies the events committed since the last event applied, the checkpoint, and until then reads
+// return the lists as of the checkpoint. When the log no longer holds the events it needs, CatchUp
+// rebuilds the lists from the active tasks.
+type InMemoryTaskListProjection struct {
+	log        ports.TaskEventLog
+	columns    map[value_objects.TaskStatus][]*persistence.TaskModel
+	statuses   map[string]value_objects.TaskStatus
+	checkpoint uint64
+	mutex      sync.RWMutex
+	// updating serializes CatchUp and Rebuild, which read the log without holding mutex.
+	updating sync.Mutex
+}
+
+// Ensure InMemoryTaskListProjection implements ports.TaskListReadModel at compile time.
+var _ ports.TaskListReadModel = (*InMemoryTaskListProjection)(nil)
+
+// NewInMemoryTaskListProjection creates an empty projection of the given event log; it fills up with
+// the first CatchUp or Rebuild.
+func NewInMemoryTaskListProjection(log ports.TaskEventLog) *InMemoryTaskListProjection {
+	return &InMemoryTaskListProjection{
+		log:      log,
+		columns:  make(map[value_objects.TaskStatus][]*persistence.TaskModel),
+		statuses: make(map[string]value_objects.TaskStatus),
+	}
+}
+
+// ListByStatus returns the tasks of a status column ordered by rank, as of the checkpoint.
+func (p *InMemoryTaskListProjection) ListByStatus(status value_objects.TaskStatus) ([]*entities.Task, error) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return toDomain(p.columns[status])
+}
+
+// CountByStatus returns the number of tasks in every status column, as of the checkpoint.
+func (p *InMemoryTaskListProjection) CountByStatus() (map[value_objects.TaskStatus]int, error) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	counts := make(map[value_objects.TaskStatus]int, len(value_objects.AllStatuses()))
+	for _, status := range value_objects.AllStatuses() {
+		counts[status] = len(p.columns[status])
+	}
+	return counts, nil
+}
+
+// Checkpoint returns the sequence number of the last event applied.
+func (p *InMemoryTaskListProjection) Checkpoint() uint64 {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.checkpoint
+}
+
+// CatchUp applies the events committed since the checkpoint and returns how many it applied.
+func (p *InMemoryTaskListProjection) CatchUp() (int, error) {
+	p.updating.Lock()
+	defer p.updating.Unlock()
+	applied := 0
+	for {
+		events, err := p.log.EventsSince(p.checkpoint, projectionBatchSize)
+		if errors.Is(err, ErrEventsTrimmed) {
+			return applied, p.rebuild()
+		}
+		if err != nil {
+			return applied, err
+		}
+		if len(events) == 0 {
+			return applied, nil
+		}
+		p.mutex.Lock()
+		for _, event := range events {
+			p.apply(event)
+		}
+		p.mutex.Unlock()
+		applied += len(events)
+	}
+}
+
+// Rebuild discards the lists and builds them again from the active tasks.
+func (p *InMemoryTaskListProjection) Rebuild() error {
+	p.updating.Lock()
+	defer p.updating.Unlock()
+	return p.rebuild()
+}
+
+// rebuild builds the lists from the active tasks. Callers must hold updating.
+func (p *InMemoryTaskListProjection) rebuild() error {
+	tasks, sequence, err := p.log.ActiveTasks()
+	if err != nil {
+		return err
+	}
+	columns := make(map[value_objects.TaskStatus][]*persistence.TaskModel)
+	statuses := make(map[string]value_objects.TaskStatus, len(tasks))
+	for _, task := range tasks {
+		columns[task.Status] = append(columns[task.Status], persistence.FromDomain(task))
+		statuses[string(task.ID)] = task.Status
+	}
+	for _, column := range columns {
+		sort.Slice(column, func(i, j int) bool { return rankLess(column[i], column[j]) })
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.columns, p.statuses, p.checkpoint = columns, statuses, sequence
+	return nil
+}
+
+// apply moves the task of the event out of its column and, unless it was removed, into the column of
+// its new status. Callers must hold the write lock.
+func (p *InMemoryTaskListProjection) apply(event entities.TaskEvent) {
+	id := string(event.TaskID)
+	if status, exists := p.statuses[id]; exists {
+		column := p.columns[status]
+		for i, model := range column {
+			if model.ID == id {
+				p.columns[status] = append(column[:i], column[i+1:]...)
+				break
+			}
+		}
+		delete(p.statuses, id)
+	}
+	if event.Task != nil {
+		model := persistence.FromDomain(event.Task)
+		column := p.columns[event.Task.Status]
+		i := sort.Search(len(column), func(i int) bool { return rankLess(model, column[i]) })
+		column = append(column, nil)
+		copy(column[i+1:], column[i:])
+		column[i] = model
+		p.columns[event.Task.Status] = column
+		p.statuses[id] = event.Task.Status
+	}
+	p.checkpoint = event.Sequence
+}
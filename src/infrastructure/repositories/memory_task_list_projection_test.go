@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"errors"
+	"testing"
+
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+func TestTaskListProjection_IsEventuallyConsistent(t *testing.T) {
+	repo := NewInMemoryTaskRepository()
+	projection := NewInMemoryTaskListProjection(repo)
+	first, _ := entities.NewTask("first", "")
+	second, _ := entities.NewTask("second", "")
+	first.Rank, second.Rank = "b", "a"
+	repo.Save(first)
+	repo.Save(second)
+
+	// Writes show up only once the projection has caught up with them.
+	if todo, _ := projection.ListByStatus(value_objects.StatusTodo); len(todo) != 0 {
+		t.Fatalf("expected the projection to lag behind the writes, got %v", todo)
+	}
+	if applied, err := projection.CatchUp(); err != nil || applied != 2 {
+		t.Fatalf("expected 2 events applied, got %d %v", applied, err)
+	}
+	todo, _ := projection.ListByStatus(value_objects.StatusTodo)
+	if len(todo) != 2 || todo[0].ID != second.ID || todo[1].ID != first.ID {
+		t.Fatalf("expected the column in rank order, got %v", todo)
+	}
+
+	first.UpdateStatus(value_objects.StatusDoing)
+	repo.Save(first)
+	if counts, _ := projection.CountByStatus(); counts[value_objects.StatusTodo] != 2 || counts[value_objects.StatusDoing] != 0 {
+		t.Errorf("expected stale counts before catching up, got %v", counts)
+	}
+	second.MoveToTrash(first.CreatedAt)
+	repo.MoveToTrash(second)
+	projection.CatchUp()
+	counts, _ := projection.CountByStatus()
+	if counts[value_objects.StatusTodo] != 0 || counts[value_objects.StatusDoing] != 1 || counts[value_objects.StatusDone] != 0 {
+		t.Errorf("expected the counts to follow the status change and the trash, got %v", counts)
+	}
+	if applied, _ := projection.CatchUp(); applied != 0 {
+		t.Errorf("expected nothing left to apply, got %d", applied)
+	}
+
+	// Rolled back units of work leave no events behind.
+	uow := NewInMemoryUnitOfWork(repo, nil, DefaultUndoWindow)
+	checkpoint := projection.Checkpoint()
+	uow.Do(func(stores ports.Stores) error {
+		stores.Tasks.Delete(first.ID)
+		return errors.New("boom")
+	})
+	if events, _ := repo.EventsSince(checkpoint, 0); len(events) != 0 {
+		t.Errorf("expected no events from a rolled back unit of work, got %v", events)
+	}
+}
+
+func TestTaskListProjection_RebuildsFromTheActiveTasks(t *testing.T) {
+	repo := NewInMemoryTaskRepository()
+	projection := NewInMemoryTaskListProjection(repo)
+	task, _ := entities.NewTask("paint", "")
+	for i := 0; i <= DefaultEventLogSize; i++ {
+		repo.Save(task)
+	}
+	if _, err := repo.EventsSince(0, 1); !errors.Is(err, ErrEventsTrimmed) {
+		t.Fatalf("expected the oldest events to be trimmed, got %v", err)
+	}
+
+	// A projection too far behind the log rebuilds itself.
+	if _, err := projection.CatchUp(); err != nil {
+		t.Fatalf("catch up failed: %v", err)
+	}
+	if todo, _ := projection.ListByStatus(value_objects.StatusTodo); len(todo) != 1 || projection.Checkpoint() != DefaultEventLogSize+1 {
+		t.Fatalf("expected the rebuilt projection to hold the task, got %v at %d", todo, projection.Checkpoint())
+	}
+
+	repo.Delete(task.ID)
+	if err := projection.Rebuild(); err != nil {
+		t.Fatalf("rebuild failed: %v", err)
+	}
+	if todo, _ := projection.ListByStatus(value_objects.StatusTodo); len(todo) != 0 {
+		t.Errorf("expected the rebuild to reflect the delete, got %v", todo)
+	}
+	if applied, _ := projection.CatchUp(); applied != 0 {
+		t.Errorf("expected the rebuild to move the checkpoint past the delete, got %d applied", applied)
+	}
+}
@@ -7,39 +7,356 @@ import (
 	"clean-architecture-golang/domain/entities"
 	"clean-architecture-golang/domain/value_objects"
 	"clean-architecture-golang/infrastructure/persistence"
+	"clean-architecture-golang/infrastructure/search"
 	"errors"
+	"sort"
 	"sync"
+	"time"
 )
 
 // Sentinel error for not found
-var ErrNotFound = errors.New("task not found")
+var ErrNotFound = ports.ErrTaskNotFound
 
-// InMemoryTaskRepository implements ports.TaskRepository.
+// ErrEventsTrimmed indicates that events asked for have been dropped from the event log.
+var ErrEventsTrimmed = errors.New("task events no longer available")
+
+// ErrHistoryTrimmed indicates that versions asked for have been dropped by CompactVersions.
+var ErrHistoryTrimmed = errors.New("task history no longer available")
+
+// DefaultEventLogSize is how many of the latest task events the repository keeps.
+const DefaultEventLogSize = 10000
+
+// DefaultVersionRetention is how long task versions are kept once they have been replaced.
+const DefaultVersionRetention = 30 * 24 * time.Hour
+
+// InMemoryTaskRepository implements ports.TaskRepository, ports.TaskTagIndex, ports.TaskHierarchy,
+// ports.TaskDependencies, ports.TaskAttachmentIndex, ports.TaskWIPGuard, ports.TaskTrash, ports.TaskArchive,
+// ports.TaskProjectIndex, ports.TaskSearch, ports.TaskQuery, ports.TaskEventLog and ports.TaskTimeline.
 // It provides an in-memory implementation for task persistence.
+// Tag (tag -> task IDs), child (parent ID -> task IDs), dependent (blocker ID -> task IDs),
+// project (project ID -> task IDs) and blob (digest -> task IDs) indexes are maintained on every write so these queries
+// do not scan every task, and so is a full-text index of titles and descriptions. Trashed and archived tasks
+// are kept apart from the active tasks and appear only in the blob index.
+// Every change to the active tasks appends an event to a log holding the latest DefaultEventLogSize
+// events; a unit of work commits its events with its writes, so rolled back changes leave no events.
+// Every change also adds a timestamped version of the task, so the active tasks can be read as they
+// were at an earlier time; CompactVersions drops the versions no longer needed for times before a
+// cutoff, after which such times can no longer be read.
 type InMemoryTaskRepository struct {
-	tasks map[string]*persistence.TaskModel
-	mutex sync.RWMutex
+	tasks          map[string]*persistence.TaskModel
+	trash          map[string]*persistence.TaskModel
+	archive        map[string]*persistence.TaskModel
+	tagIndex       map[string]map[string]struct{}
+	childIndex     map[string]map[string]struct{}
+	dependentIndex map[string]map[string]struct{}
+	projectIndex   map[string]map[string]struct{}
+	blobIndex      map[string]map[string]struct{}
+	searchIndex    *search.InvertedIndex
+	events         []taskEventRecord
+	sequence       uint64
+	versions       map[string][]taskVersion
+	horizon        time.Time
+	now            func() time.Time
+	journal        *taskJournal
+	mutex          sync.RWMutex
+}
+
+// taskJournal records the tasks written through a repository of a unit of work, with their state and
+// versions as they were before the first write.
+type taskJournal struct {
+	states   map[string]taskState
+	versions map[string][]taskVersion
 }
 
-// Ensure InMemoryTaskRepository implements ports.TaskRepository at compile time.
-var _ ports.TaskRepository = (*InMemoryTaskRepository)(nil)
+// taskVersion is the state of a task from a point in time on; model is nil while the task is not active.
+type taskVersion struct {
+	at    time.Time
+	model *persistence.TaskModel
+}
+
+// taskEventRecord is an entry of the event log; model is nil for entities.TaskRemoved.
+type taskEventRecord struct {
+	sequence  uint64
+	eventType entities.TaskEventType
+	id        string
+	model     *persistence.TaskModel
+}
+
+// Ensure InMemoryTaskRepository implements the repository ports at compile time.
+var (
+	_ ports.TaskRepository      = (*InMemoryTaskRepository)(nil)
+	_ ports.TaskTagIndex        = (*InMemoryTaskRepository)(nil)
+	_ ports.TaskHierarchy       = (*InMemoryTaskRepository)(nil)
+	_ ports.TaskDependencies    = (*InMemoryTaskRepository)(nil)
+	_ ports.TaskAttachmentIndex = (*InMemoryTaskRepository)(nil)
+	_ ports.TaskWIPGuard        = (*InMemoryTaskRepository)(nil)
+	_ ports.TaskTrash           = (*InMemoryTaskRepository)(nil)
+	_ ports.TaskArchive         = (*InMemoryTaskRepository)(nil)
+	_ ports.TaskProjectIndex    = (*InMemoryTaskRepository)(nil)
+	_ ports.TaskSearch          = (*InMemoryTaskRepository)(nil)
+	_ ports.TaskQuery           = (*InMemoryTaskRepository)(nil)
+	_ ports.TaskEventLog        = (*InMemoryTaskRepository)(nil)
+	_ ports.TaskTimeline        = (*InMemoryTaskRepository)(nil)
+)
 
 // NewInMemoryTaskRepository creates a new instance of InMemoryTaskRepository.
 func NewInMemoryTaskRepository() *InMemoryTaskRepository {
 	return &InMemoryTaskRepository{
-		tasks: make(map[string]*persistence.TaskModel),
+		tasks:          make(map[string]*persistence.TaskModel),
+		trash:          make(map[string]*persistence.TaskModel),
+		archive:        make(map[string]*persistence.TaskModel),
+		tagIndex:       make(map[string]map[string]struct{}),
+		childIndex:     make(map[string]map[string]struct{}),
+		dependentIndex: make(map[string]map[string]struct{}),
+		projectIndex:   make(map[string]map[string]struct{}),
+		blobIndex:      make(map[string]map[string]struct{}),
+		searchIndex:    search.NewInvertedIndex(),
+		versions:       make(map[string][]taskVersion),
+		now:            time.Now,
 	}
 }
 
 // Save persists a task entity by converting it to a model and storing it.
+// Returns ErrNotFound if the task is in the trash or the archive, so a stale copy cannot bring it back.
 func (r *InMemoryTaskRepository) Save(task *entities.Task) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
+	return r.save(task)
+}
+
+// SaveWithinLimits checks the first task against the limits of its status column and saves the
+// tasks while holding the write lock, so no other write can change the column in between.
+func (r *InMemoryTaskRepository) SaveWithinLimits(limits entities.WIPLimits, beforeSave func() error, tasks ...*entities.Task) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(tasks) == 0 {
+		return nil
+	}
+	for _, task := range tasks {
+		if r.isShelved(string(task.ID)) {
+			return ErrNotFound
+		}
+	}
+	var models []*persistence.TaskModel
+	for _, model := range r.tasks {
+		if model.Status == tasks[0].Status.String() {
+			models = append(models, model)
+		}
+	}
+	column, err := toDomain(models)
+	if err != nil {
+		return err
+	}
+	if err := limits.Check(tasks[0], column); err != nil {
+		return err
+	}
+	if beforeSave != nil {
+		if err := beforeSave(); err != nil {
+			return err
+		}
+	}
+	for _, task := range tasks {
+		if err := r.save(task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// adopt replaces the state of the repository with the state of a clone.
+// Callers must hold the write lock; the clone must not be used afterwards.
+func (r *InMemoryTaskRepository) adopt(clone *InMemoryTaskRepository) {
+	r.tasks, r.trash, r.archive = clone.tasks, clone.trash, clone.archive
+	r.tagIndex, r.childIndex, r.dependentIndex = clone.tagIndex, clone.childIndex, clone.dependentIndex
+	r.projectIndex, r.blobIndex, r.searchIndex = clone.projectIndex, clone.blobIndex, clone.searchIndex
+	r.events, r.sequence, r.versions = clone.events, clone.sequence, clone.versions
+}
+
+// stateOf returns where the task is kept and its model. Callers must hold the lock.
+func (r *InMemoryTaskRepository) stateOf(id string) taskState {
+	if model, exists := r.tasks[id]; exists {
+		return taskState{shelf: shelfActive, model: model}
+	}
+	if model, exists := r.trash[id]; exists {
+		return taskState{shelf: shelfTrash, model: model}
+	}
+	if model, exists := r.archive[id]; exists {
+		return taskState{shelf: shelfArchive, model: model}
+	}
+	return taskState{}
+}
+
+// put replaces the task with the given state, which removes it when the state is zero, and keeps the
+// indexes in step. Callers must hold the write lock.
+func (r *InMemoryTaskRepository) put(id string, state taskState) {
+	r.touch(id)
+	current := r.stateOf(id)
+	switch {
+	case state.shelf == shelfActive && current.shelf == shelfActive:
+		r.publish(entities.TaskUpdated, id, state.model)
+	case state.shelf == shelfActive:
+		r.publish(entities.TaskAdded, id, state.model)
+	case current.shelf == shelfActive:
+		r.publish(entities.TaskRemoved, id, nil)
+	}
+	switch current.shelf {
+	case shelfActive:
+		r.unindex(current.model)
+		delete(r.tasks, id)
+	case shelfTrash:
+		r.unshelve(r.trash, id)
+	case shelfArchive:
+		r.unshelve(r.archive, id)
+	}
+	switch state.shelf {
+	case shelfActive:
+		r.tasks[id] = state.model
+		r.index(state.model)
+	case shelfTrash, shelfArchive:
+		shelf := r.trash
+		if state.shelf == shelfArchive {
+			shelf = r.archive
+		}
+		shelf[id] = state.model
+		for _, attachment := range state.model.Attachments {
+			addToIndex(r.blobIndex, attachment.Digest, id)
+		}
+	}
+}
+
+// touch records the state of the task in the journal before its first write. Callers must hold the
+// write lock.
+func (r *InMemoryTaskRepository) touch(id string) {
+	if r.journal == nil {
+		return
+	}
+	if _, recorded := r.journal.states[id]; recorded {
+		return
+	}
+	r.journal.states[id] = r.stateOf(id)
+	r.journal.versions[id] = r.versions[id]
+}
+
+// journaled returns a repository sharing the state of r whose writes are recorded in a journal, so
+// that rollback can put the written tasks back. Its writes are visible in r at once, apart from the
+// event log, which adopt commits. Callers must hold the write lock of r for as long as it is used.
+func (r *InMemoryTaskRepository) journaled() *InMemoryTaskRepository {
+	return &InMemoryTaskRepository{
+		tasks:          r.tasks,
+		trash:          r.trash,
+		archive:        r.archive,
+		tagIndex:       r.tagIndex,
+		childIndex:     r.childIndex,
+		dependentIndex: r.dependentIndex,
+		projectIndex:   r.projectIndex,
+		blobIndex:      r.blobIndex,
+		searchIndex:    r.searchIndex,
+		events:         r.events[:len(r.events):len(r.events)],
+		sequence:       r.sequence,
+		versions:       r.versions,
+		now:            r.now,
+		journal:        newTaskJournal(),
+	}
+}
+
+// rollback puts back the tasks recorded in the journal and their versions. Events published since
+// the repository was created are not adopted, so they need no undoing. Callers must hold the write
+// lock of the repository it was created from.
+func (r *InMemoryTaskRepository) rollback() {
+	journal := r.journal
+	r.journal = nil
+	for id, state := range journal.states {
+		r.put(id, state)
+	}
+	for id, versions := range journal.versions {
+		if versions == nil {
+			delete(r.versions, id)
+		} else {
+			r.versions[id] = versions
+		}
+	}
+}
+
+func newTaskJournal() *taskJournal {
+	return &taskJournal{states: make(map[string]taskState), versions: make(map[string][]taskVersion)}
+}
+
+// clone copies the repository. Models are replaced rather than changed on every write, so the copy
+// shares them with the original. The copy records the tasks written through it in a journal.
+// Callers must hold the lock.
+func (r *InMemoryTaskRepository) clone() *InMemoryTaskRepository {
+	return &InMemoryTaskRepository{
+		tasks:          cloneModels(r.tasks),
+		trash:          cloneModels(r.trash),
+		archive:        cloneModels(r.archive),
+		tagIndex:       cloneIndex(r.tagIndex),
+		childIndex:     cloneIndex(r.childIndex),
+		dependentIndex: cloneIndex(r.dependentIndex),
+		projectIndex:   cloneIndex(r.projectIndex),
+		blobIndex:      cloneIndex(r.blobIndex),
+		searchIndex:    r.searchIndex.Clone(),
+		events:         r.events[:len(r.events):len(r.events)],
+		sequence:       r.sequence,
+		versions:       cloneVersions(r.versions),
+		horizon:        r.horizon,
+		now:            r.now,
+		journal:        newTaskJournal(),
+	}
+}
+
+func cloneVersions(versions map[string][]taskVersion) map[string][]taskVersion {
+	clone := make(map[string][]taskVersion, len(versions))
+	for id, list := range versions {
+		clone[id] = list[:len(list):len(list)]
+	}
+	return clone
+}
+
+func cloneModels(models map[string]*persistence.TaskModel) map[string]*persistence.TaskModel {
+	clone := make(map[string]*persistence.TaskModel, len(models))
+	for id, model := range models {
+		clone[id] = model
+	}
+	return clone
+}
+
+func cloneIndex(index map[string]map[string]struct{}) map[string]map[string]struct{} {
+	clone := make(map[string]map[string]struct{}, len(index))
+	for key, ids := range index {
+		clone[key] = make(map[string]struct{}, len(ids))
+		for id := range ids {
+			clone[key][id] = struct{}{}
+		}
+	}
+	return clone
+}
+
+// save stores a task and updates the indexes, unless the task is in the trash or the archive; the
+// caller must hold the write lock.
+func (r *InMemoryTaskRepository) save(task *entities.Task) error {
+	if r.isShelved(string(task.ID)) {
+		return ErrNotFound
+	}
 	model := persistence.FromDomain(task)
-	r.tasks[string(task.ID)] = model
+	r.touch(model.ID)
+	eventType := entities.TaskAdded
+	if previous, exists := r.tasks[model.ID]; exists {
+		r.unindex(previous)
+		eventType = entities.TaskUpdated
+	}
+	r.tasks[model.ID] = model
+	r.index(model)
+	r.publish(eventType, model.ID, model)
 	return nil
 }
 
+// isShelved reports whether the task is in the trash or the archive. Callers must hold the lock.
+func (r *InMemoryTaskRepository) isShelved(id string) bool {
+	_, trashed := r.trash[id]
+	_, archived := r.archive[id]
+	return trashed || archived
+}
+
 // FindById retrieves a task by ID, converting the model back to a domain entity.
 func (r *InMemoryTaskRepository) FindById(id value_objects.TaskId) (*entities.Task, error) {
 	r.mutex.RLock()
@@ -48,29 +365,608 @@ func (r *InMemoryTaskRepository) FindById(id value_objects.TaskId) (*entities.Ta
 	if !exists {
 		return nil, ErrNotFound
 	}
-	return model.ToDomain(), nil
+	return model.ToDomain()
 }
 
-// FindByStatus retrieves all tasks with a specific status.
+// FindByStatus retrieves all tasks with a specific status, ordered by rank within the column.
+// Tasks sharing a rank are ordered by creation time.
 func (r *InMemoryTaskRepository) FindByStatus(status value_objects.TaskStatus) ([]*entities.Task, error) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
-	var tasks []*entities.Task
+	var models []*persistence.TaskModel
 	for _, model := range r.tasks {
 		if model.Status == status.String() {
-			tasks = append(tasks, model.ToDomain())
+			models = append(models, model)
 		}
 	}
+	return byRank(models)
+}
+
+// byRank orders the models of a status column by rank, then by creation time, and converts them.
+func byRank(models []*persistence.TaskModel) ([]*entities.Task, error) {
+	sort.Slice(models, func(i, j int) bool { return rankLess(models[i], models[j]) })
+	return toDomain(models)
+}
+
+// toDomain converts models to tasks, failing on the first model that cannot be converted.
+func toDomain(models []*persistence.TaskModel) ([]*entities.Task, error) {
+	var tasks []*entities.Task
+	for _, model := range models {
+		task, err := model.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
 	return tasks, nil
 }
 
+// rankLess reports whether a comes before b within a status column.
+func rankLess(a, b *persistence.TaskModel) bool {
+	if a.Rank != b.Rank {
+		return a.Rank < b.Rank
+	}
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	return a.ID < b.ID
+}
+
 // Delete removes a task by ID.
 func (r *InMemoryTaskRepository) Delete(id value_objects.TaskId) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
-	if _, exists := r.tasks[string(id)]; !exists {
+	model, exists := r.tasks[string(id)]
+	if !exists {
 		return ErrNotFound
 	}
+	r.touch(model.ID)
+	r.unindex(model)
 	delete(r.tasks, string(id))
+	r.publish(entities.TaskRemoved, string(id), nil)
 	return nil
 }
+
+// EventsSince returns up to limit events following the one numbered after, or all of them if limit
+// is not positive. Returns ErrEventsTrimmed if some of those events have been dropped from the log.
+func (r *InMemoryTaskRepository) EventsSince(after uint64, limit int) ([]entities.TaskEvent, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if after >= r.sequence {
+		return nil, nil
+	}
+	first := r.sequence - uint64(len(r.events))
+	if after < first {
+		return nil, ErrEventsTrimmed
+	}
+	records := r.events[after-first:]
+	if limit > 0 && len(records) > limit {
+		records = records[:limit]
+	}
+	events := make([]entities.TaskEvent, len(records))
+	for i, record := range records {
+		events[i] = entities.TaskEvent{Sequence: record.sequence, Type: record.eventType, TaskID: value_objects.TaskId(record.id)}
+		if record.model != nil {
+			task, err := record.model.ToDomain()
+			if err != nil {
+				return nil, err
+			}
+			events[i].Task = task
+		}
+	}
+	return events, nil
+}
+
+// ActiveTasks returns the active tasks and the sequence number of the last event they reflect.
+func (r *InMemoryTaskRepository) ActiveTasks() ([]*entities.Task, uint64, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	tasks := make([]*entities.Task, 0, len(r.tasks))
+	for _, model := range r.tasks {
+		task, err := model.ToDomain()
+		if err != nil {
+			return nil, 0, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, r.sequence, nil
+}
+
+// FindByIdAsOf returns the task as it was at the given time, or its current state for a zero time.
+// Returns ErrNotFound if the task was not active at that time, and ErrHistoryTrimmed if the time is
+// before the cutoff of CompactVersions.
+func (r *InMemoryTaskRepository) FindByIdAsOf(id value_objects.TaskId, at time.Time) (*entities.Task, error) {
+	if at.IsZero() {
+		return r.FindById(id)
+	}
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if at.Before(r.horizon) {
+		return nil, ErrHistoryTrimmed
+	}
+	model := versionAt(r.versions[string(id)], at)
+	if model == nil {
+		return nil, ErrNotFound
+	}
+	return model.ToDomain()
+}
+
+// FindByStatusAsOf returns the tasks that had the status at the given time, ordered by rank within the
+// column, or the current column for a zero time. Returns ErrHistoryTrimmed if the time is before the
+// cutoff of CompactVersions.
+func (r *InMemoryTaskRepository) FindByStatusAsOf(status value_objects.TaskStatus, at time.Time) ([]*entities.Task, error) {
+	if at.IsZero() {
+		return r.FindByStatus(status)
+	}
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if at.Before(r.horizon) {
+		return nil, ErrHistoryTrimmed
+	}
+	var models []*persistence.TaskModel
+	for _, versions := range r.versions {
+		if model := versionAt(versions, at); model != nil && model.Status == status.String() {
+			models = append(models, model)
+		}
+	}
+	return byRank(models)
+}
+
+// CompactVersions drops the versions replaced at or before the cutoff, keeping for each task the
+// version in effect at the cutoff, and forgets tasks that have not been active since. Reads of times
+// before the cutoff return ErrHistoryTrimmed afterwards. Returns the number of versions dropped.
+func (r *InMemoryTaskRepository) CompactVersions(before time.Time) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if !before.After(r.horizon) {
+		return 0
+	}
+	dropped := 0
+	for id, versions := range r.versions {
+		i := sort.Search(len(versions), func(i int) bool { return versions[i].at.After(before) })
+		if n := len(versions); i == n && (n == 0 || versions[n-1].model == nil) {
+			delete(r.versions, id)
+			dropped += len(versions)
+			continue
+		}
+		if i > 1 {
+			r.versions[id] = append([]taskVersion(nil), versions[i-1:]...)
+			dropped += i - 1
+		}
+	}
+	r.horizon = before
+	return dropped
+}
+
+// versionAt returns the model of the latest version recorded up to and including at, or nil if there
+// is none or the task was not active then.
+func versionAt(versions []taskVersion, at time.Time) *persistence.TaskModel {
+	i := sort.Search(len(versions), func(i int) bool { return versions[i].at.After(at) })
+	if i == 0 {
+		return nil
+	}
+	return versions[i-1].model
+}
+
+// publish appends an event to the log, dropping the oldest events beyond DefaultEventLogSize, and
+// records the new version of the task. Version times never decrease for a task, so they can be
+// searched by time. Callers must hold the write lock.
+func (r *InMemoryTaskRepository) publish(eventType entities.TaskEventType, id string, model *persistence.TaskModel) {
+	at := r.now()
+	versions := r.versions[id]
+	if n := len(versions); n > 0 && at.Before(versions[n-1].at) {
+		at = versions[n-1].at
+	}
+	r.versions[id] = append(versions, taskVersion{at: at, model: model})
+	r.sequence++
+	r.events = append(r.events, taskEventRecord{sequence: r.sequence, eventType: eventType, id: id, model: model})
+	if len(r.events) > DefaultEventLogSize {
+		r.events = r.events[len(r.events)-DefaultEventLogSize:]
+	}
+}
+
+// FindByTags retrieves tasks carrying all (matchAll) or any of the given tags.
+// Results are ordered by creation time.
+func (r *InMemoryTaskRepository) FindByTags(tags []value_objects.Tag, matchAll bool) ([]*entities.Task, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	var ids map[string]struct{}
+	if matchAll {
+		ids = r.intersectTags(tags)
+	} else {
+		ids = r.unionTags(tags)
+	}
+	return r.tasksByCreation(ids)
+}
+
+// FindChildren retrieves the direct subtasks of a task, ordered by creation time.
+func (r *InMemoryTaskRepository) FindChildren(parentID value_objects.TaskId) ([]*entities.Task, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.tasksByCreation(r.childIndex[string(parentID)])
+}
+
+// FindDescendants retrieves the subtasks of a task at every depth, breadth first, with the children
+// of each task ordered by creation time. The whole subtree is read under one lock from the child index.
+func (r *InMemoryTaskRepository) FindDescendants(rootID value_objects.TaskId) ([]*entities.Task, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	models := r.modelsByCreation(r.childIndex[string(rootID)])
+	for i := 0; i < len(models); i++ {
+		models = append(models, r.modelsByCreation(r.childIndex[models[i].ID])...)
+	}
+	return toDomain(models)
+}
+
+// FindDependents retrieves the tasks blocked by the given task, ordered by creation time.
+func (r *InMemoryTaskRepository) FindDependents(blockerID value_objects.TaskId) ([]*entities.Task, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.tasksByCreation(r.dependentIndex[string(blockerID)])
+}
+
+// FindByProject retrieves the tasks of a project, ordered by creation time.
+func (r *InMemoryTaskRepository) FindByProject(projectID value_objects.ProjectId) ([]*entities.Task, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.tasksByCreation(r.projectIndex[string(projectID)])
+}
+
+// CountByProject returns the number of tasks of a project.
+func (r *InMemoryTaskRepository) CountByProject(projectID value_objects.ProjectId) (int, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return len(r.projectIndex[string(projectID)]), nil
+}
+
+// FindMatching retrieves the tasks satisfying the filter, ordered by creation time.
+// The filter is executed against the indexes first: tag and project conditions, and the AND and OR
+// of such conditions, narrow the candidates, which are then checked with entities.Task.Matches.
+// Filters without an indexed condition check every task.
+func (r *InMemoryTaskRepository) FindMatching(filter value_objects.TaskFilter) ([]*entities.Task, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	candidates, narrowed := r.candidates(filter)
+	if !narrowed {
+		candidates = make(map[string]struct{}, len(r.tasks))
+		for id := range r.tasks {
+			candidates[id] = struct{}{}
+		}
+	}
+	ids := make(map[string]struct{})
+	for id := range candidates {
+		task, err := r.tasks[id].ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		if task.Matches(filter) {
+			ids[id] = struct{}{}
+		}
+	}
+	return r.tasksByCreation(ids)
+}
+
+// candidates returns the IDs of the tasks the indexes allow to match the filter, a superset of the
+// matching tasks, and false when the indexes cannot narrow the filter down. Callers must hold the lock.
+func (r *InMemoryTaskRepository) candidates(filter value_objects.TaskFilter) (map[string]struct{}, bool) {
+	switch {
+	case filter.Condition != nil:
+		c := filter.Condition
+		if c.Op != value_objects.OpMatch && c.Op != value_objects.OpEqual {
+			return nil, false
+		}
+		switch {
+		case c.Field == value_objects.FilterTag:
+			return r.tagIndex[c.Value], true
+		case c.Field == value_objects.FilterProject && c.Value != "":
+			return r.projectIndex[c.Value], true
+		}
+	case len(filter.And) > 0:
+		var result map[string]struct{}
+		narrowed := false
+		for _, operand := range filter.And {
+			ids, ok := r.candidates(operand)
+			if !ok {
+				continue
+			}
+			if !narrowed {
+				result, narrowed = ids, true
+				continue
+			}
+			intersection := make(map[string]struct{})
+			for id := range result {
+				if _, in := ids[id]; in {
+					intersection[id] = struct{}{}
+				}
+			}
+			result = intersection
+		}
+		return result, narrowed
+	case len(filter.Or) > 0:
+		result := make(map[string]struct{})
+		for _, operand := range filter.Or {
+			ids, ok := r.candidates(operand)
+			if !ok {
+				return nil, false
+			}
+			for id := range ids {
+				result[id] = struct{}{}
+			}
+		}
+		return result, true
+	}
+	return nil, false
+}
+
+// Search finds active tasks by the words of their titles and descriptions, best match first.
+func (r *InMemoryTaskRepository) Search(query string, limit int) ([]ports.TaskSearchHit, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	var hits []ports.TaskSearchHit
+	for _, hit := range r.searchIndex.Search(query, limit) {
+		hits = append(hits, ports.TaskSearchHit{
+			TaskID:  value_objects.TaskId(hit.ID),
+			Score:   hit.Score,
+			Title:   hit.Title,
+			Snippet: hit.Snippet,
+		})
+	}
+	return hits, nil
+}
+
+// IsBlobReferenced reports whether any task other than except has an attachment stored under digest.
+func (r *InMemoryTaskRepository) IsBlobReferenced(digest string, except value_objects.TaskId) (bool, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	for id := range r.blobIndex[digest] {
+		if id != string(except) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MoveToTrash removes the task from the active tasks and keeps its trashed version.
+func (r *InMemoryTaskRepository) MoveToTrash(task *entities.Task) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.shelve(r.trash, task)
+}
+
+// FindTrashed retrieves the trashed tasks, most recently deleted first.
+func (r *InMemoryTaskRepository) FindTrashed() ([]*entities.Task, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return shelved(r.trash, func(m *persistence.TaskModel) *time.Time { return m.DeletedAt })
+}
+
+// FindTrashedById retrieves a task from the trash.
+func (r *InMemoryTaskRepository) FindTrashedById(id value_objects.TaskId) (*entities.Task, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	model, exists := r.trash[string(id)]
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return model.ToDomain()
+}
+
+// Restore takes the task out of the trash and saves it as an active task.
+func (r *InMemoryTaskRepository) Restore(task *entities.Task) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if err := r.unshelve(r.trash, string(task.ID)); err != nil {
+		return err
+	}
+	return r.save(task)
+}
+
+// Purge permanently deletes a task from the trash.
+func (r *InMemoryTaskRepository) Purge(id value_objects.TaskId) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.unshelve(r.trash, string(id))
+}
+
+// MoveToArchive removes the task from the active tasks and keeps its archived version.
+func (r *InMemoryTaskRepository) MoveToArchive(task *entities.Task) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.shelve(r.archive, task)
+}
+
+// FindArchived retrieves the archived tasks, most recently archived first.
+func (r *InMemoryTaskRepository) FindArchived() ([]*entities.Task, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return shelved(r.archive, func(m *persistence.TaskModel) *time.Time { return m.ArchivedAt })
+}
+
+// FindArchivedById retrieves a task from the archive.
+func (r *InMemoryTaskRepository) FindArchivedById(id value_objects.TaskId) (*entities.Task, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	model, exists := r.archive[string(id)]
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return model.ToDomain()
+}
+
+// shelve moves an active task into the trash or the archive, keeping only its blob references
+// indexed. Callers must hold the write lock.
+func (r *InMemoryTaskRepository) shelve(shelf map[string]*persistence.TaskModel, task *entities.Task) error {
+	previous, exists := r.tasks[string(task.ID)]
+	if !exists {
+		return ErrNotFound
+	}
+	r.touch(previous.ID)
+	r.unindex(previous)
+	delete(r.tasks, previous.ID)
+	r.publish(entities.TaskRemoved, previous.ID, nil)
+	model := persistence.FromDomain(task)
+	shelf[model.ID] = model
+	for _, attachment := range model.Attachments {
+		addToIndex(r.blobIndex, attachment.Digest, model.ID)
+	}
+	return nil
+}
+
+// unshelve drops a task from the trash or the archive together with its blob references.
+// Callers must hold the write lock.
+func (r *InMemoryTaskRepository) unshelve(shelf map[string]*persistence.TaskModel, id string) error {
+	model, exists := shelf[id]
+	if !exists {
+		return ErrNotFound
+	}
+	r.touch(id)
+	for _, attachment := range model.Attachments {
+		removeFromIndex(r.blobIndex, attachment.Digest, id)
+	}
+	delete(shelf, id)
+	return nil
+}
+
+// shelved converts the models of the trash or the archive to entities, most recent first by the given time.
+func shelved(shelf map[string]*persistence.TaskModel, at func(*persistence.TaskModel) *time.Time) ([]*entities.Task, error) {
+	models := make([]*persistence.TaskModel, 0, len(shelf))
+	for _, model := range shelf {
+		models = append(models, model)
+	}
+	sort.Slice(models, func(i, j int) bool {
+		ti, tj := at(models[i]), at(models[j])
+		if ti.Equal(*tj) {
+			return models[i].ID < models[j].ID
+		}
+		return ti.After(*tj)
+	})
+	return toDomain(models)
+}
+
+// tasksByCreation converts the models with the given IDs to entities ordered by creation time.
+// Callers must hold the lock.
+func (r *InMemoryTaskRepository) tasksByCreation(ids map[string]struct{}) ([]*entities.Task, error) {
+	return toDomain(r.modelsByCreation(ids))
+}
+
+// modelsByCreation returns the models with the given IDs ordered by creation time.
+// Callers must hold the lock.
+func (r *InMemoryTaskRepository) modelsByCreation(ids map[string]struct{}) []*persistence.TaskModel {
+	models := make([]*persistence.TaskModel, 0, len(ids))
+	for id := range ids {
+		models = append(models, r.tasks[id])
+	}
+	sort.Slice(models, func(i, j int) bool {
+		if models[i].CreatedAt.Equal(models[j].CreatedAt) {
+			return models[i].ID < models[j].ID
+		}
+		return models[i].CreatedAt.Before(models[j].CreatedAt)
+	})
+	return models
+}
+
+// CountTags returns the number of tasks carrying each tag.
+func (r *InMemoryTaskRepository) CountTags() (map[value_objects.Tag]int, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	counts := make(map[value_objects.Tag]int, len(r.tagIndex))
+	for tag, ids := range r.tagIndex {
+		counts[value_objects.Tag(tag)] = len(ids)
+	}
+	return counts, nil
+}
+
+// index adds the model to the secondary indexes. Callers must hold the write lock.
+func (r *InMemoryTaskRepository) index(model *persistence.TaskModel) {
+	for _, tag := range model.Tags {
+		addToIndex(r.tagIndex, tag, model.ID)
+	}
+	if model.ParentID != "" {
+		addToIndex(r.childIndex, model.ParentID, model.ID)
+	}
+	if model.ProjectID != "" {
+		addToIndex(r.projectIndex, model.ProjectID, model.ID)
+	}
+	for _, blockerID := range model.BlockedBy {
+		addToIndex(r.dependentIndex, blockerID, model.ID)
+	}
+	for _, attachment := range model.Attachments {
+		addToIndex(r.blobIndex, attachment.Digest, model.ID)
+	}
+	r.searchIndex.Add(model.ID, model.Title, model.Description)
+}
+
+// unindex removes the model from the secondary indexes. Callers must hold the write lock.
+func (r *InMemoryTaskRepository) unindex(model *persistence.TaskModel) {
+	for _, tag := range model.Tags {
+		removeFromIndex(r.tagIndex, tag, model.ID)
+	}
+	if model.ParentID != "" {
+		removeFromIndex(r.childIndex, model.ParentID, model.ID)
+	}
+	if model.ProjectID != "" {
+		removeFromIndex(r.projectIndex, model.ProjectID, model.ID)
+	}
+	for _, blockerID := range model.BlockedBy {
+		removeFromIndex(r.dependentIndex, blockerID, model.ID)
+	}
+	for _, attachment := range model.Attachments {
+		removeFromIndex(r.blobIndex, attachment.Digest, model.ID)
+	}
+	r.searchIndex.Remove(model.ID)
+}
+
+func addToIndex(index map[string]map[string]struct{}, key, id string) {
+	ids, ok := index[key]
+	if !ok {
+		ids = make(map[string]struct{})
+		index[key] = ids
+	}
+	ids[id] = struct{}{}
+}
+
+func removeFromIndex(index map[string]map[string]struct{}, key, id string) {
+	ids := index[key]
+	delete(ids, id)
+	if len(ids) == 0 {
+		delete(index, key)
+	}
+}
+
+// intersectTags returns the IDs of tasks carrying every tag, starting from the smallest posting set.
+func (r *InMemoryTaskRepository) intersectTags(tags []value_objects.Tag) map[string]struct{} {
+	smallest := r.tagIndex[tags[0].String()]
+	for _, tag := range tags[1:] {
+		if ids := r.tagIndex[tag.String()]; len(ids) < len(smallest) {
+			smallest = ids
+		}
+	}
+	result := make(map[string]struct{}, len(smallest))
+	for id := range smallest {
+		matches := true
+		for _, tag := range tags {
+			if _, ok := r.tagIndex[tag.String()][id]; !ok {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			result[id] = struct{}{}
+		}
+	}
+	return result
+}
+
+// unionTags returns the IDs of tasks carrying at least one of the tags.
+func (r *InMemoryTaskRepository) unionTags(tags []value_objects.Tag) map[string]struct{} {
+	result := make(map[string]struct{})
+	for _, tag := range tags {
+		for id := range r.tagIndex[tag.String()] {
+			result[id] = struct{}{}
+		}
+	}
+	return result
+}
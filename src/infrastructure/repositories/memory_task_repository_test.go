@@ -3,6 +3,7 @@ package repositories
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"clean-architecture-golang/domain/entities"
 	"clean-architecture-golang/domain/value_objects"
@@ -51,3 +52,298 @@ func TestSaveFindDeleteFlow(t *testing.T) {
 		t.Fatalf("expected ErrNotFound after delete, got %v", err)
 	}
 }
+
+func TestTagIndex(t *testing.T) {
+	r := NewInMemoryTaskRepository()
+
+	a, _ := entities.NewTask("a", "")
+	a.AddTags("home", "urgent")
+	b, _ := entities.NewTask("b", "")
+	b.AddTags("home")
+	c, _ := entities.NewTask("c", "")
+	c.AddTags("work")
+	for _, task := range []*entities.Task{a, b, c} {
+		if err := r.Save(task); err != nil {
+			t.Fatalf("save failed: %v", err)
+		}
+	}
+
+	all, _ := r.FindByTags([]value_objects.Tag{"home", "urgent"}, true)
+	if len(all) != 1 || all[0].ID != a.ID {
+		t.Fatalf("expected only task a for home AND urgent, got %v", all)
+	}
+	either, _ := r.FindByTags([]value_objects.Tag{"urgent", "work"}, false)
+	if len(either) != 2 {
+		t.Fatalf("expected 2 tasks for urgent OR work, got %d", len(either))
+	}
+
+	// Re-saving with different tags must update the index.
+	a.RemoveTag("home")
+	r.Save(a)
+	counts, _ := r.CountTags()
+	if counts["home"] != 1 || counts["urgent"] != 1 || counts["work"] != 1 {
+		t.Fatalf("unexpected counts after retag: %v", counts)
+	}
+
+	// Deleting must drop the task from the index, removing unused tags.
+	r.Delete(c.ID)
+	counts, _ = r.CountTags()
+	if _, ok := counts["work"]; ok {
+		t.Fatalf("expected 'work' to disappear after delete, got %v", counts)
+	}
+	none, _ := r.FindByTags([]value_objects.Tag{"work"}, true)
+	if len(none) != 0 {
+		t.Fatalf("expected no tasks tagged work, got %d", len(none))
+	}
+}
+
+func TestFindMatching_NarrowsWithIndexes(t *testing.T) {
+	r := NewInMemoryTaskRepository()
+
+	a, _ := entities.NewTask("a", "")
+	a.AddTags("home")
+	project := value_objects.NewProjectId()
+	a.ProjectID = project
+	b, _ := entities.NewTask("b", "")
+	b.AddTags("home")
+	b.UpdateStatus(value_objects.StatusDoing)
+	c, _ := entities.NewTask("c", "")
+	c.AddTags("work")
+	d, _ := entities.NewTask("d", "")
+	for _, task := range []*entities.Task{a, b, c, d} {
+		if err := r.Save(task); err != nil {
+			t.Fatalf("save failed: %v", err)
+		}
+	}
+
+	cases := map[string][]value_objects.TaskId{
+		"tag:home AND status:todo":                    {a.ID},
+		"tag:home OR tag:work":                        {a.ID, b.ID, c.ID},
+		"tag:work OR status:doing":                    {b.ID, c.ID},
+		"project:" + string(project) + " OR tag:work": {a.ID, c.ID},
+		"NOT tag:home AND project:none":               {c.ID, d.ID},
+		"tag:home AND (tag:work OR title:d)":          nil,
+		"tag:home AND title:b":                        {b.ID},
+	}
+	for query, want := range cases {
+		filter, err := value_objects.ParseTaskFilter(query)
+		if err != nil {
+			t.Fatalf("%q: parse failed: %v", query, err)
+		}
+		got, err := r.FindMatching(filter)
+		if err != nil {
+			t.Fatalf("%q: find failed: %v", query, err)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("%q: expected %v, got %d tasks", query, want, len(got))
+		}
+		for i := range want {
+			if got[i].ID != want[i] {
+				t.Errorf("%q: expected %v in creation order, got %s at %d", query, want, got[i].ID, i)
+			}
+		}
+	}
+}
+
+func TestFindChildren(t *testing.T) {
+	r := NewInMemoryTaskRepository()
+	parent, _ := entities.NewTask("parent", "")
+	child, _ := entities.NewTask("child", "")
+	child.SetParent(parent, nil)
+	r.Save(parent)
+	r.Save(child)
+
+	children, _ := r.FindChildren(parent.ID)
+	if len(children) != 1 || children[0].ID != child.ID {
+		t.Fatalf("expected child to be found, got %v", children)
+	}
+	grandchild, _ := entities.NewTask("grandchild", "")
+	grandchild.SetParent(child, nil)
+	r.Save(grandchild)
+	descendants, _ := r.FindDescendants(parent.ID)
+	if len(descendants) != 2 || descendants[0].ID != child.ID || descendants[1].ID != grandchild.ID {
+		t.Fatalf("expected child and grandchild, got %v", descendants)
+	}
+	r.Delete(grandchild.ID)
+
+	// Moving the child must update the index.
+	child.SetParent(nil, nil)
+	r.Save(child)
+	children, _ = r.FindChildren(parent.ID)
+	if len(children) != 0 {
+		t.Fatalf("expected no children after detaching, got %d", len(children))
+	}
+}
+
+func TestTrash_HidesTasksAndKeepsBlobReferences(t *testing.T) {
+	r := NewInMemoryTaskRepository()
+	older, _ := entities.NewTask("older", "")
+	newer, _ := entities.NewTask("newer", "")
+	newer.AddAttachment("a.txt", "text/plain", 1, "d1")
+	r.Save(older)
+	r.Save(newer)
+
+	older.MoveToTrash(time.Now().Add(-time.Hour))
+	newer.MoveToTrash(time.Now())
+	if err := r.MoveToTrash(older); err != nil {
+		t.Fatalf("move to trash failed: %v", err)
+	}
+	r.MoveToTrash(newer)
+	if err := r.MoveToTrash(newer); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound when trashing twice, got %v", err)
+	}
+
+	if list, _ := r.FindByStatus(value_objects.StatusTodo); len(list) != 0 {
+		t.Errorf("expected trashed tasks to be hidden, got %d", len(list))
+	}
+	trash, _ := r.FindTrashed()
+	if len(trash) != 2 || trash[0].ID != newer.ID || trash[1].ID != older.ID {
+		t.Fatalf("expected most recently deleted first, got %v", trash)
+	}
+	if referenced, _ := r.IsBlobReferenced("d1", ""); !referenced {
+		t.Errorf("expected blob of trashed task to stay referenced")
+	}
+
+	if err := r.Purge(newer.ID); err != nil {
+		t.Fatalf("purge failed: %v", err)
+	}
+	if referenced, _ := r.IsBlobReferenced("d1", ""); referenced {
+		t.Errorf("expected purged task to release its blob")
+	}
+	older.Restore()
+	if err := r.Restore(older); err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if found, err := r.FindById(older.ID); err != nil || found.IsTrashed() {
+		t.Errorf("expected restored task to be active, got %v (err=%v)", found, err)
+	}
+}
+
+func TestSave_RefusesTrashedAndArchivedTasks(t *testing.T) {
+	r := NewInMemoryTaskRepository()
+	trashed, _ := entities.NewTask("trashed", "")
+	archived, _ := entities.NewTask("archived", "")
+	archived.UpdateStatus(value_objects.StatusDone)
+	r.Save(trashed)
+	r.Save(archived)
+	stale, _ := r.FindById(trashed.ID)
+
+	trashed.MoveToTrash(time.Now())
+	r.MoveToTrash(trashed)
+	archived.Archive(time.Now())
+	r.MoveToArchive(archived)
+
+	stale.Title = "renamed"
+	if err := r.Save(stale); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound saving a trashed task, got %v", err)
+	}
+	if err := r.SaveWithinLimits(nil, nil, archived); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound saving an archived task, got %v", err)
+	}
+	if list, _ := r.FindByStatus(value_objects.StatusTodo); len(list) != 0 {
+		t.Errorf("expected no task brought back, got %v", list)
+	}
+	if found, _ := r.FindTrashedById(trashed.ID); found.Title != "trashed" {
+		t.Errorf("expected the trashed task unchanged, got %q", found.Title)
+	}
+}
+
+func TestSearch_FollowsWrites(t *testing.T) {
+	r := NewInMemoryTaskRepository()
+	fence, _ := entities.NewTask("Paint the fence", "")
+	garage, _ := entities.NewTask("Clean the garage", "Move the paint cans")
+	r.Save(fence)
+	r.Save(garage)
+
+	hits, _ := r.Search("paint", 10)
+	if len(hits) != 2 || hits[0].TaskID != fence.ID {
+		t.Fatalf("expected both tasks, title match first, got %+v", hits)
+	}
+
+	fence.Title = "Repair the fence"
+	r.Save(fence)
+	r.MoveToTrash(garage)
+	if hits, _ := r.Search("paint", 10); len(hits) != 0 {
+		t.Errorf("expected renamed and trashed tasks to be gone, got %+v", hits)
+	}
+	if hits, _ := r.Search("repair", 10); len(hits) != 1 {
+		t.Errorf("expected the new title to match, got %+v", hits)
+	}
+
+	garage.Restore()
+	r.Restore(garage)
+	if hits, _ := r.Search("cans", 10); len(hits) != 1 || hits[0].Snippet != "Move the paint <mark>cans</mark>" {
+		t.Errorf("expected the restored task to be searchable, got %+v", hits)
+	}
+	r.Delete(garage.ID)
+	if hits, _ := r.Search("cans", 10); len(hits) != 0 {
+		t.Errorf("expected the deleted task to be gone, got %+v", hits)
+	}
+}
+
+func TestTimeline_ReadsTasksAsTheyWere(t *testing.T) {
+	r := NewInMemoryTaskRepository()
+	start := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	clock := start
+	r.now = func() time.Time { return clock }
+
+	task, _ := entities.NewTask("paint", "")
+	r.Save(task)
+	clock = start.Add(time.Hour)
+	task.UpdateStatus(value_objects.StatusDoing)
+	r.Save(task)
+	clock = start.Add(2 * time.Hour)
+	task.MoveToTrash(clock)
+	r.MoveToTrash(task)
+
+	if _, err := r.FindByIdAsOf(task.ID, start.Add(-time.Second)); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected no task before its creation, got %v", err)
+	}
+	if found, err := r.FindByIdAsOf(task.ID, start.Add(30*time.Minute)); err != nil || found.Status != value_objects.StatusTodo {
+		t.Errorf("expected the task in todo, got %+v %v", found, err)
+	}
+	if doing, _ := r.FindByStatusAsOf(value_objects.StatusDoing, start.Add(time.Hour)); len(doing) != 1 {
+		t.Errorf("expected the task in doing from the status change on, got %v", doing)
+	}
+	if _, err := r.FindByIdAsOf(task.ID, start.Add(2*time.Hour)); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected the trashed task not to be found, got %v", err)
+	}
+	if doing, _ := r.FindByStatusAsOf(value_objects.StatusDoing, time.Time{}); len(doing) != 0 {
+		t.Errorf("expected a zero time to read the current column, got %v", doing)
+	}
+}
+
+func TestTimeline_CompactVersionsKeepsTheVersionInEffect(t *testing.T) {
+	r := NewInMemoryTaskRepository()
+	start := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	clock := start
+	r.now = func() time.Time { return clock }
+
+	task, _ := entities.NewTask("paint", "")
+	r.Save(task)
+	gone, _ := entities.NewTask("sweep", "")
+	r.Save(gone)
+	clock = start.Add(time.Hour)
+	task.UpdateStatus(value_objects.StatusDoing)
+	r.Save(task)
+	r.Delete(gone.ID)
+	clock = start.Add(3 * time.Hour)
+	task.UpdateStatus(value_objects.StatusDone)
+	r.Save(task)
+
+	if dropped := r.CompactVersions(start.Add(2 * time.Hour)); dropped != 3 {
+		t.Errorf("expected 3 versions dropped, got %d", dropped)
+	}
+	if _, ok := r.versions[string(gone.ID)]; ok {
+		t.Error("expected the deleted task to be forgotten")
+	}
+	if _, err := r.FindByIdAsOf(task.ID, start.Add(30*time.Minute)); !errors.Is(err, ErrHistoryTrimmed) {
+		t.Errorf("expected ErrHistoryTrimmed before the cutoff, got %v", err)
+	}
+	if found, err := r.FindByIdAsOf(task.ID, start.Add(2*time.Hour)); err != nil || found.Status != value_objects.StatusDoing {
+		t.Errorf("expected the task in doing at the cutoff, got %+v %v", found, err)
+	}
+	if done, _ := r.FindByStatusAsOf(value_objects.StatusDone, start.Add(3*time.Hour)); len(done) != 1 {
+		t.Errorf("expected the task in done after the cutoff, got %v", done)
+	}
+}
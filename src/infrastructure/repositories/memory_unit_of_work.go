@@ -0,0 +1,258 @@
+package repositories
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/infrastructure/persistence"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultUndoWindow is how long undo tokens stay valid by default.
+const DefaultUndoWindow = 5 * time.Minute
+
+// Sentinel errors for undo
+var (
+	ErrUndoNotFound = errors.New("undo token not found or expired")
+	ErrUndoConflict = errors.New("cannot undo: the records changed since")
+)
+
+// InMemoryUnitOfWork implements ports.UndoableUnitOfWork over the in-memory task and work log repositories.
+// A unit of work holds the write locks of the repositories while it runs and writes through them,
+// journaling the models of the records it writes as they were before; on rollback the journaled models
+// are put back. Its cost is proportional to the records written, which suits single-task operations.
+// A copy-on-commit unit of work instead works on copies of the state of the repositories, which replace
+// it on commit and are dropped on rollback. Copying takes time proportional to the amount of data, so it
+// is meant for batches of many items, whose rollback then takes a single step.
+// Either way, the journal tells the records an undoable unit of work changed; their models before and
+// after the change are kept for the undo window.
+type InMemoryUnitOfWork struct {
+	tasks        *InMemoryTaskRepository
+	workLog      *InMemoryWorkLogRepository
+	copyOnCommit bool
+	undoWindow   time.Duration
+	undoLog      map[string]*undoEntry
+	undoMutex    sync.Mutex
+}
+
+// Ensure InMemoryUnitOfWork implements ports.UndoableUnitOfWork at compile time.
+var _ ports.UndoableUnitOfWork = (*InMemoryUnitOfWork)(nil)
+
+// taskState is where a task is kept and its model; a zero state means the task does not exist.
+type taskState struct {
+	shelf string
+	model *persistence.TaskModel
+}
+
+// active returns the task of an active state, or nil for a task kept on another shelf or not existing.
+func (s taskState) active() (*entities.Task, error) {
+	if s.shelf != shelfActive {
+		return nil, nil
+	}
+	return s.model.ToDomain()
+}
+
+// Shelves a task can be kept on.
+const (
+	shelfActive  = "active"
+	shelfTrash   = "trash"
+	shelfArchive = "archive"
+)
+
+// undoEntry holds the records changed by a unit of work, before and after the change.
+type undoEntry struct {
+	tasksBefore   map[string]taskState
+	tasksAfter    map[string]taskState
+	entriesBefore map[string]*persistence.WorkEntryModel
+	entriesAfter  map[string]*persistence.WorkEntryModel
+	expiresAt     time.Time
+}
+
+// NewInMemoryUnitOfWork creates a journaling unit of work over the given repositories; workLog may be nil.
+// Undo tokens stay valid for undoWindow.
+func NewInMemoryUnitOfWork(tasks *InMemoryTaskRepository, workLog *InMemoryWorkLogRepository, undoWindow time.Duration) *InMemoryUnitOfWork {
+	return &InMemoryUnitOfWork{tasks: tasks, workLog: workLog, undoWindow: undoWindow, undoLog: make(map[string]*undoEntry)}
+}
+
+// NewInMemoryCopyOnCommitUnitOfWork creates a copy-on-commit unit of work over the given repositories;
+// workLog may be nil. Undo tokens stay valid for undoWindow.
+func NewInMemoryCopyOnCommitUnitOfWork(tasks *InMemoryTaskRepository, workLog *InMemoryWorkLogRepository, undoWindow time.Duration) *InMemoryUnitOfWork {
+	uow := NewInMemoryUnitOfWork(tasks, workLog, undoWindow)
+	uow.copyOnCommit = true
+	return uow
+}
+
+// Do runs fn and commits its writes when it succeeds.
+func (u *InMemoryUnitOfWork) Do(fn func(stores ports.Stores) error) error {
+	return u.run(func(tasks *InMemoryTaskRepository, workLog *InMemoryWorkLogRepository) error {
+		return fn(stores(tasks, workLog))
+	}, nil)
+}
+
+// DoUndoable runs fn like Do and records the changes it commits.
+func (u *InMemoryUnitOfWork) DoUndoable(fn func(stores ports.Stores) error, now time.Time) (string, error) {
+	var entry *undoEntry
+	err := u.run(func(tasks *InMemoryTaskRepository, workLog *InMemoryWorkLogRepository) error {
+		return fn(stores(tasks, workLog))
+	}, &entry)
+	if err != nil || entry == nil {
+		return "", err
+	}
+	return u.record(entry, now), nil
+}
+
+// Undo puts back the records changed under token, provided they still hold the models the change left,
+// and has check vet the result before committing it.
+func (u *InMemoryUnitOfWork) Undo(token string, now time.Time, check func(stores ports.Stores, reverted []ports.RevertedTask) error) (string, error) {
+	u.undoMutex.Lock()
+	entry, exists := u.undoLog[token]
+	if exists && !now.Before(entry.expiresAt) {
+		delete(u.undoLog, token)
+		exists = false
+	}
+	u.undoMutex.Unlock()
+	if !exists {
+		return "", ErrUndoNotFound
+	}
+	var redo *undoEntry
+	err := u.run(func(tasks *InMemoryTaskRepository, workLog *InMemoryWorkLogRepository) error {
+		for id, after := range entry.tasksAfter {
+			if tasks.stateOf(id) != after {
+				return ErrUndoConflict
+			}
+		}
+		for id, after := range entry.entriesAfter {
+			if workLog == nil || workLog.entries[id] != after {
+				return ErrUndoConflict
+			}
+		}
+		for id, before := range entry.tasksBefore {
+			tasks.put(id, before)
+		}
+		for id, before := range entry.entriesBefore {
+			workLog.put(id, before)
+		}
+		if check == nil {
+			return nil
+		}
+		reverted := make([]ports.RevertedTask, 0, len(entry.tasksBefore))
+		for id, before := range entry.tasksBefore {
+			current, err := entry.tasksAfter[id].active()
+			if err != nil {
+				return err
+			}
+			restored, err := before.active()
+			if err != nil {
+				return err
+			}
+			reverted = append(reverted, ports.RevertedTask{Current: current, Restored: restored})
+		}
+		return check(stores(tasks, workLog), reverted)
+	}, &redo)
+	if err != nil {
+		return "", err
+	}
+	u.undoMutex.Lock()
+	delete(u.undoLog, token)
+	u.undoMutex.Unlock()
+	if redo == nil {
+		return "", nil
+	}
+	return u.record(redo, now), nil
+}
+
+// run locks the repositories, always the task repository first, runs fn on journaled repositories or
+// copies of them, and commits its writes when fn succeeds; otherwise, or if fn panics, they are rolled
+// back. When changes is not nil, it receives the changed records, or nil if nothing changed.
+func (u *InMemoryUnitOfWork) run(fn func(tasks *InMemoryTaskRepository, workLog *InMemoryWorkLogRepository) error, changes **undoEntry) error {
+	u.tasks.mutex.Lock()
+	defer u.tasks.mutex.Unlock()
+	tasks := u.tasks.journaled()
+	if u.copyOnCommit {
+		tasks = u.tasks.clone()
+	}
+	var workLog *InMemoryWorkLogRepository
+	if u.workLog != nil {
+		u.workLog.mutex.Lock()
+		defer u.workLog.mutex.Unlock()
+		workLog = u.workLog.journaled()
+		if u.copyOnCommit {
+			workLog = u.workLog.clone()
+		}
+	}
+	committed := false
+	defer func() {
+		if committed || u.copyOnCommit {
+			return
+		}
+		tasks.rollback()
+		if workLog != nil {
+			workLog.rollback()
+		}
+	}()
+	if err := fn(tasks, workLog); err != nil {
+		return err
+	}
+	if changes != nil {
+		*changes = changesOf(tasks, workLog)
+	}
+	u.tasks.adopt(tasks)
+	if workLog != nil {
+		u.workLog.adopt(workLog)
+	}
+	committed = true
+	return nil
+}
+
+// changesOf returns the records written through the repositories of a unit of work whose model
+// differs from the journaled one, or nil if there are none. Callers must hold the locks.
+func changesOf(tasks *InMemoryTaskRepository, workLog *InMemoryWorkLogRepository) *undoEntry {
+	entry := &undoEntry{
+		tasksBefore:   make(map[string]taskState),
+		tasksAfter:    make(map[string]taskState),
+		entriesBefore: make(map[string]*persistence.WorkEntryModel),
+		entriesAfter:  make(map[string]*persistence.WorkEntryModel),
+	}
+	for id, before := range tasks.journal.states {
+		if after := tasks.stateOf(id); before != after {
+			entry.tasksBefore[id], entry.tasksAfter[id] = before, after
+		}
+	}
+	if workLog != nil {
+		for id, before := range workLog.journal {
+			if after := workLog.entries[id]; before != after {
+				entry.entriesBefore[id], entry.entriesAfter[id] = before, after
+			}
+		}
+	}
+	if len(entry.tasksAfter) == 0 && len(entry.entriesAfter) == 0 {
+		return nil
+	}
+	return entry
+}
+
+// record keeps the entry for the undo window under a new token, dropping expired entries.
+func (u *InMemoryUnitOfWork) record(entry *undoEntry, now time.Time) string {
+	u.undoMutex.Lock()
+	defer u.undoMutex.Unlock()
+	for token, e := range u.undoLog {
+		if !now.Before(e.expiresAt) {
+			delete(u.undoLog, token)
+		}
+	}
+	token := uuid.New().String()
+	entry.expiresAt = now.Add(u.undoWindow)
+	u.undoLog[token] = entry
+	return token
+}
+
+func stores(tasks *InMemoryTaskRepository, workLog *InMemoryWorkLogRepository) ports.Stores {
+	s := ports.Stores{Tasks: tasks}
+	if workLog != nil {
+		s.WorkLog = workLog
+	}
+	return s
+}
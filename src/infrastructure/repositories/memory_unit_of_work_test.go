@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+)
+
+func TestUnitOfWork_CommitsOrRollsBackAcrossRepositories(t *testing.T) {
+	for name, newUnitOfWork := range map[string]func(*InMemoryTaskRepository, *InMemoryWorkLogRepository, time.Duration) *InMemoryUnitOfWork{
+		"journaling":     NewInMemoryUnitOfWork,
+		"copy-on-commit": NewInMemoryCopyOnCommitUnitOfWork,
+	} {
+		t.Run(name, func(t *testing.T) {
+			testUnitOfWorkCommitsOrRollsBack(t, newUnitOfWork)
+		})
+	}
+}
+
+func testUnitOfWorkCommitsOrRollsBack(t *testing.T, newUnitOfWork func(*InMemoryTaskRepository, *InMemoryWorkLogRepository, time.Duration) *InMemoryUnitOfWork) {
+	tasks := NewInMemoryTaskRepository()
+	workLog := NewInMemoryWorkLogRepository()
+	uow := newUnitOfWork(tasks, workLog, DefaultUndoWindow)
+	kept, _ := entities.NewTask("kept", "")
+	tasks.Save(kept)
+
+	added, _ := entities.NewTask("added", "")
+	added.AddTags("home")
+	timer, _ := entities.StartTimer(added.ID, "alice", time.Now())
+	failure := errors.New("boom")
+	err := uow.Do(func(stores ports.Stores) error {
+		stores.Tasks.Save(added)
+		stores.Tasks.Delete(kept.ID)
+		stores.WorkLog.Save(timer)
+		if _, err := stores.Tasks.FindById(added.ID); err != nil {
+			t.Errorf("expected the unit of work to see its own writes, got %v", err)
+		}
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("expected the error of fn, got %v", err)
+	}
+	if _, err := tasks.FindById(kept.ID); err != nil {
+		t.Errorf("expected the rolled back delete to leave the task, got %v", err)
+	}
+	if _, err := tasks.FindById(added.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected the rolled back save to leave no task, got %v", err)
+	}
+	if running, _ := workLog.FindRunning("alice"); running != nil {
+		t.Errorf("expected the rolled back timer to be gone, got %+v", running)
+	}
+	if events, _ := tasks.EventsSince(0, 0); len(events) != 1 {
+		t.Errorf("expected the rolled back writes to leave no events, got %+v", events)
+	}
+	if _, err := tasks.FindByIdAsOf(added.ID, time.Now()); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected the rolled back save to leave no version, got %v", err)
+	}
+	func() {
+		defer func() { recover() }()
+		uow.Do(func(stores ports.Stores) error {
+			stores.Tasks.Save(added)
+			panic(failure)
+		})
+	}()
+	if _, err := tasks.FindById(added.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a panicking unit of work to be rolled back, got %v", err)
+	}
+
+	err = uow.Do(func(stores ports.Stores) error {
+		stores.Tasks.Save(added)
+		if err := stores.WorkLog.Save(timer); err != nil {
+			return err
+		}
+		return stores.Tasks.MoveToTrash(kept)
+	})
+	if err != nil {
+		t.Fatalf("unit of work failed: %v", err)
+	}
+	if tagged, _ := tasks.FindByTags([]value_objects.Tag{"home"}, true); len(tagged) != 1 {
+		t.Errorf("expected the committed task to be indexed, got %v", tagged)
+	}
+	if hits, _ := tasks.Search("added", 10); len(hits) != 1 {
+		t.Errorf("expected the committed task to be searchable, got %+v", hits)
+	}
+	if trash, _ := tasks.FindTrashed(); len(trash) != 1 || trash[0].ID != kept.ID {
+		t.Errorf("expected the committed move to the trash, got %v", trash)
+	}
+	if running, _ := workLog.FindRunning("alice"); running == nil || running.TaskID != added.ID {
+		t.Errorf("expected the committed timer, got %+v", running)
+	}
+}
+
+func TestUnitOfWork_UndoRedoAndConflicts(t *testing.T) {
+	tasks := NewInMemoryTaskRepository()
+	uow := NewInMemoryUnitOfWork(tasks, nil, time.Minute)
+	now := time.Now()
+	task, _ := entities.NewTask("paint", "")
+	task.AddTags("home")
+	tasks.Save(task)
+
+	token, err := uow.DoUndoable(func(stores ports.Stores) error {
+		return stores.Tasks.MoveToTrash(task)
+	}, now)
+	if err != nil || token == "" {
+		t.Fatalf("expected an undo token, got %q %v", token, err)
+	}
+	redo, err := uow.Undo(token, now, nil)
+	if err != nil || redo == "" {
+		t.Fatalf("expected the undo to succeed with a redo token, got %q %v", redo, err)
+	}
+	if tagged, _ := tasks.FindByTags([]value_objects.Tag{"home"}, true); len(tagged) != 1 {
+		t.Errorf("expected the restored task to be indexed, got %v", tagged)
+	}
+	if _, err := uow.Undo(token, now, nil); !errors.Is(err, ErrUndoNotFound) {
+		t.Errorf("expected a used token to be gone, got %v", err)
+	}
+	if _, err := uow.Undo(redo, now, nil); err != nil {
+		t.Fatalf("expected the redo to succeed, got %v", err)
+	}
+	if trash, _ := tasks.FindTrashed(); len(trash) != 1 {
+		t.Errorf("expected the redo to trash the task again, got %v", trash)
+	}
+
+	// A change nothing commits yields no token.
+	if token, err := uow.DoUndoable(func(ports.Stores) error { return nil }, now); err != nil || token != "" {
+		t.Errorf("expected no token for an empty change, got %q %v", token, err)
+	}
+
+	other, _ := entities.NewTask("mow", "")
+	tasks.Save(other)
+	token, _ = uow.DoUndoable(func(stores ports.Stores) error {
+		other.AddTags("garden")
+		return stores.Tasks.Save(other)
+	}, now)
+	other.AddTags("weekend")
+	tasks.Save(other)
+	if _, err := uow.Undo(token, now, nil); !errors.Is(err, ErrUndoConflict) {
+		t.Errorf("expected a conflict after a later change, got %v", err)
+	}
+
+	token, _ = uow.DoUndoable(func(stores ports.Stores) error {
+		return stores.Tasks.Delete(other.ID)
+	}, now)
+	if _, err := uow.Undo(token, now.Add(time.Minute), nil); !errors.Is(err, ErrUndoNotFound) {
+		t.Errorf("expected the token to expire with the undo window, got %v", err)
+	}
+}
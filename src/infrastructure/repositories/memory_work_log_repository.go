@@ -0,0 +1,176 @@
+package repositories
+
+import (
+	"clean-architecture-golang/application/ports"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/persistence"
+	"sort"
+	"sync"
+)
+
+// InMemoryWorkLogRepository implements ports.WorkLogRepository.
+// Entries are indexed by task and by user; the overlap rule is checked under the write lock.
+type InMemoryWorkLogRepository struct {
+	entries map[string]*persistence.WorkEntryModel
+	byTask  map[string]map[string]struct{}
+	byUser  map[string]map[string]struct{}
+	// journal holds the entries written through a repository of a unit of work as they were before
+	// the first write, nil for entries that did not exist.
+	journal map[string]*persistence.WorkEntryModel
+	mutex   sync.RWMutex
+}
+
+// Ensure InMemoryWorkLogRepository implements ports.WorkLogRepository at compile time.
+var _ ports.WorkLogRepository = (*InMemoryWorkLogRepository)(nil)
+
+// NewInMemoryWorkLogRepository creates a new instance of InMemoryWorkLogRepository.
+func NewInMemoryWorkLogRepository() *InMemoryWorkLogRepository {
+	return &InMemoryWorkLogRepository{
+		entries: make(map[string]*persistence.WorkEntryModel),
+		byTask:  make(map[string]map[string]struct{}),
+		byUser:  make(map[string]map[string]struct{}),
+	}
+}
+
+// clone copies the repository; entry models are shared as they are replaced on every write.
+// Callers must hold the lock.
+func (r *InMemoryWorkLogRepository) clone() *InMemoryWorkLogRepository {
+	entries := make(map[string]*persistence.WorkEntryModel, len(r.entries))
+	for id, model := range r.entries {
+		entries[id] = model
+	}
+	return &InMemoryWorkLogRepository{entries: entries, byTask: cloneIndex(r.byTask), byUser: cloneIndex(r.byUser),
+		journal: make(map[string]*persistence.WorkEntryModel)}
+}
+
+// journaled returns a repository sharing the state of r whose writes are recorded in a journal, so
+// that rollback can put the written entries back. Callers must hold the write lock of r for as long
+// as it is used.
+func (r *InMemoryWorkLogRepository) journaled() *InMemoryWorkLogRepository {
+	return &InMemoryWorkLogRepository{entries: r.entries, byTask: r.byTask, byUser: r.byUser,
+		journal: make(map[string]*persistence.WorkEntryModel)}
+}
+
+// rollback puts back the entries recorded in the journal. Callers must hold the write lock of the
+// repository it was created from.
+func (r *InMemoryWorkLogRepository) rollback() {
+	journal := r.journal
+	r.journal = nil
+	for id, model := range journal {
+		r.put(id, model)
+	}
+}
+
+// touch records the entry in the journal before its first write. Callers must hold the write lock.
+func (r *InMemoryWorkLogRepository) touch(id string) {
+	if r.journal == nil {
+		return
+	}
+	if _, recorded := r.journal[id]; !recorded {
+		r.journal[id] = r.entries[id]
+	}
+}
+
+// adopt replaces the state of the repository with the state of a clone.
+// Callers must hold the write lock; the clone must not be used afterwards.
+func (r *InMemoryWorkLogRepository) adopt(clone *InMemoryWorkLogRepository) {
+	r.entries, r.byTask, r.byUser = clone.entries, clone.byTask, clone.byUser
+}
+
+// put replaces an entry with the given model, which removes it when model is nil, keeping the indexes
+// in step. Unlike Save it does not check overlaps. Callers must hold the write lock.
+func (r *InMemoryWorkLogRepository) put(id string, model *persistence.WorkEntryModel) {
+	r.touch(id)
+	if current, exists := r.entries[id]; exists {
+		removeFromIndex(r.byTask, current.TaskID, id)
+		removeFromIndex(r.byUser, current.User, id)
+		delete(r.entries, id)
+	}
+	if model != nil {
+		r.entries[id] = model
+		addToIndex(r.byTask, model.TaskID, id)
+		addToIndex(r.byUser, model.User, id)
+	}
+}
+
+// Save stores an entry unless it overlaps another entry of the same user.
+func (r *InMemoryWorkLogRepository) Save(entry *entities.WorkEntry) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if err := entities.CheckNoOverlap(entry, r.entriesByStart(r.byUser[entry.User])); err != nil {
+		return err
+	}
+	model := persistence.WorkEntryFromDomain(entry)
+	r.touch(model.ID)
+	r.entries[model.ID] = model
+	addToIndex(r.byTask, model.TaskID, model.ID)
+	addToIndex(r.byUser, model.User, model.ID)
+	return nil
+}
+
+// FindRunning returns the running timer of a user, or nil if there is none.
+func (r *InMemoryWorkLogRepository) FindRunning(user string) (*entities.WorkEntry, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	for id := range r.byUser[user] {
+		if model := r.entries[id]; model.End == nil {
+			return model.ToDomain(), nil
+		}
+	}
+	return nil, nil
+}
+
+// FindByTask returns the entries of a task ordered by start time.
+func (r *InMemoryWorkLogRepository) FindByTask(taskID value_objects.TaskId) ([]*entities.WorkEntry, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.entriesByStart(r.byTask[string(taskID)]), nil
+}
+
+// FindByUser returns the entries of a user ordered by start time; an empty user returns every entry.
+func (r *InMemoryWorkLogRepository) FindByUser(user string) ([]*entities.WorkEntry, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if user != "" {
+		return r.entriesByStart(r.byUser[user]), nil
+	}
+	all := make(map[string]struct{}, len(r.entries))
+	for id := range r.entries {
+		all[id] = struct{}{}
+	}
+	return r.entriesByStart(all), nil
+}
+
+// DeleteByTask removes every entry of a task.
+func (r *InMemoryWorkLogRepository) DeleteByTask(taskID value_objects.TaskId) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for id := range r.byTask[string(taskID)] {
+		r.touch(id)
+		removeFromIndex(r.byUser, r.entries[id].User, id)
+		delete(r.entries, id)
+	}
+	delete(r.byTask, string(taskID))
+	return nil
+}
+
+// entriesByStart converts the models with the given IDs to entities ordered by start time.
+// Callers must hold the lock.
+func (r *InMemoryWorkLogRepository) entriesByStart(ids map[string]struct{}) []*entities.WorkEntry {
+	models := make([]*persistence.WorkEntryModel, 0, len(ids))
+	for id := range ids {
+		models = append(models, r.entries[id])
+	}
+	sort.Slice(models, func(i, j int) bool {
+		if models[i].Start.Equal(models[j].Start) {
+			return models[i].ID < models[j].ID
+		}
+		return models[i].Start.Before(models[j].Start)
+	})
+	entries := make([]*entities.WorkEntry, 0, len(models))
+	for _, model := range models {
+		entries = append(entries, model.ToDomain())
+	}
+	return entries
+}
@@ -0,0 +1,35 @@
+// Package scheduler runs background jobs for the application.
+package scheduler
+
+import (
+	"sync"
+	"time"
+)
+
+// Every runs job in a background goroutine once per interval, starting one interval from now.
+// Runs never overlap. The returned stop function ends the schedule and waits for a running job
+// to finish; it is safe to call more than once.
+func Every(interval time.Duration, job func()) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		for {
+			select {
+			case <-ticker.C:
+				job()
+			case <-done:
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ticker.Stop()
+			close(done)
+			<-finished
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package scheduler
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEvery_RunsUntilStopped(t *testing.T) {
+	var runs int32
+	stop := Every(time.Millisecond, func() { atomic.AddInt32(&runs, 1) })
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(&runs) < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	stop()
+	stop()
+	after := atomic.LoadInt32(&runs)
+	if after < 3 {
+		t.Fatalf("Expected at least 3 runs, got %d", after)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if got := atomic.LoadInt32(&runs); got != after {
+		t.Errorf("Expected no runs after stop, got %d more", got-after)
+	}
+}
@@ -0,0 +1,82 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// SnippetLength is the maximum number of characters of a description excerpt, not counting markup.
+const SnippetLength = 160
+
+// snippetContext is the number of words kept before the first match of an excerpt.
+const snippetContext = 5
+
+// Highlight returns text as HTML-escaped markup with the words normalizing to one of terms
+// wrapped in <mark> tags.
+func Highlight(text string, terms map[string]bool) string {
+	return highlightRange(text, Tokenize(text), terms, 0, len(text))
+}
+
+// Snippet returns an excerpt of text of at most SnippetLength characters, starting a few words
+// before the first word normalizing to one of terms, highlighted like Highlight. Without a match
+// the excerpt starts at the beginning of text. Cut ends are marked with an ellipsis.
+func Snippet(text string, terms map[string]bool) string {
+	tokens := Tokenize(text)
+	if len(tokens) == 0 {
+		return ""
+	}
+	first := 0
+	for i, token := range tokens {
+		if terms[token.Term] {
+			first = i
+			break
+		}
+	}
+	start := 0
+	if first > snippetContext {
+		start = tokens[first-snippetContext].Start
+	}
+	end := len(text)
+	if utf8.RuneCountInString(text[start:]) > SnippetLength {
+		end = start
+		for count := 0; count < SnippetLength; count++ {
+			_, size := utf8.DecodeRuneInString(text[end:])
+			end += size
+		}
+		// Cut before a word that does not fit, unless it is the first word of the excerpt.
+		for _, token := range tokens {
+			if token.Start < end && token.End > end && token.Start > start {
+				end = token.Start
+				break
+			}
+		}
+	}
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	b.WriteString(highlightRange(text, tokens, terms, start, end))
+	if end < len(text) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+// highlightRange highlights the part of text between the byte offsets start and end.
+func highlightRange(text string, tokens []Token, terms map[string]bool, start, end int) string {
+	var b strings.Builder
+	pos := start
+	for _, token := range tokens {
+		if token.Start < start || token.End > end || !terms[token.Term] {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[pos:token.Start]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(text[token.Start:token.End]))
+		b.WriteString("</mark>")
+		pos = token.End
+	}
+	b.WriteString(html.EscapeString(text[pos:end]))
+	return strings.TrimSpace(b.String())
+}
@@ -0,0 +1,217 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// BM25 parameters: bm25K1 controls term frequency saturation and bm25B the length normalization.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// titleWeight is the number of times a word of the title counts compared to a word of the description.
+const titleWeight = 2
+
+// prefixWeight scales the score of a query word that only matches the beginning of an indexed word.
+const prefixWeight = 0.5
+
+// Hit is a document matching a query.
+type Hit struct {
+	ID    string
+	Score float64
+	// Title is the highlighted title and Snippet a highlighted excerpt of the description;
+	// see Highlight and Snippet.
+	Title   string
+	Snippet string
+}
+
+type document struct {
+	title       string
+	description string
+	// terms holds the weighted frequency of each term and length their sum.
+	terms  map[string]int
+	length int
+}
+
+// InvertedIndex maps the words of document titles and descriptions to the documents containing them
+// and ranks matches with BM25. Every word of a query must match, either exactly or as the beginning
+// of an indexed word. InvertedIndex is not safe for concurrent use.
+type InvertedIndex struct {
+	docs     map[string]*document
+	postings map[string]map[string]struct{}
+	// terms holds the keys of postings in sorted order for prefix lookups.
+	terms       []string
+	totalLength int
+}
+
+// NewInvertedIndex creates an empty index.
+func NewInvertedIndex() *InvertedIndex {
+	return &InvertedIndex{
+		docs:     make(map[string]*document),
+		postings: make(map[string]map[string]struct{}),
+	}
+}
+
+// Clone returns an independent copy of the index.
+func (ix *InvertedIndex) Clone() *InvertedIndex {
+	clone := &InvertedIndex{
+		docs:        make(map[string]*document, len(ix.docs)),
+		postings:    make(map[string]map[string]struct{}, len(ix.postings)),
+		terms:       append([]string(nil), ix.terms...),
+		totalLength: ix.totalLength,
+	}
+	// Documents are never changed once added, so they can be shared.
+	for id, doc := range ix.docs {
+		clone.docs[id] = doc
+	}
+	for term, ids := range ix.postings {
+		clone.postings[term] = make(map[string]struct{}, len(ids))
+		for id := range ids {
+			clone.postings[term][id] = struct{}{}
+		}
+	}
+	return clone
+}
+
+// Len returns the number of indexed documents.
+func (ix *InvertedIndex) Len() int {
+	return len(ix.docs)
+}
+
+// Add indexes a document, replacing any previous version with the same ID.
+func (ix *InvertedIndex) Add(id, title, description string) {
+	ix.Remove(id)
+	doc := &document{title: title, description: description, terms: make(map[string]int)}
+	for _, token := range Tokenize(title) {
+		doc.terms[token.Term] += titleWeight
+		doc.length += titleWeight
+	}
+	for _, token := range Tokenize(description) {
+		doc.terms[token.Term]++
+		doc.length++
+	}
+	for term := range doc.terms {
+		ids, ok := ix.postings[term]
+		if !ok {
+			ids = make(map[string]struct{})
+			ix.postings[term] = ids
+			i := sort.SearchStrings(ix.terms, term)
+			ix.terms = append(ix.terms, "")
+			copy(ix.terms[i+1:], ix.terms[i:])
+			ix.terms[i] = term
+		}
+		ids[id] = struct{}{}
+	}
+	ix.docs[id] = doc
+	ix.totalLength += doc.length
+}
+
+// Remove drops a document from the index. Removing an unknown document is a no-op.
+func (ix *InvertedIndex) Remove(id string) {
+	doc, ok := ix.docs[id]
+	if !ok {
+		return
+	}
+	for term := range doc.terms {
+		ids := ix.postings[term]
+		delete(ids, id)
+		if len(ids) == 0 {
+			delete(ix.postings, term)
+			i := sort.SearchStrings(ix.terms, term)
+			ix.terms = append(ix.terms[:i], ix.terms[i+1:]...)
+		}
+	}
+	delete(ix.docs, id)
+	ix.totalLength -= doc.length
+}
+
+// Search returns the documents matching every word of the query, best match first.
+// Documents with the same score are ordered by ID. A limit of zero or less returns all matches.
+func (ix *InvertedIndex) Search(query string, limit int) []Hit {
+	words := queryTerms(query)
+	if len(words) == 0 || len(ix.docs) == 0 {
+		return nil
+	}
+	var scores map[string]float64
+	matched := make(map[string]map[string]bool)
+	for _, word := range words {
+		wordScores := ix.scoreWord(word, matched)
+		if scores == nil {
+			scores = wordScores
+			continue
+		}
+		for id, score := range scores {
+			if wordScore, ok := wordScores[id]; ok {
+				scores[id] = score + wordScore
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+	hits := make([]Hit, 0, len(scores))
+	for id, score := range scores {
+		hits = append(hits, Hit{ID: id, Score: score})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID < hits[j].ID
+	})
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	for i := range hits {
+		doc := ix.docs[hits[i].ID]
+		hits[i].Title = Highlight(doc.title, matched[hits[i].ID])
+		hits[i].Snippet = Snippet(doc.description, matched[hits[i].ID])
+	}
+	return hits
+}
+
+// scoreWord scores the documents containing a term starting with word. A document matching several
+// such terms keeps its best score. The matching terms are recorded per document in matched.
+func (ix *InvertedIndex) scoreWord(word string, matched map[string]map[string]bool) map[string]float64 {
+	scores := make(map[string]float64)
+	avgLength := float64(ix.totalLength) / float64(len(ix.docs))
+	for i := sort.SearchStrings(ix.terms, word); i < len(ix.terms) && strings.HasPrefix(ix.terms[i], word); i++ {
+		term := ix.terms[i]
+		ids := ix.postings[term]
+		df := float64(len(ids))
+		idf := math.Log(1 + (float64(len(ix.docs))-df+0.5)/(df+0.5))
+		weight := 1.0
+		if term != word {
+			weight = prefixWeight
+		}
+		for id := range ids {
+			doc := ix.docs[id]
+			tf := float64(doc.terms[term])
+			norm := bm25K1 * (1 - bm25B + bm25B*float64(doc.length)/avgLength)
+			score := weight * idf * tf * (bm25K1 + 1) / (tf + norm)
+			if score > scores[id] {
+				scores[id] = score
+			}
+			if matched[id] == nil {
+				matched[id] = make(map[string]bool)
+			}
+			matched[id][term] = true
+		}
+	}
+	return scores
+}
+
+// queryTerms returns the distinct normalized words of a query in order.
+func queryTerms(query string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, token := range Tokenize(query) {
+		if !seen[token.Term] {
+			seen[token.Term] = true
+			terms = append(terms, token.Term)
+		}
+	}
+	return terms
+}
@@ -0,0 +1,113 @@
+package search
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTokenize_FoldsCaseAndDiacritics(t *testing.T) {
+	tokens := Tokenize("Café CRÈME, café & Straße—2024")
+	var terms []string
+	for _, token := range tokens {
+		terms = append(terms, token.Term)
+	}
+	if got := strings.Join(terms, " "); got != "cafe creme cafe strasse 2024" {
+		t.Fatalf("unexpected terms %q", got)
+	}
+	if first := tokens[0]; first.Start != 0 || first.End != len("Café") {
+		t.Errorf("unexpected offsets %+v", first)
+	}
+}
+
+func TestTokenize_FoldsCompatibilityForms(t *testing.T) {
+	var terms []string
+	for _, token := range Tokenize("Ｔａｓｋ Ａ１ ﬁle") {
+		terms = append(terms, token.Term)
+	}
+	if got := strings.Join(terms, " "); got != "task a1 file" {
+		t.Fatalf("unexpected terms %q", got)
+	}
+}
+
+func TestInvertedIndex_FindsVietnameseWithoutDiacritics(t *testing.T) {
+	ix := NewInvertedIndex()
+	ix.Add("1", "Học tiếng Việt", "")
+	hits := ix.Search("hoc tieng viet", 0)
+	if len(hits) != 1 || hits[0].Title != "<mark>Học</mark> <mark>tiếng</mark> <mark>Việt</mark>" {
+		t.Fatalf("expected the Vietnamese title to match, got %+v", hits)
+	}
+}
+
+func TestInvertedIndex_RanksWithBM25(t *testing.T) {
+	ix := NewInvertedIndex()
+	ix.Add("1", "Paint the fence", "Buy paint and brushes first")
+	ix.Add("2", "Clean the garage", "Move the paint cans to the shed")
+	ix.Add("3", "Call the plumber", "")
+
+	hits := ix.Search("paint", 0)
+	if len(hits) != 2 || hits[0].ID != "1" || hits[1].ID != "2" {
+		t.Fatalf("expected the title match first, got %+v", hits)
+	}
+	if hits[0].Score <= hits[1].Score {
+		t.Errorf("expected decreasing scores, got %+v", hits)
+	}
+	if hits[0].Title != "<mark>Paint</mark> the fence" || hits[1].Snippet != "Move the <mark>paint</mark> cans to the shed" {
+		t.Errorf("unexpected highlights %q / %q", hits[0].Title, hits[1].Snippet)
+	}
+
+	// Every word must match.
+	if hits := ix.Search("paint shed", 0); len(hits) != 1 || hits[0].ID != "2" {
+		t.Errorf("expected only the garage, got %+v", hits)
+	}
+	if hits := ix.Search("paint", 1); len(hits) != 1 {
+		t.Errorf("expected the limit to apply, got %+v", hits)
+	}
+	if hits := ix.Search(" ,; ", 0); hits != nil {
+		t.Errorf("expected no hits for a query without words, got %+v", hits)
+	}
+}
+
+func TestInvertedIndex_PrefixMatching(t *testing.T) {
+	ix := NewInvertedIndex()
+	ix.Add("1", "Plumber", "")
+	ix.Add("2", "Plum jam", "")
+
+	hits := ix.Search("plum", 0)
+	if len(hits) != 2 || hits[0].ID != "2" {
+		t.Fatalf("expected the exact match before the prefix match, got %+v", hits)
+	}
+	if hits[1].Title != "<mark>Plumber</mark>" {
+		t.Errorf("expected the expanded word to be highlighted, got %q", hits[1].Title)
+	}
+}
+
+func TestInvertedIndex_UpdateAndRemove(t *testing.T) {
+	ix := NewInvertedIndex()
+	ix.Add("1", "Paint the fence", "")
+	ix.Add("1", "Repair the fence", "")
+	if hits := ix.Search("paint", 0); len(hits) != 0 {
+		t.Errorf("expected the old title to be gone, got %+v", hits)
+	}
+	if hits := ix.Search("repair", 0); len(hits) != 1 {
+		t.Errorf("expected the new title to match, got %+v", hits)
+	}
+	ix.Remove("1")
+	ix.Remove("1")
+	if ix.Len() != 0 || len(ix.terms) != 0 || len(ix.postings) != 0 || ix.totalLength != 0 {
+		t.Errorf("expected an empty index, got %d docs, terms %v", ix.Len(), ix.terms)
+	}
+}
+
+func TestSnippet_CutsAroundFirstMatch(t *testing.T) {
+	text := strings.Repeat("lorem ipsum ", 30) + "the <key> word " + strings.Repeat("dolor sit ", 30)
+	snippet := Snippet(text, map[string]bool{"key": true})
+	if !strings.HasPrefix(snippet, "…lorem ipsum lorem ipsum the") || !strings.HasSuffix(snippet, "…") {
+		t.Fatalf("unexpected snippet %q", snippet)
+	}
+	if !strings.Contains(snippet, "the &lt;<mark>key</mark>&gt; word") {
+		t.Errorf("expected an escaped highlight, got %q", snippet)
+	}
+	if got := Snippet("short text", nil); got != "short text" {
+		t.Errorf("expected the whole short text, got %q", got)
+	}
+}
@@ -0,0 +1,76 @@
+// Package search contains an in-process full-text index for tasks.
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Token is a normalized word of a text together with its byte range in the original text.
+type Token struct {
+	Term  string
+	Start int
+	End   int
+}
+
+// foldings maps the lower-case letters that have no decomposition to their plain spelling.
+var foldings = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'þ': "th", 'ø': "o", 'đ': "d", 'ð': "d", 'ħ': "h", 'ı': "i",
+	'ł': "l", 'ŧ': "t",
+}
+
+// Normalize folds a word for indexing: it is decomposed in compatibility form (NFKD), so that
+// precomposed and decomposed spellings (e.g. "é" and "é") and full-width letters (e.g. "Ａ")
+// match their plain form, combining marks are dropped, and it is lower-cased.
+func Normalize(word string) string {
+	var b strings.Builder
+	for _, r := range norm.NFKD.String(word) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		r = unicode.ToLower(r)
+		if folded, ok := foldings[r]; ok {
+			b.WriteString(folded)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Tokenize splits text into words of letters and digits and normalizes them.
+// Everything else separates words.
+func Tokenize(text string) []Token {
+	var tokens []Token
+	start := -1
+	for i, r := range text {
+		if isWordRune(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = appendToken(tokens, text, start, i)
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = appendToken(tokens, text, start, len(text))
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+}
+
+func appendToken(tokens []Token, text string, start, end int) []Token {
+	term := Normalize(text[start:end])
+	if term == "" {
+		return tokens
+	}
+	return append(tokens, Token{Term: term, Start: start, End: end})
+}
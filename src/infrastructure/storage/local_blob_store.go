@@ -0,0 +1,152 @@
+// Package storage contains implementations of the blob storage port.
+package storage
+
+import (
+	"clean-architecture-golang/application/ports"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// DefaultMaxBlobSize is the size limit used when none is configured (10 MiB).
+const DefaultMaxBlobSize int64 = 10 << 20
+
+// sniffLen is the number of leading bytes inspected to detect the MIME type.
+const sniffLen = 512
+
+// Sentinel errors for blob storage
+var (
+	ErrBlobNotFound = errors.New("blob not found")
+	ErrBlobTooLarge = errors.New("file is too large")
+	ErrEmptyBlob    = errors.New("file is empty")
+)
+
+// LocalBlobStore implements ports.BlobStore on the local filesystem.
+// Blobs are content-addressed: each is stored once under its SHA-256 digest,
+// sharded by the first two hex characters to keep directories small.
+type LocalBlobStore struct {
+	root    string
+	maxSize int64
+}
+
+// Ensure LocalBlobStore implements ports.BlobStore at compile time.
+var _ ports.BlobStore = (*LocalBlobStore)(nil)
+
+// NewLocalBlobStore creates a store rooted at dir, creating the directory if needed.
+// A maxSize of zero or less selects DefaultMaxBlobSize.
+func NewLocalBlobStore(dir string, maxSize int64) (*LocalBlobStore, error) {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxBlobSize
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &LocalBlobStore{root: dir, maxSize: maxSize}, nil
+}
+
+// Put streams content into a temporary file while hashing it, then moves it to its
+// content address. Content larger than the configured limit is rejected with ErrBlobTooLarge.
+func (s *LocalBlobStore) Put(content io.Reader) (ports.BlobInfo, error) {
+	tmp, err := os.CreateTemp(s.root, "upload-*")
+	if err != nil {
+		return ports.BlobInfo{}, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	head := &sniffBuffer{}
+	size, err := io.Copy(io.MultiWriter(tmp, hash, head), io.LimitReader(content, s.maxSize+1))
+	if err != nil {
+		return ports.BlobInfo{}, err
+	}
+	if size > s.maxSize {
+		return ports.BlobInfo{}, ErrBlobTooLarge
+	}
+	if size == 0 {
+		return ports.BlobInfo{}, ErrEmptyBlob
+	}
+	if err := tmp.Close(); err != nil {
+		return ports.BlobInfo{}, err
+	}
+
+	info := ports.BlobInfo{
+		Digest:      hex.EncodeToString(hash.Sum(nil)),
+		Size:        size,
+		ContentType: http.DetectContentType(head.data),
+	}
+	target := s.path(info.Digest)
+	if _, err := os.Stat(target); err == nil {
+		return info, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return ports.BlobInfo{}, err
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return ports.BlobInfo{}, err
+	}
+	return info, nil
+}
+
+// Open returns a reader over the blob stored under digest.
+func (s *LocalBlobStore) Open(digest string) (io.ReadSeekCloser, error) {
+	if !validDigest(digest) {
+		return nil, ErrBlobNotFound
+	}
+	file, err := os.Open(s.path(digest))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// Delete removes the blob stored under digest. Deleting a missing blob is a no-op.
+func (s *LocalBlobStore) Delete(digest string) error {
+	if !validDigest(digest) {
+		return nil
+	}
+	if err := os.Remove(s.path(digest)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalBlobStore) path(digest string) string {
+	return filepath.Join(s.root, digest[:2], digest)
+}
+
+// validDigest reports whether digest is a lower-case hex SHA-256, which also keeps
+// callers from addressing files outside the store.
+func validDigest(digest string) bool {
+	if len(digest) != sha256.Size*2 {
+		return false
+	}
+	for _, c := range digest {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// sniffBuffer keeps the first sniffLen bytes written to it.
+type sniffBuffer struct {
+	data []byte
+}
+
+func (b *sniffBuffer) Write(p []byte) (int, error) {
+	if missing := sniffLen - len(b.data); missing > 0 {
+		if len(p) < missing {
+			missing = len(p)
+		}
+		b.data = append(b.data, p[:missing]...)
+	}
+	return len(p), nil
+}
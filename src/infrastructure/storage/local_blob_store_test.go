@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalBlobStore_PutOpenDelete(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewLocalBlobStore(dir, 1024)
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+
+	info, err := s.Put(strings.NewReader("hello world"))
+	if err != nil {
+		t.Fatalf("put failed: %v", err)
+	}
+	if info.Digest != "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9" || info.Size != 11 {
+		t.Fatalf("unexpected blob info: %+v", info)
+	}
+	if info.ContentType != "text/plain; charset=utf-8" {
+		t.Errorf("expected sniffed text/plain, got %q", info.ContentType)
+	}
+
+	// Identical content is stored once.
+	if again, err := s.Put(strings.NewReader("hello world")); err != nil || again.Digest != info.Digest {
+		t.Fatalf("expected identical digest, got %+v (err=%v)", again, err)
+	}
+	entries, _ := os.ReadDir(filepath.Join(dir, info.Digest[:2]))
+	if len(entries) != 1 {
+		t.Fatalf("expected a single stored copy, got %d", len(entries))
+	}
+
+	content, err := s.Open(info.Digest)
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	content.Seek(6, io.SeekStart)
+	rest, _ := io.ReadAll(content)
+	content.Close()
+	if string(rest) != "world" {
+		t.Errorf("expected seekable content, got %q", rest)
+	}
+
+	if err := s.Delete(info.Digest); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := s.Open(info.Digest); !errors.Is(err, ErrBlobNotFound) {
+		t.Errorf("expected ErrBlobNotFound, got %v", err)
+	}
+	if err := s.Delete(info.Digest); err != nil {
+		t.Errorf("expected deleting a missing blob to succeed, got %v", err)
+	}
+}
+
+func TestLocalBlobStore_Limits(t *testing.T) {
+	dir := t.TempDir()
+	s, _ := NewLocalBlobStore(dir, 4)
+
+	if _, err := s.Put(strings.NewReader("12345")); !errors.Is(err, ErrBlobTooLarge) {
+		t.Errorf("expected ErrBlobTooLarge, got %v", err)
+	}
+	if _, err := s.Put(strings.NewReader("")); !errors.Is(err, ErrEmptyBlob) {
+		t.Errorf("expected ErrEmptyBlob, got %v", err)
+	}
+	if _, err := s.Put(strings.NewReader("1234")); err != nil {
+		t.Errorf("expected content at the limit to be accepted, got %v", err)
+	}
+	entries, _ := os.ReadDir(dir)
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "upload-") {
+			t.Errorf("expected temporary upload %s to be cleaned up", entry.Name())
+		}
+	}
+}
+
+func TestLocalBlobStore_RejectsPathLikeDigests(t *testing.T) {
+	s, _ := NewLocalBlobStore(t.TempDir(), 0)
+	if _, err := s.Open("../../etc/passwd"); !errors.Is(err, ErrBlobNotFound) {
+		t.Errorf("expected ErrBlobNotFound, got %v", err)
+	}
+}
@@ -5,28 +5,145 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"strings"
 	"testing"
 
 	"clean-architecture-golang/application/usecases"
+	"clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
 	"clean-architecture-golang/infrastructure/repositories"
+	"clean-architecture-golang/infrastructure/storage"
 	presentation "clean-architecture-golang/presentation/controllers"
 )
 
-// SetupTestServer creates an httptest.Server wired with InMemoryTaskRepository
-// and returns the server and the repository for further inspection.
+// TestMaxBlobSize is the upload size limit of the blob store used by SetupTestServer.
+const TestMaxBlobSize = 1 << 10
+
+// TestDoingLimit is the number of tasks each user may have in doing on the server of SetupTestServer.
+const TestDoingLimit = 3
+
+// SetupTestServer creates an httptest.Server wired with InMemoryTaskRepository and a task list read
+// model of it, and returns the server and the repository for further inspection.
 func SetupTestServer() (*httptest.Server, *repositories.InMemoryTaskRepository) {
 	repo := repositories.NewInMemoryTaskRepository()
+	commentRepo := repositories.NewInMemoryCommentRepository()
+	projectRepo := repositories.NewInMemoryProjectRepository()
+	viewRepo := repositories.NewInMemorySavedViewRepository()
+	workLog := repositories.NewInMemoryWorkLogRepository()
+	blobDir, err := os.MkdirTemp("", "task-blobs-")
+	if err != nil {
+		panic(err)
+	}
+	blobs, err := storage.NewLocalBlobStore(blobDir, TestMaxBlobSize)
+	if err != nil {
+		panic(err)
+	}
+
+	responses := &usecases.ResponseBuilder{Hierarchy: repo, Comments: commentRepo}
 
-	createUC := &usecases.CreateTaskUseCase{Repo: repo}
-	updateUC := &usecases.UpdateTaskStatusUseCase{Repo: repo}
-	getUC := &usecases.GetTasksByStatusUseCase{Repo: repo}
-	deleteUC := &usecases.DeleteTaskUseCase{Repo: repo}
+	unitOfWork := repositories.NewInMemoryUnitOfWork(repo, workLog, repositories.DefaultUndoWindow)
+	bulkUnitOfWork := repositories.NewInMemoryCopyOnCommitUnitOfWork(repo, workLog, repositories.DefaultUndoWindow)
+	readModel := repositories.NewInMemoryTaskListProjection(repo)
+	limits := entities.WIPLimits{value_objects.StatusDoing: {Max: TestDoingLimit, PerUser: true}}
+	createUC := &usecases.CreateTaskUseCase{Repo: repo, Projects: projectRepo, WIP: repo, Limits: limits,
+		UnitOfWork: unitOfWork}
+	updateUC := &usecases.UpdateTaskStatusUseCase{Repo: repo, Hierarchy: repo, WorkLog: workLog, Projects: projectRepo,
+		WIP: repo, Limits: limits, RequireChecklist: true, UnitOfWork: unitOfWork}
+	getUC := &usecases.GetTasksByStatusUseCase{Repo: repo, ReadModel: readModel, Responses: responses}
+	deleteUC := &usecases.DeleteTaskUseCase{Repo: repo, Hierarchy: repo, Dependencies: repo, Comments: commentRepo,
+		WorkLog: workLog, Blobs: blobs, Attachments: repo, Trash: repo, UnitOfWork: unitOfWork}
+	addTagsUC := &usecases.AddTaskTagsUseCase{Repo: repo, Responses: responses, UnitOfWork: unitOfWork}
+	removeTagUC := &usecases.RemoveTaskTagUseCase{Repo: repo, UnitOfWork: unitOfWork}
+	getByTagsUC := &usecases.GetTasksByTagsUseCase{Repo: repo, Responses: responses}
+	tagStatsUC := &usecases.GetTagStatsUseCase{Repo: repo}
+	getTaskUC := &usecases.GetTaskUseCase{Repo: repo, Responses: responses}
+	childrenUC := &usecases.GetTaskChildrenUseCase{Repo: repo, Hierarchy: repo, Responses: responses}
+	setParentUC := &usecases.SetTaskParentUseCase{Repo: repo, UnitOfWork: unitOfWork}
+	addBlockerUC := &usecases.AddTaskBlockerUseCase{Repo: repo, UnitOfWork: unitOfWork}
+	removeBlockerUC := &usecases.RemoveTaskBlockerUseCase{Repo: repo, UnitOfWork: unitOfWork}
+	nextUC := &usecases.GetNextTasksUseCase{Repo: repo, ReadModel: readModel, Responses: responses}
+	setRecurrenceUC := &usecases.SetTaskRecurrenceUseCase{Repo: repo, UnitOfWork: unitOfWork}
+	restoreUC := &usecases.RestoreTaskUseCase{Repo: repo, Trash: repo, Projects: projectRepo, Limits: limits,
+		Responses: responses, UnitOfWork: unitOfWork}
 
 	controller := &presentation.TaskController{
-		CreateTaskUC:       createUC,
-		UpdateStatusUC:     updateUC,
-		GetTasksByStatusUC: getUC,
-		DeleteTaskUC:       deleteUC,
+		CreateTaskUC:          createUC,
+		UpdateStatusUC:        updateUC,
+		GetTasksByStatusUC:    getUC,
+		StatusCountsUC:        &usecases.GetStatusCountsUseCase{Repo: repo, ReadModel: readModel},
+		DeleteTaskUC:          deleteUC,
+		AddTagsUC:             addTagsUC,
+		RemoveTagUC:           removeTagUC,
+		GetTasksByTagsUC:      getByTagsUC,
+		GetTagStatsUC:         tagStatsUC,
+		GetTaskUC:             getTaskUC,
+		GetTaskAsOfUC:         &usecases.GetTaskAsOfUseCase{Timeline: repo},
+		ListByStatusAsOfUC:    &usecases.GetTasksByStatusAsOfUseCase{Timeline: repo},
+		GetChildrenUC:         childrenUC,
+		SetParentUC:           setParentUC,
+		AddBlockerUC:          addBlockerUC,
+		RemoveBlockerUC:       removeBlockerUC,
+		GetNextTasksUC:        nextUC,
+		SetRecurrenceUC:       setRecurrenceUC,
+		SetPriorityUC:         &usecases.SetTaskPriorityUseCase{Repo: repo, UnitOfWork: unitOfWork},
+		UploadAttachmentUC:    &usecases.UploadAttachmentUseCase{Repo: repo, Blobs: blobs, Attachments: repo, UnitOfWork: unitOfWork},
+		ListAttachmentsUC:     &usecases.ListAttachmentsUseCase{Repo: repo},
+		OpenAttachmentUC:      &usecases.OpenAttachmentUseCase{Repo: repo, Blobs: blobs},
+		DeleteAttachmentUC:    &usecases.DeleteAttachmentUseCase{Repo: repo, Blobs: blobs, Attachments: repo, UnitOfWork: unitOfWork},
+		StartTimerUC:          &usecases.StartTimerUseCase{Repo: repo, WorkLog: workLog, UnitOfWork: unitOfWork},
+		StopTimerUC:           &usecases.StopTimerUseCase{WorkLog: workLog, UnitOfWork: unitOfWork},
+		LogWorkUC:             &usecases.LogWorkUseCase{Repo: repo, WorkLog: workLog, UnitOfWork: unitOfWork},
+		ListWorkLogUC:         &usecases.ListWorkLogUseCase{Repo: repo, WorkLog: workLog},
+		TimeReportUC:          &usecases.GetTimeReportUseCase{Repo: repo, WorkLog: workLog},
+		GetHistoryUC:          &usecases.GetTaskHistoryUseCase{Repo: repo},
+		FlowAnalyticsUC:       &usecases.GetFlowAnalyticsUseCase{Repo: repo, ReadModel: readModel, Archive: repo},
+		MoveTaskUC:            &usecases.MoveTaskUseCase{Repo: repo, Status: updateUC, UnitOfWork: unitOfWork},
+		ListTrashUC:           &usecases.ListTrashUseCase{Trash: repo, Responses: responses},
+		RestoreTaskUC:         restoreUC,
+		ArchiveTaskUC:         &usecases.ArchiveTaskUseCase{Repo: repo, Hierarchy: repo, Dependencies: repo, Archive: repo, UnitOfWork: unitOfWork},
+		ListArchivedUC:        &usecases.ListArchivedUseCase{Archive: repo, Responses: responses},
+		GetArchivedUC:         &usecases.GetArchivedTaskUseCase{Archive: repo, Responses: responses},
+		MoveToProjectUC:       &usecases.MoveTaskToProjectUseCase{Repo: repo, Hierarchy: repo, Projects: projectRepo, UnitOfWork: unitOfWork},
+		AddChecklistItemUC:    &usecases.AddChecklistItemUseCase{Repo: repo, UnitOfWork: unitOfWork},
+		CheckChecklistItemUC:  &usecases.CheckChecklistItemUseCase{Repo: repo, UnitOfWork: unitOfWork},
+		MoveChecklistItemUC:   &usecases.MoveChecklistItemUseCase{Repo: repo, UnitOfWork: unitOfWork},
+		RemoveChecklistItemUC: &usecases.RemoveChecklistItemUseCase{Repo: repo, UnitOfWork: unitOfWork},
+		SearchTasksUC:         &usecases.SearchTasksUseCase{Repo: repo, Search: repo, Responses: responses},
+		FilterTasksUC:         &usecases.FilterTasksUseCase{Query: repo, Responses: responses},
+		BulkCreateUC:          &usecases.BulkCreateTasksUseCase{Create: createUC, UnitOfWork: bulkUnitOfWork},
+		BulkUpdateStatusUC:    &usecases.BulkUpdateStatusUseCase{UpdateStatus: updateUC, UnitOfWork: bulkUnitOfWork},
+		BulkDeleteUC:          &usecases.BulkDeleteTasksUseCase{Delete: deleteUC, UnitOfWork: bulkUnitOfWork},
+	}
+
+	projectController := &presentation.ProjectController{
+		CreateProjectUC:    &usecases.CreateProjectUseCase{Projects: projectRepo},
+		ListProjectsUC:     &usecases.ListProjectsUseCase{Projects: projectRepo, Tasks: repo},
+		GetProjectUC:       &usecases.GetProjectUseCase{Projects: projectRepo, Tasks: repo},
+		UpdateProjectUC:    &usecases.UpdateProjectUseCase{Projects: projectRepo, Tasks: repo},
+		DeleteProjectUC:    &usecases.DeleteProjectUseCase{Projects: projectRepo, Tasks: repo, Trash: repo, Archive: repo, UnitOfWork: unitOfWork},
+		ListProjectTasksUC: &usecases.ListProjectTasksUseCase{Projects: projectRepo, Tasks: repo, Responses: responses},
+	}
+
+	readModelController := &presentation.ReadModelController{
+		RebuildUC: &usecases.RebuildReadModelUseCase{ReadModel: readModel},
+	}
+	undoController := &presentation.UndoController{UndoUC: &usecases.UndoUseCase{UnitOfWork: unitOfWork, Status: updateUC}}
+	idempotency := &presentation.IdempotencyGuard{Store: repositories.NewInMemoryIdempotencyStore(repositories.DefaultIdempotencyTTL)}
+
+	viewController := &presentation.ViewController{
+		SaveViewUC:   &usecases.SaveViewUseCase{Views: viewRepo},
+		ListViewsUC:  &usecases.ListViewsUseCase{Views: viewRepo},
+		GetViewUC:    &usecases.GetViewUseCase{Views: viewRepo},
+		DeleteViewUC: &usecases.DeleteViewUseCase{Views: viewRepo},
+		RunViewUC:    &usecases.RunViewUseCase{Views: viewRepo, Query: repo, Responses: responses},
+	}
+
+	commentController := &presentation.CommentController{
+		AddCommentUC:    &usecases.AddCommentUseCase{Repo: repo, Comments: commentRepo, UnitOfWork: unitOfWork},
+		ListCommentsUC:  &usecases.ListCommentsUseCase{Repo: repo, Comments: commentRepo},
+		EditCommentUC:   &usecases.EditCommentUseCase{Comments: commentRepo},
+		DeleteCommentUC: &usecases.DeleteCommentUseCase{Comments: commentRepo},
 	}
 
 	mux := http.NewServeMux()
@@ -34,7 +151,7 @@ func SetupTestServer() (*httptest.Server, *repositories.InMemoryTaskRepository)
 	mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodPost:
-			controller.Create(w, r)
+			idempotency.Wrap(controller.Create)(w, r)
 		case http.MethodGet:
 			controller.ListByStatus(w, r)
 		default:
@@ -43,16 +160,208 @@ func SetupTestServer() (*httptest.Server, *repositories.InMemoryTaskRepository)
 	})
 
 	mux.HandleFunc("/tasks/", func(w http.ResponseWriter, r *http.Request) {
-		if r.Method == http.MethodPut && len(r.URL.Path) > 0 && len(r.URL.Path) >= 7 && r.URL.Path[len(r.URL.Path)-7:] == "/status" {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/attachments") && r.Method == http.MethodPost:
+			controller.UploadAttachment(w, r)
+		case strings.HasSuffix(r.URL.Path, "/attachments") && r.Method == http.MethodGet:
+			controller.ListAttachments(w, r)
+		case strings.Contains(r.URL.Path, "/attachments/") && r.Method == http.MethodGet:
+			controller.DownloadAttachment(w, r)
+		case strings.Contains(r.URL.Path, "/attachments/") && r.Method == http.MethodDelete:
+			controller.DeleteAttachment(w, r)
+		case strings.HasSuffix(r.URL.Path, "/timer/start") && r.Method == http.MethodPost:
+			controller.StartTimer(w, r)
+		case strings.HasSuffix(r.URL.Path, "/timer/stop") && r.Method == http.MethodPost:
+			controller.StopTimer(w, r)
+		case strings.HasSuffix(r.URL.Path, "/worklog") && r.Method == http.MethodPost:
+			controller.LogWork(w, r)
+		case strings.HasSuffix(r.URL.Path, "/worklog") && r.Method == http.MethodGet:
+			controller.ListWorkLog(w, r)
+		case strings.HasSuffix(r.URL.Path, "/comments") && r.Method == http.MethodPost:
+			commentController.Add(w, r)
+		case strings.HasSuffix(r.URL.Path, "/comments") && r.Method == http.MethodGet:
+			commentController.List(w, r)
+		case strings.Contains(r.URL.Path, "/comments/") && r.Method == http.MethodPut:
+			commentController.Edit(w, r)
+		case strings.Contains(r.URL.Path, "/comments/") && r.Method == http.MethodDelete:
+			commentController.Delete(w, r)
+		case strings.HasSuffix(r.URL.Path, "/checklist") && r.Method == http.MethodPost:
+			controller.AddChecklistItem(w, r)
+		case strings.Contains(r.URL.Path, "/checklist/") && strings.HasSuffix(r.URL.Path, "/position") && r.Method == http.MethodPut:
+			controller.MoveChecklistItem(w, r)
+		case strings.Contains(r.URL.Path, "/checklist/") && r.Method == http.MethodPut:
+			controller.CheckChecklistItem(w, r)
+		case strings.Contains(r.URL.Path, "/checklist/") && r.Method == http.MethodDelete:
+			controller.RemoveChecklistItem(w, r)
+		case strings.HasSuffix(r.URL.Path, "/project") && r.Method == http.MethodPut:
+			controller.SetProject(w, r)
+		case strings.HasSuffix(r.URL.Path, "/archive") && r.Method == http.MethodPost:
+			controller.Archive(w, r)
+		case strings.HasSuffix(r.URL.Path, "/restore") && r.Method == http.MethodPost:
+			controller.Restore(w, r)
+		case strings.HasSuffix(r.URL.Path, "/position") && r.Method == http.MethodPut:
+			controller.Move(w, r)
+		case strings.HasSuffix(r.URL.Path, "/status") && r.Method == http.MethodPut:
 			controller.UpdateStatus(w, r)
-		} else if r.Method == http.MethodDelete {
+		case strings.HasSuffix(r.URL.Path, "/recurrence") && r.Method == http.MethodPut:
+			controller.SetRecurrence(w, r)
+		case strings.HasSuffix(r.URL.Path, "/priority") && r.Method == http.MethodPut:
+			controller.SetPriority(w, r)
+		case strings.HasSuffix(r.URL.Path, "/parent") && r.Method == http.MethodPut:
+			controller.SetParent(w, r)
+		case strings.HasSuffix(r.URL.Path, "/history") && r.Method == http.MethodGet:
+			controller.History(w, r)
+		case strings.HasSuffix(r.URL.Path, "/children") && r.Method == http.MethodGet:
+			controller.Children(w, r)
+		case strings.HasSuffix(r.URL.Path, "/blockers") && r.Method == http.MethodPost:
+			controller.AddBlocker(w, r)
+		case strings.Contains(r.URL.Path, "/blockers/") && r.Method == http.MethodDelete:
+			controller.RemoveBlocker(w, r)
+		case r.URL.Path == "/tasks/next" && r.Method == http.MethodGet:
+			controller.Next(w, r)
+		case r.URL.Path == "/tasks/search" && r.Method == http.MethodGet:
+			controller.Search(w, r)
+		case r.URL.Path == "/tasks/counts" && r.Method == http.MethodGet:
+			controller.StatusCounts(w, r)
+		case r.URL.Path == "/tasks/bulk" && r.Method == http.MethodPost:
+			controller.BulkCreate(w, r)
+		case r.URL.Path == "/tasks/bulk/status" && r.Method == http.MethodPost:
+			controller.BulkUpdateStatus(w, r)
+		case r.URL.Path == "/tasks/bulk/delete" && r.Method == http.MethodPost:
+			controller.BulkDelete(w, r)
+		case strings.HasSuffix(r.URL.Path, "/tags") && r.Method == http.MethodPost:
+			controller.AddTags(w, r)
+		case strings.Contains(r.URL.Path, "/tags/") && r.Method == http.MethodDelete:
+			controller.RemoveTag(w, r)
+		case r.Method == http.MethodDelete:
 			controller.Delete(w, r)
-		} else {
+		case r.Method == http.MethodGet:
+			controller.Get(w, r)
+		default:
 			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
 		}
 	})
 
-	return httptest.NewServer(mux), repo
+	mux.HandleFunc("/tags", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		controller.TagStats(w, r)
+	})
+
+	mux.HandleFunc("/projects", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			projectController.Create(w, r)
+		case http.MethodGet:
+			projectController.List(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	mux.HandleFunc("/projects/", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/tasks") && r.Method == http.MethodGet:
+			projectController.ListTasks(w, r)
+		case r.Method == http.MethodGet:
+			projectController.Get(w, r)
+		case r.Method == http.MethodPut:
+			projectController.Update(w, r)
+		case r.Method == http.MethodDelete:
+			projectController.Delete(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/views", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		viewController.List(w, r)
+	})
+	mux.HandleFunc("/views/", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/tasks") && r.Method == http.MethodGet:
+			viewController.Tasks(w, r)
+		case r.Method == http.MethodGet:
+			viewController.Get(w, r)
+		case r.Method == http.MethodPut:
+			viewController.Save(w, r)
+		case r.Method == http.MethodDelete:
+			viewController.Delete(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	mux.HandleFunc("/read-model/rebuild", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		readModelController.Rebuild(w, r)
+	})
+
+	mux.HandleFunc("/undo/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		undoController.Undo(w, r)
+	})
+
+	mux.HandleFunc("/trash", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		controller.ListTrash(w, r)
+	})
+
+	mux.HandleFunc("/archive", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		controller.ListArchive(w, r)
+	})
+
+	mux.HandleFunc("/archive/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		controller.GetArchived(w, r)
+	})
+
+	mux.HandleFunc("/reports/time", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		controller.TimeReport(w, r)
+	})
+
+	mux.HandleFunc("/analytics/flow", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		controller.FlowAnalytics(w, r)
+	})
+
+	// The read model catches up before every request instead of on an interval, so that tests see
+	// their writes in the queries it serves.
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := readModel.CatchUp(); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})), repo
 }
 
 // CreateTask helper posts to /tasks and returns the created task response as map.
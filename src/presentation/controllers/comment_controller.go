@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	presentation_dto "clean-architecture-golang/presentation/dto"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// CommentController handles the comment thread of tasks under /tasks/{id}/comments.
+type CommentController struct {
+	AddCommentUC    *usecases.AddCommentUseCase
+	ListCommentsUC  *usecases.ListCommentsUseCase
+	EditCommentUC   *usecases.EditCommentUseCase
+	DeleteCommentUC *usecases.DeleteCommentUseCase
+}
+
+// commentPath splits "/tasks/{id}/comments[/{commentId}]" into its IDs.
+func commentPath(r *http.Request) (taskID, commentID string) {
+	rest := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	taskID, commentID, _ = strings.Cut(rest, "/comments")
+	return taskID, strings.TrimPrefix(commentID, "/")
+}
+
+// Add handles POST /tasks/{id}/comments.
+func (c *CommentController) Add(w http.ResponseWriter, r *http.Request) {
+	taskID, _ := commentPath(r)
+	var httpReq presentation_dto.HttpCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, err := c.AddCommentUC.Execute(taskID, currentUser(r), httpReq.Body)
+	if err != nil {
+		writeCommentError(w, "AddComment", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// List handles GET /tasks/{id}/comments.
+func (c *CommentController) List(w http.ResponseWriter, r *http.Request) {
+	taskID, _ := commentPath(r)
+	responses, err := c.ListCommentsUC.Execute(taskID)
+	if err != nil {
+		writeCommentError(w, "ListComments", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+// Edit handles PUT /tasks/{id}/comments/{commentId}.
+func (c *CommentController) Edit(w http.ResponseWriter, r *http.Request) {
+	taskID, commentID := commentPath(r)
+	var httpReq presentation_dto.HttpCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, err := c.EditCommentUC.Execute(taskID, commentID, httpReq.Body)
+	if err != nil {
+		writeCommentError(w, "EditComment", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// Delete handles DELETE /tasks/{id}/comments/{commentId}.
+func (c *CommentController) Delete(w http.ResponseWriter, r *http.Request) {
+	taskID, commentID := commentPath(r)
+	if err := c.DeleteCommentUC.Execute(taskID, commentID); err != nil {
+		writeCommentError(w, "DeleteComment", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeCommentError(w http.ResponseWriter, handler string, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidID):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain_entities.ErrEmptyComment), errors.Is(err, domain_entities.ErrCommentTooLong):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, repo.ErrNotFound), errors.Is(err, repo.ErrCommentNotFound), errors.Is(err, usecases.ErrCommentNotOnTask):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	default:
+		log.Printf("%s internal error: %v", handler, err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+	}
+}
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"bytes"
+	"clean-architecture-golang/application/ports"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+)
+
+// MaxIdempotencyKeyLength is the maximum length of an Idempotency-Key header.
+const MaxIdempotencyKeyLength = 255
+
+// IdempotencyGuard makes handlers safe to retry with an Idempotency-Key header.
+// The first response to a key is stored per X-User-ID and replayed with the same status and body
+// to later requests with that key and the same method, path and body. Server errors are not stored,
+// so such requests can be retried.
+type IdempotencyGuard struct {
+	Store ports.IdempotencyStore
+}
+
+// Wrap returns a handler applying the guard to next. Requests without the header go straight to next.
+// If next panics, the key is released before the panic goes on, so the request can be retried.
+func (g *IdempotencyGuard) Wrap(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Idempotency-Key")
+		if key == "" {
+			next(w, r)
+			return
+		}
+		if len(key) > MaxIdempotencyKeyLength {
+			writeJSONError(w, http.StatusBadRequest, "idempotency key is too long")
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		scopedKey := currentUser(r) + "\x00" + key
+		stored, err := g.Store.Reserve(scopedKey, fingerprint(r, body), time.Now())
+		if err != nil {
+			switch {
+			case errors.Is(err, repo.ErrIdempotencyKeyInUse):
+				writeJSONError(w, http.StatusConflict, err.Error())
+			case errors.Is(err, repo.ErrIdempotencyKeyReused):
+				writeJSONError(w, http.StatusUnprocessableEntity, err.Error())
+			default:
+				log.Printf("Idempotency internal error: %v", err)
+				writeJSONError(w, http.StatusInternalServerError, "internal error")
+			}
+			return
+		}
+		if stored != nil {
+			w.Header().Set("Idempotent-Replayed", "true")
+			writeStoredResponse(w, *stored)
+			return
+		}
+
+		finished := false
+		defer func() {
+			if !finished {
+				if err := g.Store.Release(scopedKey); err != nil {
+					log.Printf("Idempotency internal error: %v", err)
+				}
+			}
+		}()
+		recorder := &responseRecorder{header: make(http.Header), status: http.StatusOK}
+		next(recorder, r)
+		finished = true
+		response := ports.IdempotentResponse{
+			StatusCode:  recorder.status,
+			ContentType: recorder.header.Get("Content-Type"),
+			Body:        recorder.body.Bytes(),
+		}
+		if response.StatusCode >= http.StatusInternalServerError {
+			err = g.Store.Release(scopedKey)
+		} else {
+			err = g.Store.Complete(scopedKey, response)
+		}
+		if err != nil {
+			log.Printf("Idempotency internal error: %v", err)
+		}
+		writeStoredResponse(w, response)
+	}
+}
+
+// fingerprint identifies the payload of a request, so a key reused for another request is detected.
+func fingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	io.WriteString(hash, r.Method+" "+r.URL.Path+"\n")
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func writeStoredResponse(w http.ResponseWriter, response ports.IdempotentResponse) {
+	if response.ContentType != "" {
+		w.Header().Set("Content-Type", response.ContentType)
+	}
+	w.WriteHeader(response.StatusCode)
+	w.Write(response.Body)
+}
+
+// responseRecorder buffers the response of a handler.
+type responseRecorder struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (rr *responseRecorder) Header() http.Header {
+	return rr.header
+}
+
+func (rr *responseRecorder) WriteHeader(status int) {
+	if !rr.wroteHeader {
+		rr.status = status
+		rr.wroteHeader = true
+	}
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	rr.WriteHeader(http.StatusOK)
+	return rr.body.Write(b)
+}
@@ -0,0 +1,15 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+)
+
+// UserHeader carries the identity of the caller. The API has no authentication
+// yet, so the value is trusted as sent by the client.
+const UserHeader = "X-User-ID"
+
+// currentUser returns the caller's identity, or an empty string for anonymous requests.
+func currentUser(r *http.Request) string {
+	return strings.TrimSpace(r.Header.Get(UserHeader))
+}
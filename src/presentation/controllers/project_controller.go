@@ -0,0 +1,132 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	presentation_dto "clean-architecture-golang/presentation/dto"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// ProjectController handles projects under /projects.
+type ProjectController struct {
+	CreateProjectUC    *usecases.CreateProjectUseCase
+	ListProjectsUC     *usecases.ListProjectsUseCase
+	GetProjectUC       *usecases.GetProjectUseCase
+	UpdateProjectUC    *usecases.UpdateProjectUseCase
+	DeleteProjectUC    *usecases.DeleteProjectUseCase
+	ListProjectTasksUC *usecases.ListProjectTasksUseCase
+}
+
+// projectID extracts {id} from "/projects/{id}[/tasks]".
+func projectID(r *http.Request) string {
+	id := strings.TrimPrefix(r.URL.Path, "/projects/")
+	return strings.TrimSuffix(id, "/tasks")
+}
+
+// Create handles POST /projects.
+func (c *ProjectController) Create(w http.ResponseWriter, r *http.Request) {
+	var httpReq presentation_dto.HttpProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, err := c.CreateProjectUC.Execute(toProjectRequest("", httpReq))
+	if err != nil {
+		writeProjectError(w, "CreateProject", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// List handles GET /projects.
+func (c *ProjectController) List(w http.ResponseWriter, r *http.Request) {
+	responses, err := c.ListProjectsUC.Execute()
+	if err != nil {
+		writeProjectError(w, "ListProjects", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+// Get handles GET /projects/{id}.
+func (c *ProjectController) Get(w http.ResponseWriter, r *http.Request) {
+	response, err := c.GetProjectUC.Execute(projectID(r))
+	if err != nil {
+		writeProjectError(w, "GetProject", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// Update handles PUT /projects/{id}.
+func (c *ProjectController) Update(w http.ResponseWriter, r *http.Request) {
+	var httpReq presentation_dto.HttpProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, err := c.UpdateProjectUC.Execute(toProjectRequest(projectID(r), httpReq))
+	if err != nil {
+		writeProjectError(w, "UpdateProject", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// Delete handles DELETE /projects/{id}.
+func (c *ProjectController) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := c.DeleteProjectUC.Execute(projectID(r)); err != nil {
+		writeProjectError(w, "DeleteProject", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListTasks handles GET /projects/{id}/tasks with an optional status query param.
+func (c *ProjectController) ListTasks(w http.ResponseWriter, r *http.Request) {
+	responses, err := c.ListProjectTasksUC.Execute(projectID(r), r.URL.Query().Get("status"))
+	if err != nil {
+		writeProjectError(w, "ListProjectTasks", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+func toProjectRequest(id string, httpReq presentation_dto.HttpProjectRequest) dto.ProjectRequest {
+	return dto.ProjectRequest{
+		ID:          id,
+		Name:        httpReq.Name,
+		Description: httpReq.Description,
+		DefaultTags: httpReq.Settings.DefaultTags,
+		StartTimers: httpReq.Settings.StartTimers,
+	}
+}
+
+func writeProjectError(w http.ResponseWriter, handler string, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidID):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain_entities.ErrInvalidInput), errors.Is(err, value_objects.ErrInvalidTag):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, usecases.ErrProjectNotEmpty):
+		writeJSONError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, repo.ErrProjectNotFound):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	default:
+		log.Printf("%s internal error: %v", handler, err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+	}
+}
@@ -0,0 +1,22 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	"log"
+	"net/http"
+)
+
+// ReadModelController handles maintenance of the task list read model.
+type ReadModelController struct {
+	RebuildUC *usecases.RebuildReadModelUseCase
+}
+
+// Rebuild handles POST /read-model/rebuild.
+func (c *ReadModelController) Rebuild(w http.ResponseWriter, r *http.Request) {
+	if err := c.RebuildUC.Execute(); err != nil {
+		log.Printf("Rebuild internal error: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/usecases"
+	"clean-architecture-golang/domain/value_objects"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// FlowAnalytics handles GET /analytics/flow?from=YYYY-MM-DD&to=YYYY-MM-DD&tz=.
+// JSON is returned by default; format=csv (or an Accept header asking for text/csv)
+// returns one dataset as CSV, selected with dataset=cfd (default), throughput or tasks.
+func (c *TaskController) FlowAnalytics(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	response, err := c.FlowAnalyticsUC.Execute(dto.FlowAnalyticsRequest{
+		From:     query.Get("from"),
+		To:       query.Get("to"),
+		Timezone: query.Get("tz"),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidAnalyticsRange):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		default:
+			log.Printf("FlowAnalytics internal error: %v", err)
+			writeJSONError(w, http.StatusInternalServerError, "internal error")
+		}
+		return
+	}
+	if query.Get("format") != "csv" && !strings.Contains(r.Header.Get("Accept"), "text/csv") {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+	rows, ok := flowCSV(response, query.Get("dataset"))
+	if !ok {
+		writeJSONError(w, http.StatusBadRequest, "dataset must be cfd, throughput or tasks")
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	csv.NewWriter(w).WriteAll(rows)
+}
+
+// flowCSV renders one dataset of the analytics response as CSV rows, header first.
+func flowCSV(response *dto.FlowAnalyticsResponse, dataset string) ([][]string, bool) {
+	switch dataset {
+	case "", "cfd":
+		header := []string{"date"}
+		for _, status := range value_objects.AllStatuses() {
+			header = append(header, status.String())
+		}
+		rows := [][]string{header}
+		for _, point := range response.CumulativeFlow {
+			row := []string{point.Date}
+			for _, status := range value_objects.AllStatuses() {
+				row = append(row, strconv.Itoa(point.Counts[status.String()]))
+			}
+			rows = append(rows, row)
+		}
+		return rows, true
+	case "throughput":
+		rows := [][]string{{"week_start", "completed"}}
+		for _, week := range response.Throughput {
+			rows = append(rows, []string{week.WeekStart, strconv.Itoa(week.Completed)})
+		}
+		return rows, true
+	case "tasks":
+		rows := [][]string{{"task_id", "title", "created_at", "started_at", "completed_at", "lead_time_seconds", "cycle_time_seconds"}}
+		for _, task := range response.Completed {
+			rows = append(rows, []string{
+				task.TaskID, task.Title, task.CreatedAt, task.StartedAt, task.CompletedAt,
+				strconv.FormatInt(task.LeadTimeSeconds, 10), strconv.FormatInt(task.CycleTimeSeconds, 10),
+			})
+		}
+		return rows, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// Archive handles POST /tasks/{id}/archive.
+func (c *TaskController) Archive(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/archive")
+	token, err := c.ArchiveTaskUC.ExecuteUndoable(id)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidID), errors.Is(err, domain_entities.ErrArchiveNotDone),
+			errors.Is(err, domain_entities.ErrArchiveSubtask):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, repo.ErrNotFound):
+			writeJSONError(w, http.StatusNotFound, err.Error())
+		default:
+			log.Printf("Archive internal error: %v", err)
+			writeJSONError(w, http.StatusInternalServerError, "internal error")
+		}
+		return
+	}
+	writeUndoToken(w, token)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListArchive handles GET /archive.
+func (c *TaskController) ListArchive(w http.ResponseWriter, r *http.Request) {
+	responses, err := c.ListArchivedUC.Execute()
+	if err != nil {
+		log.Printf("ListArchive internal error: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+// GetArchived handles GET /archive/{id}.
+func (c *TaskController) GetArchived(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/archive/")
+	response, err := c.GetArchivedUC.Execute(id)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidID):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, repo.ErrNotFound):
+			writeJSONError(w, http.StatusNotFound, err.Error())
+		default:
+			log.Printf("GetArchived internal error: %v", err)
+			writeJSONError(w, http.StatusInternalServerError, "internal error")
+		}
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// GetAsOf handles GET /tasks/{id}?as_of={RFC 3339 timestamp}.
+func (c *TaskController) GetAsOf(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	response, err := c.GetTaskAsOfUC.Execute(id, r.URL.Query().Get("as_of"))
+	if err != nil {
+		writeAsOfError(w, "GetAsOf", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// ListByStatusAsOf handles GET /tasks?status={status}&as_of={RFC 3339 timestamp}.
+func (c *TaskController) ListByStatusAsOf(w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+	if status == "" {
+		writeJSONError(w, http.StatusBadRequest, "status query param required with as_of")
+		return
+	}
+	responses, err := c.ListByStatusAsOfUC.Execute(status, r.URL.Query().Get("as_of"))
+	if err != nil {
+		writeAsOfError(w, "ListByStatusAsOf", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+func writeAsOfError(w http.ResponseWriter, handler string, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidID), errors.Is(err, usecases.ErrInvalidAsOf),
+		errors.Is(err, domain_entities.ErrInvalidStatus):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, repo.ErrNotFound):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, repo.ErrHistoryTrimmed):
+		writeJSONError(w, http.StatusGone, err.Error())
+	default:
+		log.Printf("%s internal error: %v", handler, err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+	}
+}
@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	"clean-architecture-golang/infrastructure/storage"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// attachmentFormField is the multipart form field carrying the uploaded file.
+const attachmentFormField = "file"
+
+// attachmentPath splits "/tasks/{id}/attachments[/{attachmentId}]" into its IDs.
+func attachmentPath(r *http.Request) (taskID, attachmentID string) {
+	rest := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	taskID, attachmentID, _ = strings.Cut(rest, "/attachments")
+	return taskID, strings.TrimPrefix(attachmentID, "/")
+}
+
+// UploadAttachment handles POST /tasks/{id}/attachments with a multipart/form-data body.
+// The file part is streamed to the blob store without buffering the whole upload.
+func (c *TaskController) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	taskID, _ := attachmentPath(r)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			writeJSONError(w, http.StatusBadRequest, "missing \""+attachmentFormField+"\" form field")
+			return
+		}
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if part.FormName() != attachmentFormField {
+			part.Close()
+			continue
+		}
+		response, err := c.UploadAttachmentUC.Execute(taskID, part.FileName(), part)
+		part.Close()
+		if err != nil {
+			writeAttachmentError(w, "UploadAttachment", err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+}
+
+// ListAttachments handles GET /tasks/{id}/attachments.
+func (c *TaskController) ListAttachments(w http.ResponseWriter, r *http.Request) {
+	taskID, _ := attachmentPath(r)
+	responses, err := c.ListAttachmentsUC.Execute(taskID)
+	if err != nil {
+		writeAttachmentError(w, "ListAttachments", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+// DownloadAttachment handles GET /tasks/{id}/attachments/{attachmentId}.
+// Range and conditional requests are served by http.ServeContent.
+func (c *TaskController) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	taskID, attachmentID := attachmentPath(r)
+	attachment, content, err := c.OpenAttachmentUC.Execute(taskID, attachmentID)
+	if err != nil {
+		writeAttachmentError(w, "DownloadAttachment", err)
+		return
+	}
+	defer content.Close()
+	uploadedAt, _ := time.Parse(time.RFC3339, attachment.UploadedAt)
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("ETag", `"`+attachment.Digest+`"`)
+	http.ServeContent(w, r, attachment.Name, uploadedAt, content)
+}
+
+// DeleteAttachment handles DELETE /tasks/{id}/attachments/{attachmentId}.
+func (c *TaskController) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	taskID, attachmentID := attachmentPath(r)
+	if err := c.DeleteAttachmentUC.Execute(taskID, attachmentID); err != nil {
+		writeAttachmentError(w, "DeleteAttachment", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeAttachmentError(w http.ResponseWriter, handler string, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidID):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain_entities.ErrInvalidAttachmentName), errors.Is(err, domain_entities.ErrTooManyAttachments),
+		errors.Is(err, storage.ErrEmptyBlob):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, storage.ErrBlobTooLarge):
+		writeJSONError(w, http.StatusRequestEntityTooLarge, err.Error())
+	case errors.Is(err, repo.ErrNotFound), errors.Is(err, usecases.ErrAttachmentNotFound), errors.Is(err, storage.ErrBlobNotFound):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	default:
+		log.Printf("%s internal error: %v", handler, err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+	}
+}
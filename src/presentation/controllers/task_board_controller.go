@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	presentation_dto "clean-architecture-golang/presentation/dto"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// Move handles PUT /tasks/{id}/position.
+func (c *TaskController) Move(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/position")
+	var httpReq presentation_dto.HttpMoveTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	token, err := c.MoveTaskUC.ExecuteUndoable(dto.MoveTaskRequest{
+		TaskID:     id,
+		Status:     httpReq.Status,
+		AfterID:    httpReq.AfterID,
+		BeforeID:   httpReq.BeforeID,
+		Actor:      currentUser(r),
+		StartTimer: httpReq.StartTimer,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidID), errors.Is(err, usecases.ErrInvalidPosition):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, domain_entities.ErrMissingUser):
+			writeJSONError(w, http.StatusUnauthorized, err.Error())
+		case errors.Is(err, domain_entities.ErrTimerRunning), errors.Is(err, domain_entities.ErrOverlappingWork),
+			errors.Is(err, domain_entities.ErrWIPLimitReached):
+			writeJSONError(w, http.StatusConflict, err.Error())
+		case errors.Is(err, domain_entities.ErrInvalidInput):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, repo.ErrNotFound):
+			writeJSONError(w, http.StatusNotFound, err.Error())
+		default:
+			log.Printf("Move internal error: %v", err)
+			writeJSONError(w, http.StatusInternalServerError, "internal error")
+		}
+		return
+	}
+	writeUndoToken(w, token)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	presentation_dto "clean-architecture-golang/presentation/dto"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+)
+
+// BulkCreate handles POST /tasks/bulk.
+func (c *TaskController) BulkCreate(w http.ResponseWriter, r *http.Request) {
+	var httpReq presentation_dto.HttpBulkCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	req := dto.BulkCreateRequest{Mode: httpReq.Mode}
+	for _, task := range httpReq.Tasks {
+		req.Tasks = append(req.Tasks, dto.CreateTaskRequest{
+			Title:       task.Title,
+			Description: task.Description,
+			ProjectID:   task.ProjectID,
+			ParentID:    task.ParentID,
+			DueDate:     task.DueDate,
+			Timezone:    task.Timezone,
+			Recurrence:  task.Recurrence,
+			Priority:    task.Priority,
+		})
+	}
+	results, err := c.BulkCreateUC.Execute(req)
+	writeBulkResults(w, "BulkCreate", results, err, http.StatusOK)
+}
+
+// BulkUpdateStatus handles POST /tasks/bulk/status.
+func (c *TaskController) BulkUpdateStatus(w http.ResponseWriter, r *http.Request) {
+	var httpReq presentation_dto.HttpBulkUpdateStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	req := dto.BulkUpdateStatusRequest{Mode: httpReq.Mode}
+	for _, update := range httpReq.Updates {
+		req.Updates = append(req.Updates, dto.UpdateStatusRequest{
+			TaskID:     update.ID,
+			NewStatus:  update.NewStatus,
+			Actor:      currentUser(r),
+			StartTimer: update.StartTimer,
+		})
+	}
+	results, err := c.BulkUpdateStatusUC.Execute(req)
+	writeBulkResults(w, "BulkUpdateStatus", results, err, http.StatusNoContent)
+}
+
+// BulkDelete handles POST /tasks/bulk/delete.
+func (c *TaskController) BulkDelete(w http.ResponseWriter, r *http.Request) {
+	var httpReq presentation_dto.HttpBulkDeleteRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	results, err := c.BulkDeleteUC.Execute(dto.BulkDeleteRequest{Mode: httpReq.Mode, TaskIDs: httpReq.IDs})
+	writeBulkResults(w, "BulkDelete", results, err, http.StatusNoContent)
+}
+
+// writeBulkResults answers 200 when every item succeeded and 207 when only some did. When no item
+// was applied, the response carries the status of the first item that failed on its own.
+func writeBulkResults(w http.ResponseWriter, handler string, results []dto.BulkItemResult, err error, itemOK int) {
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidBulkMode), errors.Is(err, usecases.ErrInvalidBulkSize):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, usecases.ErrAtomicUnsupported):
+			writeJSONError(w, http.StatusNotImplemented, err.Error())
+		default:
+			log.Printf("%s internal error: %v", handler, err)
+			writeJSONError(w, http.StatusInternalServerError, "internal error")
+		}
+		return
+	}
+	response := presentation_dto.HttpBulkResponse{Results: make([]presentation_dto.HttpBulkItemResult, len(results))}
+	code := 0
+	for i, result := range results {
+		item := presentation_dto.HttpBulkItemResult{Index: result.Index, ID: result.TaskID, Status: itemOK}
+		if result.Err == nil {
+			response.Succeeded++
+		} else {
+			response.Failed++
+			item.Status = bulkItemStatus(handler, result.Err)
+			item.Error = result.Err.Error()
+			if code == 0 && item.Status != http.StatusFailedDependency {
+				code = item.Status
+			}
+		}
+		response.Results[i] = item
+	}
+	switch {
+	case response.Failed == 0:
+		code = http.StatusOK
+	case response.Succeeded > 0:
+		code = http.StatusMultiStatus
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(response)
+}
+
+// bulkItemStatus maps the error of a bulk item to the status of the single-task endpoints.
+func bulkItemStatus(handler string, err error) int {
+	switch {
+	case errors.Is(err, usecases.ErrNotApplied):
+		return http.StatusFailedDependency
+	case errors.Is(err, domain_entities.ErrMissingUser):
+		return http.StatusUnauthorized
+	case errors.Is(err, domain_entities.ErrTimerRunning), errors.Is(err, domain_entities.ErrOverlappingWork),
+		errors.Is(err, domain_entities.ErrWIPLimitReached):
+		return http.StatusConflict
+	case errors.Is(err, repo.ErrNotFound), errors.Is(err, repo.ErrProjectNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, usecases.ErrInvalidID), errors.Is(err, usecases.ErrInvalidDueDate),
+		errors.Is(err, domain_entities.ErrInvalidInput), errors.Is(err, domain_entities.ErrEmptyTitle),
+		errors.Is(err, domain_entities.ErrInvalidStatus), errors.Is(err, domain_entities.ErrInvalidTransition),
+		errors.Is(err, domain_entities.ErrParentDone), errors.Is(err, domain_entities.ErrSubtaskProject),
+		errors.Is(err, domain_entities.ErrOpenChildren), errors.Is(err, domain_entities.ErrParentClosed),
+		errors.Is(err, domain_entities.ErrBlocked),
+		errors.Is(err, domain_entities.ErrUncheckedItems), errors.Is(err, domain_entities.ErrRecurrenceWithoutDueDate),
+		errors.Is(err, value_objects.ErrInvalidRecurrence), errors.Is(err, value_objects.ErrInvalidPriority):
+		return http.StatusBadRequest
+	}
+	log.Printf("%s internal error: %v", handler, err)
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	presentation_dto "clean-architecture-golang/presentation/dto"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// checklistPath splits "/tasks/{id}/checklist[/{itemId}[/position]]" into its IDs.
+func checklistPath(r *http.Request) (taskID, itemID string) {
+	rest := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	taskID, itemID, _ = strings.Cut(rest, "/checklist")
+	itemID = strings.TrimPrefix(itemID, "/")
+	return taskID, strings.TrimSuffix(itemID, "/position")
+}
+
+// AddChecklistItem handles POST /tasks/{id}/checklist.
+func (c *TaskController) AddChecklistItem(w http.ResponseWriter, r *http.Request) {
+	taskID, _ := checklistPath(r)
+	var httpReq presentation_dto.HttpChecklistItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, token, err := c.AddChecklistItemUC.ExecuteUndoable(taskID, httpReq.Text)
+	if err != nil {
+		writeChecklistError(w, "AddChecklistItem", err)
+		return
+	}
+	writeUndoToken(w, token)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// CheckChecklistItem handles PUT /tasks/{id}/checklist/{itemId}.
+func (c *TaskController) CheckChecklistItem(w http.ResponseWriter, r *http.Request) {
+	taskID, itemID := checklistPath(r)
+	var httpReq presentation_dto.HttpCheckChecklistItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, token, err := c.CheckChecklistItemUC.ExecuteUndoable(taskID, itemID, httpReq.Checked)
+	if err != nil {
+		writeChecklistError(w, "CheckChecklistItem", err)
+		return
+	}
+	writeUndoToken(w, token)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// MoveChecklistItem handles PUT /tasks/{id}/checklist/{itemId}/position and returns the reordered checklist.
+func (c *TaskController) MoveChecklistItem(w http.ResponseWriter, r *http.Request) {
+	taskID, itemID := checklistPath(r)
+	var httpReq presentation_dto.HttpMoveChecklistItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	responses, token, err := c.MoveChecklistItemUC.ExecuteUndoable(taskID, itemID, httpReq.Position)
+	if err != nil {
+		writeChecklistError(w, "MoveChecklistItem", err)
+		return
+	}
+	writeUndoToken(w, token)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+// RemoveChecklistItem handles DELETE /tasks/{id}/checklist/{itemId}.
+func (c *TaskController) RemoveChecklistItem(w http.ResponseWriter, r *http.Request) {
+	taskID, itemID := checklistPath(r)
+	token, err := c.RemoveChecklistItemUC.ExecuteUndoable(taskID, itemID)
+	if err != nil {
+		writeChecklistError(w, "RemoveChecklistItem", err)
+		return
+	}
+	writeUndoToken(w, token)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeChecklistError(w http.ResponseWriter, handler string, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidID), errors.Is(err, domain_entities.ErrInvalidInput):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, repo.ErrNotFound), errors.Is(err, usecases.ErrChecklistItemNotFound):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	default:
+		log.Printf("%s internal error: %v", handler, err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+	}
+}
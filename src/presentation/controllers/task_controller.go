@@ -6,6 +6,7 @@ import (
 	"clean-architecture-golang/application/dto"
 	"clean-architecture-golang/application/usecases"
 	domain_entities "clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
 	repo "clean-architecture-golang/infrastructure/repositories"
 	presentation_dto "clean-architecture-golang/presentation/dto"
 	"encoding/json"
@@ -16,10 +17,52 @@ import (
 )
 
 type TaskController struct {
-	CreateTaskUC       *usecases.CreateTaskUseCase
-	UpdateStatusUC     *usecases.UpdateTaskStatusUseCase
-	GetTasksByStatusUC *usecases.GetTasksByStatusUseCase
-	DeleteTaskUC       *usecases.DeleteTaskUseCase
+	CreateTaskUC          *usecases.CreateTaskUseCase
+	UpdateStatusUC        *usecases.UpdateTaskStatusUseCase
+	GetTasksByStatusUC    *usecases.GetTasksByStatusUseCase
+	StatusCountsUC        *usecases.GetStatusCountsUseCase
+	DeleteTaskUC          *usecases.DeleteTaskUseCase
+	AddTagsUC             *usecases.AddTaskTagsUseCase
+	RemoveTagUC           *usecases.RemoveTaskTagUseCase
+	GetTasksByTagsUC      *usecases.GetTasksByTagsUseCase
+	GetTagStatsUC         *usecases.GetTagStatsUseCase
+	GetTaskUC             *usecases.GetTaskUseCase
+	GetTaskAsOfUC         *usecases.GetTaskAsOfUseCase
+	ListByStatusAsOfUC    *usecases.GetTasksByStatusAsOfUseCase
+	GetChildrenUC         *usecases.GetTaskChildrenUseCase
+	SetParentUC           *usecases.SetTaskParentUseCase
+	AddBlockerUC          *usecases.AddTaskBlockerUseCase
+	RemoveBlockerUC       *usecases.RemoveTaskBlockerUseCase
+	GetNextTasksUC        *usecases.GetNextTasksUseCase
+	SetRecurrenceUC       *usecases.SetTaskRecurrenceUseCase
+	SetPriorityUC         *usecases.SetTaskPriorityUseCase
+	StartTimerUC          *usecases.StartTimerUseCase
+	StopTimerUC           *usecases.StopTimerUseCase
+	LogWorkUC             *usecases.LogWorkUseCase
+	ListWorkLogUC         *usecases.ListWorkLogUseCase
+	TimeReportUC          *usecases.GetTimeReportUseCase
+	GetHistoryUC          *usecases.GetTaskHistoryUseCase
+	FlowAnalyticsUC       *usecases.GetFlowAnalyticsUseCase
+	MoveTaskUC            *usecases.MoveTaskUseCase
+	ListTrashUC           *usecases.ListTrashUseCase
+	RestoreTaskUC         *usecases.RestoreTaskUseCase
+	ArchiveTaskUC         *usecases.ArchiveTaskUseCase
+	ListArchivedUC        *usecases.ListArchivedUseCase
+	GetArchivedUC         *usecases.GetArchivedTaskUseCase
+	MoveToProjectUC       *usecases.MoveTaskToProjectUseCase
+	UploadAttachmentUC    *usecases.UploadAttachmentUseCase
+	ListAttachmentsUC     *usecases.ListAttachmentsUseCase
+	OpenAttachmentUC      *usecases.OpenAttachmentUseCase
+	DeleteAttachmentUC    *usecases.DeleteAttachmentUseCase
+	AddChecklistItemUC    *usecases.AddChecklistItemUseCase
+	CheckChecklistItemUC  *usecases.CheckChecklistItemUseCase
+	MoveChecklistItemUC   *usecases.MoveChecklistItemUseCase
+	RemoveChecklistItemUC *usecases.RemoveChecklistItemUseCase
+	SearchTasksUC         *usecases.SearchTasksUseCase
+	FilterTasksUC         *usecases.FilterTasksUseCase
+	BulkCreateUC          *usecases.BulkCreateTasksUseCase
+	BulkUpdateStatusUC    *usecases.BulkUpdateStatusUseCase
+	BulkDeleteUC          *usecases.BulkDeleteTasksUseCase
 }
 
 func writeJSONError(w http.ResponseWriter, code int, msg string) {
@@ -37,12 +80,28 @@ func (c *TaskController) Create(w http.ResponseWriter, r *http.Request) {
 	appReq := dto.CreateTaskRequest{
 		Title:       httpReq.Title,
 		Description: httpReq.Description,
+		ProjectID:   httpReq.ProjectID,
+		ParentID:    httpReq.ParentID,
+		DueDate:     httpReq.DueDate,
+		Timezone:    httpReq.Timezone,
+		Recurrence:  httpReq.Recurrence,
+		Priority:    httpReq.Priority,
 	}
 	response, err := c.CreateTaskUC.Execute(appReq)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain_entities.ErrEmptyTitle), errors.Is(err, domain_entities.ErrInvalidStatus):
 			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, usecases.ErrInvalidID), errors.Is(err, domain_entities.ErrParentDone),
+			errors.Is(err, domain_entities.ErrSubtaskProject):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, usecases.ErrInvalidDueDate), errors.Is(err, value_objects.ErrInvalidRecurrence),
+			errors.Is(err, domain_entities.ErrRecurrenceWithoutDueDate), errors.Is(err, value_objects.ErrInvalidPriority):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, repo.ErrNotFound), errors.Is(err, repo.ErrProjectNotFound):
+			writeJSONError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, domain_entities.ErrWIPLimitReached):
+			writeJSONError(w, http.StatusConflict, err.Error())
 		default:
 			log.Printf("Create internal error: %v", err)
 			writeJSONError(w, http.StatusInternalServerError, "internal error")
@@ -65,12 +124,24 @@ func (c *TaskController) UpdateStatus(w http.ResponseWriter, r *http.Request) {
 		writeJSONError(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	err := c.UpdateStatusUC.Execute(id, httpReq.NewStatus)
+	token, err := c.UpdateStatusUC.ExecuteUndoable(dto.UpdateStatusRequest{
+		TaskID:     id,
+		NewStatus:  httpReq.NewStatus,
+		Actor:      currentUser(r),
+		StartTimer: httpReq.StartTimer,
+	})
 	if err != nil {
 		switch {
 		case errors.Is(err, usecases.ErrInvalidID):
 			writeJSONError(w, http.StatusBadRequest, err.Error())
-		case errors.Is(err, domain_entities.ErrInvalidStatus), errors.Is(err, domain_entities.ErrInvalidTransition):
+		case errors.Is(err, domain_entities.ErrMissingUser):
+			writeJSONError(w, http.StatusUnauthorized, err.Error())
+		case errors.Is(err, domain_entities.ErrTimerRunning), errors.Is(err, domain_entities.ErrOverlappingWork),
+			errors.Is(err, domain_entities.ErrWIPLimitReached):
+			writeJSONError(w, http.StatusConflict, err.Error())
+		case errors.Is(err, domain_entities.ErrInvalidStatus), errors.Is(err, domain_entities.ErrInvalidTransition),
+			errors.Is(err, domain_entities.ErrOpenChildren), errors.Is(err, domain_entities.ErrParentClosed),
+			errors.Is(err, domain_entities.ErrBlocked), errors.Is(err, domain_entities.ErrUncheckedItems):
 			writeJSONError(w, http.StatusBadRequest, err.Error())
 		case errors.Is(err, repo.ErrNotFound):
 			writeJSONError(w, http.StatusNotFound, err.Error())
@@ -80,10 +151,23 @@ func (c *TaskController) UpdateStatus(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	writeUndoToken(w, token)
 	w.WriteHeader(http.StatusNoContent)
 }
 
 func (c *TaskController) ListByStatus(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Has("as_of") {
+		c.ListByStatusAsOf(w, r)
+		return
+	}
+	if r.URL.Query().Has("filter") {
+		c.ListByFilter(w, r)
+		return
+	}
+	if len(r.URL.Query()["tag"]) > 0 {
+		c.ListByTags(w, r)
+		return
+	}
 	status := r.URL.Query().Get("status")
 	if status == "" {
 		writeJSONError(w, http.StatusBadRequest, "status query param required")
@@ -104,13 +188,25 @@ func (c *TaskController) ListByStatus(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(responses)
 }
 
+// StatusCounts handles GET /tasks/counts.
+func (c *TaskController) StatusCounts(w http.ResponseWriter, r *http.Request) {
+	responses, err := c.StatusCountsUC.Execute()
+	if err != nil {
+		log.Printf("StatusCounts internal error: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
 func (c *TaskController) Delete(w http.ResponseWriter, r *http.Request) {
 	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
 	if id == "" {
 		writeJSONError(w, http.StatusBadRequest, "invalid id")
 		return
 	}
-	err := c.DeleteTaskUC.Execute(id)
+	token, err := c.DeleteTaskUC.ExecuteUndoable(id)
 	if err != nil {
 		switch {
 		case errors.Is(err, repo.ErrNotFound):
@@ -121,5 +217,6 @@ func (c *TaskController) Delete(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	writeUndoToken(w, token)
 	w.WriteHeader(http.StatusNoContent)
 }
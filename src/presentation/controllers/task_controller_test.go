@@ -3,11 +3,19 @@ package controllers_test
 import (
 	"bytes"
 	"encoding/json"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"clean-architecture-golang/domain/value_objects"
+	"clean-architecture-golang/infrastructure/repositories"
 	testutil "clean-architecture-golang/internal/testutil"
+	"clean-architecture-golang/presentation/controllers"
 )
 
 func TestCreate_EmptyTitle_Returns400JSON(t *testing.T) {
@@ -161,3 +169,1232 @@ func TestUpdateStatus_NotFound_Returns404JSON(t *testing.T) {
 		t.Fatalf("expected error field in response")
 	}
 }
+
+func TestTags_AddFilterAndStats(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	first := testutil.CreateTask(t, server.URL, "first", "")
+	second := testutil.CreateTask(t, server.URL, "second", "")
+	for id, tags := range map[string][]string{
+		first["ID"].(string):  {"Home", "urgent"},
+		second["ID"].(string): {"home"},
+	} {
+		body, _ := json.Marshal(map[string][]string{"tags": tags})
+		resp, err := http.Post(server.URL+"/tasks/"+id+"/tags", "application/json", bytes.NewBuffer(body))
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+	}
+
+	var list []map[string]interface{}
+	resp, err := http.Get(server.URL + "/tasks?tag=home&tag=urgent")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	json.NewDecoder(resp.Body).Decode(&list)
+	resp.Body.Close()
+	if len(list) != 1 || list[0]["Title"] != "first" {
+		t.Fatalf("expected only 'first' for AND filter, got %v", list)
+	}
+
+	resp, _ = http.Get(server.URL + "/tasks?tag=home&tag=urgent&match=any")
+	json.NewDecoder(resp.Body).Decode(&list)
+	resp.Body.Close()
+	if len(list) != 2 {
+		t.Fatalf("expected 2 tasks for OR filter, got %d", len(list))
+	}
+
+	var stats []map[string]interface{}
+	resp, _ = http.Get(server.URL + "/tags")
+	json.NewDecoder(resp.Body).Decode(&stats)
+	resp.Body.Close()
+	if len(stats) != 2 || stats[0]["Tag"] != "home" || stats[0]["Count"].(float64) != 2 {
+		t.Fatalf("unexpected tag stats: %v", stats)
+	}
+
+	req, _ := http.NewRequest("DELETE", server.URL+"/tasks/"+second["ID"].(string)+"/tags/home", nil)
+	delResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	delResp.Body.Close()
+	if delResp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", delResp.StatusCode)
+	}
+}
+
+func TestTags_InvalidTag_Returns400JSON(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	created := testutil.CreateTask(t, server.URL, "ok", "")
+	body, _ := json.Marshal(map[string][]string{"tags": {"not/valid"}})
+	resp, err := http.Post(server.URL+"/tasks/"+created["ID"].(string)+"/tags", "application/json", bytes.NewBuffer(body))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", resp.StatusCode)
+	}
+	var errResp map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+		t.Fatalf("failed decode: %v", err)
+	}
+	if _, ok := errResp["error"]; !ok {
+		t.Fatalf("expected error field in response")
+	}
+}
+
+func TestChildren_ListAndCompletion(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	parent := testutil.CreateTask(t, server.URL, "parent", "")
+	parentID := parent["ID"].(string)
+	body, _ := json.Marshal(map[string]string{"title": "child", "parentId": parentID})
+	resp, err := http.Post(server.URL+"/tasks", "application/json", bytes.NewBuffer(body))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	var child map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&child)
+	resp.Body.Close()
+	if child["ParentID"] != parentID {
+		t.Fatalf("expected child to reference parent, got %v", child["ParentID"])
+	}
+
+	updateJSON, _ := json.Marshal(map[string]string{"newStatus": "done"})
+	req, _ := http.NewRequest("PUT", server.URL+"/tasks/"+parentID+"/status", bytes.NewBuffer(updateJSON))
+	updateResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	updateResp.Body.Close()
+	if updateResp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 completing parent with open child, got %d", updateResp.StatusCode)
+	}
+
+	req, _ = http.NewRequest("PUT", server.URL+"/tasks/"+child["ID"].(string)+"/status", bytes.NewBuffer(updateJSON))
+	updateResp, _ = http.DefaultClient.Do(req)
+	updateResp.Body.Close()
+
+	var children []map[string]interface{}
+	resp, _ = http.Get(server.URL + "/tasks/" + parentID + "/children")
+	json.NewDecoder(resp.Body).Decode(&children)
+	resp.Body.Close()
+	if len(children) != 1 || children[0]["CompletionPercent"].(float64) != 100 {
+		t.Fatalf("unexpected children: %v", children)
+	}
+
+	var got map[string]interface{}
+	resp, _ = http.Get(server.URL + "/tasks/" + parentID)
+	json.NewDecoder(resp.Body).Decode(&got)
+	resp.Body.Close()
+	if got["CompletionPercent"].(float64) != 100 {
+		t.Fatalf("expected parent completion 100, got %v", got["CompletionPercent"])
+	}
+}
+
+func TestSetParent_Cycle_Returns400JSON(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	task := testutil.CreateTask(t, server.URL, "task", "")
+	id := task["ID"].(string)
+	body, _ := json.Marshal(map[string]string{"parentId": id})
+	req, _ := http.NewRequest("PUT", server.URL+"/tasks/"+id+"/parent", bytes.NewBuffer(body))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", resp.StatusCode)
+	}
+	var errResp map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+		t.Fatalf("failed decode: %v", err)
+	}
+	if _, ok := errResp["error"]; !ok {
+		t.Fatalf("expected error field in response")
+	}
+}
+
+func TestBlockers_BlockStartAndNext(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	task := testutil.CreateTask(t, server.URL, "task", "")
+	blocker := testutil.CreateTask(t, server.URL, "blocker", "")
+	taskID, blockerID := task["ID"].(string), blocker["ID"].(string)
+
+	body, _ := json.Marshal(map[string]string{"blockerId": blockerID})
+	resp, err := http.Post(server.URL+"/tasks/"+taskID+"/blockers", "application/json", bytes.NewBuffer(body))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+
+	updateJSON, _ := json.Marshal(map[string]string{"newStatus": "doing"})
+	req, _ := http.NewRequest("PUT", server.URL+"/tasks/"+taskID+"/status", bytes.NewBuffer(updateJSON))
+	updateResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	updateResp.Body.Close()
+	if updateResp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 starting a blocked task, got %d", updateResp.StatusCode)
+	}
+
+	var next []map[string]interface{}
+	resp, _ = http.Get(server.URL + "/tasks/next")
+	json.NewDecoder(resp.Body).Decode(&next)
+	resp.Body.Close()
+	if len(next) != 2 || next[0]["Task"].(map[string]interface{})["ID"] != blockerID || next[0]["Ready"] != true || next[1]["Ready"] != false {
+		t.Fatalf("unexpected next list: %v", next)
+	}
+
+	body, _ = json.Marshal(map[string]string{"blockerId": taskID})
+	resp, _ = http.Post(server.URL+"/tasks/"+blockerID+"/blockers", "application/json", bytes.NewBuffer(body))
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for dependency cycle, got %d", resp.StatusCode)
+	}
+
+	req, _ = http.NewRequest("DELETE", server.URL+"/tasks/"+taskID+"/blockers/"+blockerID, nil)
+	delResp, _ := http.DefaultClient.Do(req)
+	delResp.Body.Close()
+	if delResp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", delResp.StatusCode)
+	}
+}
+
+func TestSetRecurrence_InvalidRule_Returns400JSON(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	task := testutil.CreateTask(t, server.URL, "chores", "")
+	body, _ := json.Marshal(map[string]string{"dueDate": "2026-10-19T09:00:00Z", "recurrence": "FREQ=FORTNIGHTLY"})
+	req, _ := http.NewRequest("PUT", server.URL+"/tasks/"+task["ID"].(string)+"/recurrence", bytes.NewBuffer(body))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", resp.StatusCode)
+	}
+	var errResp map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+		t.Fatalf("failed decode: %v", err)
+	}
+	if _, ok := errResp["error"]; !ok {
+		t.Fatalf("expected error field in response")
+	}
+}
+
+func TestSetRecurrence_Success(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	task := testutil.CreateTask(t, server.URL, "chores", "")
+	id := task["ID"].(string)
+	body, _ := json.Marshal(map[string]string{"dueDate": "2026-10-19T09:00:00Z", "recurrence": "FREQ=WEEKLY;BYDAY=MO"})
+	req, _ := http.NewRequest("PUT", server.URL+"/tasks/"+id+"/recurrence", bytes.NewBuffer(body))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+
+	var got map[string]interface{}
+	resp, _ = http.Get(server.URL + "/tasks/" + id)
+	json.NewDecoder(resp.Body).Decode(&got)
+	resp.Body.Close()
+	if got["Recurrence"] != "FREQ=WEEKLY;BYDAY=MO" || got["DueDate"] != "2026-10-19T09:00:00Z" {
+		t.Fatalf("unexpected schedule: %v / %v", got["Recurrence"], got["DueDate"])
+	}
+}
+
+func TestComments_Lifecycle(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	task := testutil.CreateTask(t, server.URL, "review", "")
+	commentsURL := server.URL + "/tasks/" + task["ID"].(string) + "/comments"
+
+	body, _ := json.Marshal(map[string]string{"body": "first pass"})
+	req, _ := http.NewRequest("POST", commentsURL, bytes.NewBuffer(body))
+	req.Header.Set("X-User-ID", "alice")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	var comment map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&comment)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated || comment["Author"] != "alice" {
+		t.Fatalf("expected 201 with author alice, got %d %v", resp.StatusCode, comment)
+	}
+	commentURL := commentsURL + "/" + comment["ID"].(string)
+
+	body, _ = json.Marshal(map[string]string{"body": ""})
+	req, _ = http.NewRequest("PUT", commentURL, bytes.NewBuffer(body))
+	resp, _ = http.DefaultClient.Do(req)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for empty body, got %d", resp.StatusCode)
+	}
+
+	var list []map[string]interface{}
+	resp, _ = http.Get(commentsURL)
+	json.NewDecoder(resp.Body).Decode(&list)
+	resp.Body.Close()
+	if len(list) != 1 || list[0]["Body"] != "first pass" {
+		t.Fatalf("unexpected comments: %v", list)
+	}
+
+	req, _ = http.NewRequest("DELETE", commentURL, nil)
+	resp, _ = http.DefaultClient.Do(req)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+	req, _ = http.NewRequest("DELETE", commentURL, nil)
+	resp, _ = http.DefaultClient.Do(req)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 for deleted comment, got %d", resp.StatusCode)
+	}
+}
+
+func uploadAttachment(t *testing.T, serverURL, taskID, filename string, content []byte) *http.Response {
+	t.Helper()
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, _ := form.CreateFormFile("file", filename)
+	part.Write(content)
+	form.Close()
+	resp, err := http.Post(serverURL+"/tasks/"+taskID+"/attachments", form.FormDataContentType(), &body)
+	if err != nil {
+		t.Fatalf("upload failed: %v", err)
+	}
+	return resp
+}
+
+func TestAttachments_UploadAndRangeDownload(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	task := testutil.CreateTask(t, server.URL, "paint", "")
+	id := task["ID"].(string)
+	resp := uploadAttachment(t, server.URL, id, "notes.txt", []byte("two coats of white"))
+	var attachment map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&attachment)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated || attachment["Name"] != "notes.txt" {
+		t.Fatalf("expected 201 with attachment, got %d %v", resp.StatusCode, attachment)
+	}
+
+	req, _ := http.NewRequest("GET", server.URL+"/tasks/"+id+"/attachments/"+attachment["ID"].(string), nil)
+	req.Header.Set("Range", "bytes=4-8")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("download failed: %v", err)
+	}
+	data, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusPartialContent || string(data) != "coats" {
+		t.Fatalf("expected 206 with \"coats\", got %d %q", resp.StatusCode, data)
+	}
+	if cd := resp.Header.Get("Content-Disposition"); cd != `attachment; filename=notes.txt` {
+		t.Errorf("unexpected Content-Disposition %q", cd)
+	}
+
+	resp = uploadAttachment(t, server.URL, id, "big.bin", bytes.Repeat([]byte("x"), testutil.TestMaxBlobSize+1))
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected 413 for oversized upload, got %d", resp.StatusCode)
+	}
+
+	var got map[string]interface{}
+	resp, _ = http.Get(server.URL + "/tasks/" + id)
+	json.NewDecoder(resp.Body).Decode(&got)
+	resp.Body.Close()
+	if attachments, _ := got["Attachments"].([]interface{}); len(attachments) != 1 {
+		t.Fatalf("expected one attachment on the task, got %v", got["Attachments"])
+	}
+}
+
+func postAs(t *testing.T, url, user string, payload interface{}) *http.Response {
+	t.Helper()
+	body, _ := json.Marshal(payload)
+	req, _ := http.NewRequest("POST", url, bytes.NewBuffer(body))
+	if user != "" {
+		req.Header.Set("X-User-ID", user)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	return resp
+}
+
+func TestTimeTracking_TimersWorkLogAndReport(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	task := testutil.CreateTask(t, server.URL, "paint", "")
+	taskURL := server.URL + "/tasks/" + task["ID"].(string)
+
+	resp := postAs(t, taskURL+"/timer/start", "", nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without a user, got %d", resp.StatusCode)
+	}
+	resp = postAs(t, taskURL+"/timer/start", "alice", nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	resp = postAs(t, taskURL+"/timer/start", "alice", nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected 409 for a second timer, got %d", resp.StatusCode)
+	}
+	resp = postAs(t, taskURL+"/timer/stop", "alice", nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	resp = postAs(t, taskURL+"/worklog", "bob", map[string]string{"start": "2026-10-05T08:00:00Z", "end": "2026-10-05T09:30:00Z"})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	var report map[string]interface{}
+	resp, _ = http.Get(server.URL + "/reports/time?user=bob&from=2026-10-05&to=2026-10-05")
+	json.NewDecoder(resp.Body).Decode(&report)
+	resp.Body.Close()
+	if report["TotalSeconds"] != float64(5400) {
+		t.Fatalf("expected 5400 seconds for bob, got %v", report)
+	}
+}
+
+func TestHistory_RecordsActorAndDerivedTimestamps(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	task := testutil.CreateTask(t, server.URL, "paint", "")
+	taskURL := server.URL + "/tasks/" + task["ID"].(string)
+	for _, status := range []string{"doing", "done"} {
+		body, _ := json.Marshal(map[string]string{"newStatus": status})
+		req, _ := http.NewRequest("PUT", taskURL+"/status", bytes.NewBuffer(body))
+		req.Header.Set("X-User-ID", "alice")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	var history []map[string]interface{}
+	resp, _ := http.Get(taskURL + "/history")
+	json.NewDecoder(resp.Body).Decode(&history)
+	resp.Body.Close()
+	if len(history) != 2 || history[1]["From"] != "doing" || history[1]["To"] != "done" || history[1]["Actor"] != "alice" {
+		t.Fatalf("unexpected history: %v", history)
+	}
+
+	var got map[string]interface{}
+	resp, _ = http.Get(taskURL)
+	json.NewDecoder(resp.Body).Decode(&got)
+	resp.Body.Close()
+	if got["StartedAt"] != history[0]["At"] || got["CompletedAt"] != history[1]["At"] {
+		t.Fatalf("expected derived timestamps from history, got %v / %v", got["StartedAt"], got["CompletedAt"])
+	}
+}
+
+func TestFlowAnalytics_JSONAndCSV(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	testutil.CreateTask(t, server.URL, "paint", "")
+
+	var report map[string]interface{}
+	resp, _ := http.Get(server.URL + "/analytics/flow")
+	json.NewDecoder(resp.Body).Decode(&report)
+	resp.Body.Close()
+	if flow, _ := report["CumulativeFlow"].([]interface{}); len(flow) != 28 {
+		t.Fatalf("expected 28 days by default, got %v", report["CumulativeFlow"])
+	}
+
+	resp, _ = http.Get(server.URL + "/analytics/flow?format=csv&dataset=throughput&from=2026-10-05&to=2026-10-18")
+	data, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.Header.Get("Content-Type") != "text/csv; charset=utf-8" ||
+		string(data) != "week_start,completed\n2026-10-05,0\n2026-10-12,0\n" {
+		t.Fatalf("unexpected CSV %q (%s)", data, resp.Header.Get("Content-Type"))
+	}
+
+	resp, _ = http.Get(server.URL + "/analytics/flow?format=csv&dataset=bogus")
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for unknown dataset, got %d", resp.StatusCode)
+	}
+}
+
+func TestMove_ReordersAndMovesAcrossColumns(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	a := testutil.CreateTask(t, server.URL, "a", "")["ID"].(string)
+	b := testutil.CreateTask(t, server.URL, "b", "")["ID"].(string)
+	move := func(id string, payload map[string]string) int {
+		body, _ := json.Marshal(payload)
+		req, _ := http.NewRequest("PUT", server.URL+"/tasks/"+id+"/position", bytes.NewBuffer(body))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	column := func(status string) []string {
+		var tasks []map[string]interface{}
+		resp, _ := http.Get(server.URL + "/tasks?status=" + status)
+		json.NewDecoder(resp.Body).Decode(&tasks)
+		resp.Body.Close()
+		titles := make([]string, len(tasks))
+		for i, task := range tasks {
+			titles[i] = task["Title"].(string)
+		}
+		return titles
+	}
+
+	if code := move(b, map[string]string{"beforeId": a}); code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", code)
+	}
+	if got := column("todo"); len(got) != 2 || got[0] != "b" || got[1] != "a" {
+		t.Fatalf("expected [b a], got %v", got)
+	}
+	if code := move(a, map[string]string{"status": "doing"}); code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", code)
+	}
+	if got := column("doing"); len(got) != 1 || got[0] != "a" {
+		t.Fatalf("expected [a] in doing, got %v", got)
+	}
+	if code := move(b, map[string]string{"afterId": a}); code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for a neighbour in another column, got %d", code)
+	}
+}
+
+func TestUpdateStatus_WIPLimitReached_Returns409JSON(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	start := func(id, user string) *http.Response {
+		body, _ := json.Marshal(map[string]string{"newStatus": "doing"})
+		req, _ := http.NewRequest("PUT", server.URL+"/tasks/"+id+"/status", bytes.NewBuffer(body))
+		req.Header.Set("X-User-ID", user)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		return resp
+	}
+	for i := 0; i < testutil.TestDoingLimit; i++ {
+		resp := start(testutil.CreateTask(t, server.URL, "t", "")["ID"].(string), "alice")
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("expected 204, got %d", resp.StatusCode)
+		}
+	}
+
+	extra := testutil.CreateTask(t, server.URL, "extra", "")["ID"].(string)
+	resp := start(extra, "alice")
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected 409, got %d", resp.StatusCode)
+	}
+	var errResp map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil || errResp["error"] == "" {
+		t.Fatalf("expected error field in response (err=%v)", err)
+	}
+
+	other := start(extra, "bob")
+	other.Body.Close()
+	if other.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected the limit to apply per user, got %d", other.StatusCode)
+	}
+}
+
+func TestTrash_DeleteListAndRestore(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	id := testutil.CreateTask(t, server.URL, "paint", "")["ID"].(string)
+	req, _ := http.NewRequest("DELETE", server.URL+"/tasks/"+id, nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+
+	resp, _ = http.Get(server.URL + "/tasks/" + id)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected trashed task to be hidden, got %d", resp.StatusCode)
+	}
+
+	var trash []map[string]interface{}
+	resp, _ = http.Get(server.URL + "/trash")
+	json.NewDecoder(resp.Body).Decode(&trash)
+	resp.Body.Close()
+	if len(trash) != 1 || trash[0]["ID"] != id || trash[0]["DeletedAt"] == "" {
+		t.Fatalf("unexpected trash: %v", trash)
+	}
+
+	resp, _ = http.Post(server.URL+"/tasks/"+id+"/restore", "application/json", nil)
+	var restored map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&restored)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || restored["ID"] != id || restored["DeletedAt"] != "" {
+		t.Fatalf("unexpected restore response %d: %v", resp.StatusCode, restored)
+	}
+
+	resp, _ = http.Post(server.URL+"/tasks/"+id+"/restore", "application/json", nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 for a task not in the trash, got %d", resp.StatusCode)
+	}
+}
+
+func TestArchive_ManualArchiveAndLookup(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	id := testutil.CreateTask(t, server.URL, "paint", "")["ID"].(string)
+	archive := func() int {
+		resp, err := http.Post(server.URL+"/tasks/"+id+"/archive", "application/json", nil)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	if code := archive(); code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for an open task, got %d", code)
+	}
+	for _, status := range []string{"doing", "done"} {
+		body, _ := json.Marshal(map[string]string{"newStatus": status})
+		req, _ := http.NewRequest("PUT", server.URL+"/tasks/"+id+"/status", bytes.NewBuffer(body))
+		resp, _ := http.DefaultClient.Do(req)
+		resp.Body.Close()
+	}
+	if code := archive(); code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", code)
+	}
+
+	var done []map[string]interface{}
+	resp, _ := http.Get(server.URL + "/tasks?status=done")
+	json.NewDecoder(resp.Body).Decode(&done)
+	resp.Body.Close()
+	if len(done) != 0 {
+		t.Fatalf("expected archived task to leave the done column, got %v", done)
+	}
+
+	var archived []map[string]interface{}
+	resp, _ = http.Get(server.URL + "/archive")
+	json.NewDecoder(resp.Body).Decode(&archived)
+	resp.Body.Close()
+	if len(archived) != 1 || archived[0]["ID"] != id {
+		t.Fatalf("unexpected archive: %v", archived)
+	}
+
+	var got map[string]interface{}
+	resp, _ = http.Get(server.URL + "/archive/" + id)
+	json.NewDecoder(resp.Body).Decode(&got)
+	resp.Body.Close()
+	if history, _ := got["History"].([]interface{}); len(history) != 2 || got["ArchivedAt"] == "" {
+		t.Fatalf("expected archived task with history, got %v", got)
+	}
+}
+
+func TestProjects_CreateListTasksAndDelete(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"name":     "Home",
+		"settings": map[string]interface{}{"defaultTags": []string{"home"}},
+	})
+	resp, err := http.Post(server.URL+"/projects", "application/json", bytes.NewBuffer(body))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	var project map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&project)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	projectID := project["ID"].(string)
+
+	body, _ = json.Marshal(map[string]string{"title": "paint", "projectId": projectID})
+	resp, _ = http.Post(server.URL+"/tasks", "application/json", bytes.NewBuffer(body))
+	var task map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&task)
+	resp.Body.Close()
+	if tags, _ := task["Tags"].([]interface{}); task["ProjectID"] != projectID || len(tags) != 1 {
+		t.Fatalf("expected task in project with default tag, got %v", task)
+	}
+	testutil.CreateTask(t, server.URL, "inbox", "")
+
+	var tasks []map[string]interface{}
+	resp, _ = http.Get(server.URL + "/projects/" + projectID + "/tasks")
+	json.NewDecoder(resp.Body).Decode(&tasks)
+	resp.Body.Close()
+	if len(tasks) != 1 || tasks[0]["ID"] != task["ID"] {
+		t.Fatalf("unexpected project tasks: %v", tasks)
+	}
+
+	req, _ := http.NewRequest("DELETE", server.URL+"/projects/"+projectID, nil)
+	resp, _ = http.DefaultClient.Do(req)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected 409 for a project with tasks, got %d", resp.StatusCode)
+	}
+
+	body, _ = json.Marshal(map[string]string{"projectId": ""})
+	req, _ = http.NewRequest("PUT", server.URL+"/tasks/"+task["ID"].(string)+"/project", bytes.NewBuffer(body))
+	resp, _ = http.DefaultClient.Do(req)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+	req, _ = http.NewRequest("DELETE", server.URL+"/projects/"+projectID, nil)
+	resp, _ = http.DefaultClient.Do(req)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+	resp, _ = http.Get(server.URL + "/projects/" + projectID)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 after delete, got %d", resp.StatusCode)
+	}
+}
+
+func TestChecklist_AddToggleReorderAndComplete(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	id := testutil.CreateTask(t, server.URL, "pack", "")["ID"].(string)
+	addItem := func(text string) map[string]interface{} {
+		body, _ := json.Marshal(map[string]string{"text": text})
+		resp, err := http.Post(server.URL+"/tasks/"+id+"/checklist", "application/json", bytes.NewBuffer(body))
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", resp.StatusCode)
+		}
+		var item map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&item)
+		return item
+	}
+	passport := addItem("passport")["ID"].(string)
+	charger := addItem("charger")["ID"].(string)
+
+	body, _ := json.Marshal(map[string]int{"position": 0})
+	req, _ := http.NewRequest("PUT", server.URL+"/tasks/"+id+"/checklist/"+charger+"/position", bytes.NewBuffer(body))
+	resp, _ := http.DefaultClient.Do(req)
+	var list []map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&list)
+	resp.Body.Close()
+	if len(list) != 2 || list[0]["ID"] != charger {
+		t.Fatalf("unexpected reordered checklist: %v", list)
+	}
+
+	setStatus := func(status string) int {
+		body, _ := json.Marshal(map[string]string{"newStatus": status})
+		req, _ := http.NewRequest("PUT", server.URL+"/tasks/"+id+"/status", bytes.NewBuffer(body))
+		resp, _ := http.DefaultClient.Do(req)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	setStatus("doing")
+	if code := setStatus("done"); code != http.StatusBadRequest {
+		t.Fatalf("expected 400 with unchecked items, got %d", code)
+	}
+
+	for _, item := range []string{passport, charger} {
+		body, _ := json.Marshal(map[string]bool{"checked": true})
+		req, _ := http.NewRequest("PUT", server.URL+"/tasks/"+id+"/checklist/"+item, bytes.NewBuffer(body))
+		resp, _ := http.DefaultClient.Do(req)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+	}
+	if code := setStatus("done"); code != http.StatusNoContent {
+		t.Fatalf("expected 204 once all items are checked, got %d", code)
+	}
+
+	req, _ = http.NewRequest("DELETE", server.URL+"/tasks/"+id+"/checklist/"+passport, nil)
+	resp, _ = http.DefaultClient.Do(req)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+	var task map[string]interface{}
+	resp, _ = http.Get(server.URL + "/tasks/" + id)
+	json.NewDecoder(resp.Body).Decode(&task)
+	resp.Body.Close()
+	if checklist, _ := task["Checklist"].([]interface{}); len(checklist) != 1 {
+		t.Fatalf("expected one checklist item left, got %v", task["Checklist"])
+	}
+}
+
+func TestSearch_ReturnsRankedHighlightedTasks(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	fence := testutil.CreateTask(t, server.URL, "Paint the fence", "")["ID"].(string)
+	testutil.CreateTask(t, server.URL, "Clean the garage", "Move the paint cans")
+	testutil.CreateTask(t, server.URL, "Call the plumber", "")
+
+	var results []map[string]interface{}
+	resp, err := http.Get(server.URL + "/tasks/search?q=paint&limit=5")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	json.NewDecoder(resp.Body).Decode(&results)
+	resp.Body.Close()
+	if len(results) != 2 || results[0]["ID"] != fence || results[1]["Snippet"] != "Move the <mark>paint</mark> cans" {
+		t.Fatalf("unexpected results: %v", results)
+	}
+
+	for _, query := range []string{"?q=", "?q=paint&limit=zero"} {
+		resp, _ := http.Get(server.URL + "/tasks/search" + query)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("expected 400 for %q, got %d", query, resp.StatusCode)
+		}
+	}
+}
+
+func TestListByFilter_FiltersAndReportsSyntaxErrors(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	paint := testutil.CreateTask(t, server.URL, "paint", "")["ID"].(string)
+	testutil.CreateTask(t, server.URL, "mow", "")
+	body, _ := json.Marshal(map[string]interface{}{"tags": []string{"home"}})
+	resp, _ := http.Post(server.URL+"/tasks/"+paint+"/tags", "application/json", bytes.NewBuffer(body))
+	resp.Body.Close()
+
+	var tasks []map[string]interface{}
+	resp, err := http.Get(server.URL + "/tasks?filter=" + url.QueryEscape("status:todo AND tag:home"))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	json.NewDecoder(resp.Body).Decode(&tasks)
+	resp.Body.Close()
+	if len(tasks) != 1 || tasks[0]["ID"] != paint {
+		t.Fatalf("unexpected tasks: %v", tasks)
+	}
+
+	var syntaxErr map[string]interface{}
+	resp, _ = http.Get(server.URL + "/tasks?filter=" + url.QueryEscape("status:todo AND (tag:home"))
+	json.NewDecoder(resp.Body).Decode(&syntaxErr)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest || syntaxErr["position"] != float64(26) {
+		t.Fatalf("expected 400 with the error position, got %d %v", resp.StatusCode, syntaxErr)
+	}
+}
+
+func TestSavedViews_SaveRunAndDelete(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	paint := testutil.CreateTask(t, server.URL, "paint", "")["ID"].(string)
+	testutil.CreateTask(t, server.URL, "mow", "")
+	do := func(method, path, user string, payload interface{}) *http.Response {
+		var body io.Reader
+		if payload != nil {
+			b, _ := json.Marshal(payload)
+			body = bytes.NewBuffer(b)
+		}
+		req, _ := http.NewRequest(method, server.URL+path, body)
+		if user != "" {
+			req.Header.Set("X-User-ID", user)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		return resp
+	}
+
+	view := map[string]string{"filter": "title:pai", "sort": "-created"}
+	if resp := do("PUT", "/views/paint-jobs", "alice", view); resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	if resp := do("PUT", "/views/paint-jobs", "alice", view); resp.StatusCode != http.StatusOK {
+		t.Errorf("expected 200 when replacing, got %d", resp.StatusCode)
+	}
+	if resp := do("PUT", "/views/paint-jobs", "", view); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected 401 without a user, got %d", resp.StatusCode)
+	}
+	var syntaxErr map[string]interface{}
+	resp := do("PUT", "/views/broken", "alice", map[string]string{"filter": "status:"})
+	json.NewDecoder(resp.Body).Decode(&syntaxErr)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest || syntaxErr["position"] == nil {
+		t.Errorf("expected 400 with the error position, got %d %v", resp.StatusCode, syntaxErr)
+	}
+
+	var tasks []map[string]interface{}
+	resp = do("GET", "/views/paint-jobs/tasks", "alice", nil)
+	json.NewDecoder(resp.Body).Decode(&tasks)
+	resp.Body.Close()
+	if len(tasks) != 1 || tasks[0]["ID"] != paint {
+		t.Fatalf("unexpected tasks: %v", tasks)
+	}
+	if resp := do("GET", "/views/paint-jobs", "bob", nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected views to be private, got %d", resp.StatusCode)
+	}
+
+	var views []map[string]interface{}
+	resp = do("GET", "/views", "alice", nil)
+	json.NewDecoder(resp.Body).Decode(&views)
+	resp.Body.Close()
+	if len(views) != 1 || views[0]["Filter"] != "title:pai" || views[0]["Sort"] != "-created" {
+		t.Fatalf("unexpected views: %v", views)
+	}
+
+	if resp := do("DELETE", "/views/paint-jobs", "alice", nil); resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+	if resp := do("GET", "/views/paint-jobs/tasks", "alice", nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404 after delete, got %d", resp.StatusCode)
+	}
+}
+
+func TestBulk_CreateUpdateAndDelete(t *testing.T) {
+	server, repo := testutil.SetupTestServer()
+	defer server.Close()
+
+	post := func(path string, payload interface{}) (*http.Response, map[string]interface{}) {
+		body, _ := json.Marshal(payload)
+		resp, err := http.Post(server.URL+path, "application/json", bytes.NewBuffer(body))
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		defer resp.Body.Close()
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		return resp, result
+	}
+	item := func(result map[string]interface{}, i int) map[string]interface{} {
+		return result["results"].([]interface{})[i].(map[string]interface{})
+	}
+
+	tasks := []map[string]string{{"title": "paint"}, {"title": ""}, {"title": "mow"}}
+	resp, result := post("/tasks/bulk", map[string]interface{}{"tasks": tasks})
+	if resp.StatusCode != http.StatusBadRequest || result["succeeded"] != float64(0) ||
+		item(result, 1)["status"] != float64(http.StatusBadRequest) || item(result, 0)["status"] != float64(http.StatusFailedDependency) {
+		t.Fatalf("expected the atomic request to fail on the empty title, got %d %v", resp.StatusCode, result)
+	}
+	resp, result = post("/tasks/bulk", map[string]interface{}{"mode": "best-effort", "tasks": tasks})
+	if resp.StatusCode != http.StatusMultiStatus || result["succeeded"] != float64(2) || result["failed"] != float64(1) {
+		t.Fatalf("expected a partial success, got %d %v", resp.StatusCode, result)
+	}
+	paint, mow := item(result, 0)["id"].(string), item(result, 2)["id"].(string)
+
+	updates := []map[string]string{{"id": paint, "newStatus": "doing"}, {"id": mow, "newStatus": "doing"}}
+	resp, result = post("/tasks/bulk/status", map[string]interface{}{"updates": updates})
+	if resp.StatusCode != http.StatusOK || item(result, 1)["status"] != float64(http.StatusNoContent) {
+		t.Fatalf("expected both moves to succeed, got %d %v", resp.StatusCode, result)
+	}
+	if task, _ := repo.FindById(value_objects.TaskId(mow)); task.Status != value_objects.StatusDoing {
+		t.Errorf("expected the task to be doing, got %s", task.Status)
+	}
+
+	resp, result = post("/tasks/bulk/delete", map[string]interface{}{"ids": []string{paint, mow}})
+	if resp.StatusCode != http.StatusOK || result["succeeded"] != float64(2) {
+		t.Fatalf("expected both deletes to succeed, got %d %v", resp.StatusCode, result)
+	}
+	if resp, _ := post("/tasks/bulk/delete", map[string]interface{}{"ids": []string{}}); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected 400 for an empty request, got %d", resp.StatusCode)
+	}
+}
+
+func TestCreate_IdempotencyKeyReplaysFirstResponse(t *testing.T) {
+	server, repo := testutil.SetupTestServer()
+	defer server.Close()
+
+	create := func(user, key, title string) (*http.Response, string) {
+		body, _ := json.Marshal(map[string]string{"title": title})
+		req, _ := http.NewRequest("POST", server.URL+"/tasks", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", key)
+		if user != "" {
+			req.Header.Set("X-User-ID", user)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		return resp, string(b)
+	}
+
+	first, firstBody := create("alice", "k1", "paint")
+	replay, replayBody := create("alice", "k1", "paint")
+	if first.StatusCode != http.StatusOK || replay.StatusCode != first.StatusCode || replayBody != firstBody {
+		t.Fatalf("expected an identical replay, got %d %s and %d %s", first.StatusCode, firstBody, replay.StatusCode, replayBody)
+	}
+	if replay.Header.Get("Idempotent-Replayed") != "true" {
+		t.Errorf("expected the replay to be flagged")
+	}
+	if todo, _ := repo.FindByStatus(value_objects.StatusTodo); len(todo) != 1 {
+		t.Fatalf("expected a single task, got %d", len(todo))
+	}
+
+	if resp, _ := create("alice", "k1", "mow"); resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("expected 422 for a different payload, got %d", resp.StatusCode)
+	}
+	if resp, _ := create("bob", "k1", "mow"); resp.StatusCode != http.StatusOK {
+		t.Errorf("expected keys to be scoped by user, got %d", resp.StatusCode)
+	}
+
+	// Error responses are replayed too.
+	invalid, invalidBody := create("alice", "k2", "")
+	again, againBody := create("alice", "k2", "")
+	if invalid.StatusCode != http.StatusBadRequest || again.StatusCode != http.StatusBadRequest || againBody != invalidBody {
+		t.Errorf("expected the 400 to be replayed, got %d %s", again.StatusCode, againBody)
+	}
+}
+
+func TestIdempotencyGuard_ReleasesKeyWhenHandlerPanics(t *testing.T) {
+	guard := &controllers.IdempotencyGuard{Store: repositories.NewInMemoryIdempotencyStore(time.Hour)}
+	calls := 0
+	handler := guard.Wrap(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			panic("boom")
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	serve := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/tasks", strings.NewReader(`{"title":"paint"}`))
+		req.Header.Set("Idempotency-Key", "k1")
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected the panic to go on")
+			}
+		}()
+		serve()
+	}()
+	if rec := serve(); rec.Code != http.StatusCreated || calls != 2 {
+		t.Errorf("expected the retry to run the handler, got %d after %d calls", rec.Code, calls)
+	}
+}
+
+func TestUndo_RevertsDeletesAndStatusChanges(t *testing.T) {
+	server, repo := testutil.SetupTestServer()
+	defer server.Close()
+
+	send := func(method, path string, payload interface{}) *http.Response {
+		var body io.Reader
+		if payload != nil {
+			b, _ := json.Marshal(payload)
+			body = bytes.NewBuffer(b)
+		}
+		req, _ := http.NewRequest(method, server.URL+path, body)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-User-ID", "alice")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	id := testutil.CreateTask(t, server.URL, "paint", "")["ID"].(string)
+	deleted := send("DELETE", "/tasks/"+id, nil)
+	token := deleted.Header.Get("Undo-Token")
+	if deleted.StatusCode != http.StatusNoContent || token == "" {
+		t.Fatalf("expected the delete to return an undo token, got %d %q", deleted.StatusCode, token)
+	}
+	undone := send("POST", "/undo/"+token, nil)
+	if undone.StatusCode != http.StatusNoContent || undone.Header.Get("Undo-Token") == "" {
+		t.Fatalf("expected the undo to return a redo token, got %d", undone.StatusCode)
+	}
+	if _, err := repo.FindById(value_objects.TaskId(id)); err != nil {
+		t.Fatalf("expected the task to be restored, got %v", err)
+	}
+	if resp := send("POST", "/undo/"+token, nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404 for a used token, got %d", resp.StatusCode)
+	}
+
+	moved := send("PUT", "/tasks/"+id+"/status", map[string]string{"newStatus": "doing"})
+	token = moved.Header.Get("Undo-Token")
+	if moved.StatusCode != http.StatusNoContent || token == "" {
+		t.Fatalf("expected the status change to return an undo token, got %d", moved.StatusCode)
+	}
+	if resp := send("POST", "/undo/"+token, nil); resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected the undo to succeed, got %d", resp.StatusCode)
+	}
+	if task, _ := repo.FindById(value_objects.TaskId(id)); task.Status != value_objects.StatusTodo {
+		t.Errorf("expected the task to be back in todo, got %s", task.Status)
+	}
+
+	tagged := send("POST", "/tasks/"+id+"/tags", map[string][]string{"tags": []string{"home"}})
+	token = tagged.Header.Get("Undo-Token")
+	send("PUT", "/tasks/"+id+"/status", map[string]string{"newStatus": "doing"})
+	if resp := send("POST", "/undo/"+token, nil); resp.StatusCode != http.StatusConflict {
+		t.Errorf("expected 409 after a later change, got %d", resp.StatusCode)
+	}
+	if resp := send("GET", "/undo/"+token, nil); resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405, got %d", resp.StatusCode)
+	}
+}
+
+func TestUndo_RevertsArchivingAndChecklistEdits(t *testing.T) {
+	server, repo := testutil.SetupTestServer()
+	defer server.Close()
+
+	send := func(method, path string, payload interface{}) *http.Response {
+		b, _ := json.Marshal(payload)
+		req, _ := http.NewRequest(method, server.URL+path, bytes.NewBuffer(b))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	id := testutil.CreateTask(t, server.URL, "paint", "")["ID"].(string)
+	added := send("POST", "/tasks/"+id+"/checklist", map[string]string{"text": "buy brush"})
+	if added.StatusCode != http.StatusCreated || added.Header.Get("Undo-Token") == "" {
+		t.Fatalf("expected the checklist edit to return an undo token, got %d", added.StatusCode)
+	}
+	if resp := send("POST", "/undo/"+added.Header.Get("Undo-Token"), nil); resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected the undo to succeed, got %d", resp.StatusCode)
+	}
+	if task, _ := repo.FindById(value_objects.TaskId(id)); len(task.Checklist) != 0 {
+		t.Errorf("expected the item to be removed, got %+v", task.Checklist)
+	}
+
+	send("PUT", "/tasks/"+id+"/status", map[string]string{"newStatus": "done"})
+	archived := send("POST", "/tasks/"+id+"/archive", nil)
+	if archived.StatusCode != http.StatusNoContent || archived.Header.Get("Undo-Token") == "" {
+		t.Fatalf("expected archiving to return an undo token, got %d", archived.StatusCode)
+	}
+	if resp := send("POST", "/undo/"+archived.Header.Get("Undo-Token"), nil); resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected the undo to succeed, got %d", resp.StatusCode)
+	}
+	if task, err := repo.FindById(value_objects.TaskId(id)); err != nil || task.ArchivedAt != nil {
+		t.Errorf("expected the task to be active again, got %+v %v", task, err)
+	}
+}
+
+func TestStatusCountsAndReadModelRebuild(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+	testutil.CreateTask(t, server.URL, "paint", "")
+
+	resp, err := http.Get(server.URL + "/tasks/counts")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	var counts []map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&counts)
+	if resp.StatusCode != http.StatusOK || len(counts) != 3 || counts[0]["Status"] != "todo" || counts[0]["Count"] != float64(1) {
+		t.Fatalf("expected the counts per status, got %d %v", resp.StatusCode, counts)
+	}
+
+	rebuild, err := http.Post(server.URL+"/read-model/rebuild", "application/json", nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	rebuild.Body.Close()
+	if rebuild.StatusCode != http.StatusNoContent {
+		t.Errorf("expected 204, got %d", rebuild.StatusCode)
+	}
+}
+
+func TestAsOf_ReturnsTasksAsTheyWere(t *testing.T) {
+	server, _ := testutil.SetupTestServer()
+	defer server.Close()
+
+	get := func(path string) (*http.Response, string) {
+		resp, err := http.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		return resp, string(b)
+	}
+
+	before := time.Now().UTC().Format(time.RFC3339Nano)
+	id := testutil.CreateTask(t, server.URL, "paint", "")["ID"].(string)
+	created := time.Now().UTC().Format(time.RFC3339Nano)
+	body, _ := json.Marshal(map[string]string{"newStatus": "doing"})
+	req, _ := http.NewRequest("PUT", server.URL+"/tasks/"+id+"/status", bytes.NewBuffer(body))
+	req.Header.Set("X-User-ID", "alice")
+	if resp, err := http.DefaultClient.Do(req); err != nil || resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("status change failed: %v", err)
+	}
+
+	resp, text := get("/tasks/" + id + "?as_of=" + url.QueryEscape(created))
+	if resp.StatusCode != http.StatusOK || !strings.Contains(text, `"Status":"todo"`) {
+		t.Errorf("expected the task as it was before the status change, got %d %s", resp.StatusCode, text)
+	}
+	if resp, _ := get("/tasks/" + id + "?as_of=" + url.QueryEscape(before)); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404 before the task existed, got %d", resp.StatusCode)
+	}
+	if _, text := get("/tasks?status=doing&as_of=" + url.QueryEscape(created)); text != "[]\n" {
+		t.Errorf("expected an empty doing column at that time, got %s", text)
+	}
+	if _, text := get("/tasks?status=todo&as_of=" + url.QueryEscape(created)); !strings.Contains(text, id) {
+		t.Errorf("expected the task in the todo column at that time, got %s", text)
+	}
+	if resp, _ := get("/tasks/" + id + "?as_of=yesterday"); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected 400 for an invalid timestamp, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	presentation_dto "clean-architecture-golang/presentation/dto"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// AddBlocker handles POST /tasks/{id}/blockers.
+func (c *TaskController) AddBlocker(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/blockers")
+	var httpReq presentation_dto.HttpAddBlockerRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	token, err := c.AddBlockerUC.ExecuteUndoable(id, httpReq.BlockerID)
+	if err != nil {
+		writeDependencyError(w, "AddBlocker", err)
+		return
+	}
+	writeUndoToken(w, token)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveBlocker handles DELETE /tasks/{id}/blockers/{blockerId}.
+func (c *TaskController) RemoveBlocker(w http.ResponseWriter, r *http.Request) {
+	rest := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id, blockerID, _ := strings.Cut(rest, "/blockers/")
+	token, err := c.RemoveBlockerUC.ExecuteUndoable(id, blockerID)
+	if err != nil {
+		writeDependencyError(w, "RemoveBlocker", err)
+		return
+	}
+	writeUndoToken(w, token)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Next handles GET /tasks/next.
+func (c *TaskController) Next(w http.ResponseWriter, r *http.Request) {
+	responses, err := c.GetNextTasksUC.Execute()
+	if err != nil {
+		log.Printf("Next internal error: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+func writeDependencyError(w http.ResponseWriter, handler string, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidID):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain_entities.ErrDependencyCycle):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, repo.ErrNotFound):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	default:
+		log.Printf("%s internal error: %v", handler, err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+	}
+}
@@ -0,0 +1,35 @@
+package controllers
+
+import (
+	"clean-architecture-golang/domain/value_objects"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+)
+
+// ListByFilter handles GET /tasks?filter={expression}.
+// Syntax errors are reported with the 1-based character position of the offending input.
+func (c *TaskController) ListByFilter(w http.ResponseWriter, r *http.Request) {
+	responses, err := c.FilterTasksUC.Execute(r.URL.Query().Get("filter"))
+	if err != nil {
+		var syntaxErr *value_objects.FilterSyntaxError
+		switch {
+		case errors.As(err, &syntaxErr):
+			writeFilterSyntaxError(w, syntaxErr)
+		default:
+			log.Printf("ListByFilter internal error: %v", err)
+			writeJSONError(w, http.StatusInternalServerError, "internal error")
+		}
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+// writeFilterSyntaxError reports a rejected filter expression together with the position of the error.
+func writeFilterSyntaxError(w http.ResponseWriter, err *value_objects.FilterSyntaxError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]interface{}{"error": err.Error(), "position": err.Pos})
+}
@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	presentation_dto "clean-architecture-golang/presentation/dto"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// Get handles GET /tasks/{id}; with as_of, the task is read as it was at that time (see GetAsOf).
+func (c *TaskController) Get(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Has("as_of") {
+		c.GetAsOf(w, r)
+		return
+	}
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	response, err := c.GetTaskUC.Execute(id)
+	if err != nil {
+		writeHierarchyError(w, "Get", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// Children handles GET /tasks/{id}/children.
+func (c *TaskController) Children(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/children")
+	responses, err := c.GetChildrenUC.Execute(id)
+	if err != nil {
+		writeHierarchyError(w, "Children", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+// SetParent handles PUT /tasks/{id}/parent.
+func (c *TaskController) SetParent(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/parent")
+	var httpReq presentation_dto.HttpSetParentRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	token, err := c.SetParentUC.ExecuteUndoable(id, httpReq.ParentID)
+	if err != nil {
+		writeHierarchyError(w, "SetParent", err)
+		return
+	}
+	writeUndoToken(w, token)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeHierarchyError(w http.ResponseWriter, handler string, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidID):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain_entities.ErrParentCycle), errors.Is(err, domain_entities.ErrParentDone),
+		errors.Is(err, domain_entities.ErrSubtaskProject):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, repo.ErrNotFound):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	default:
+		log.Printf("%s internal error: %v", handler, err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+	}
+}
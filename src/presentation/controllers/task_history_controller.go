@@ -0,0 +1,32 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// History handles GET /tasks/{id}/history.
+func (c *TaskController) History(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/history")
+	responses, err := c.GetHistoryUC.Execute(id)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidID):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, repo.ErrNotFound):
+			writeJSONError(w, http.StatusNotFound, err.Error())
+		default:
+			log.Printf("History internal error: %v", err)
+			writeJSONError(w, http.StatusInternalServerError, "internal error")
+		}
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	"clean-architecture-golang/domain/value_objects"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	presentation_dto "clean-architecture-golang/presentation/dto"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// SetPriority handles PUT /tasks/{id}/priority.
+func (c *TaskController) SetPriority(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/priority")
+	var httpReq presentation_dto.HttpSetPriorityRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	token, err := c.SetPriorityUC.ExecuteUndoable(id, httpReq.Priority)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidID), errors.Is(err, value_objects.ErrInvalidPriority):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, repo.ErrNotFound):
+			writeJSONError(w, http.StatusNotFound, err.Error())
+		default:
+			log.Printf("SetPriority internal error: %v", err)
+			writeJSONError(w, http.StatusInternalServerError, "internal error")
+		}
+		return
+	}
+	writeUndoToken(w, token)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	presentation_dto "clean-architecture-golang/presentation/dto"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// SetProject handles PUT /tasks/{id}/project.
+func (c *TaskController) SetProject(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/project")
+	var httpReq presentation_dto.HttpSetProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	token, err := c.MoveToProjectUC.ExecuteUndoable(id, httpReq.ProjectID)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidID), errors.Is(err, domain_entities.ErrInvalidInput):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, repo.ErrNotFound), errors.Is(err, repo.ErrProjectNotFound):
+			writeJSONError(w, http.StatusNotFound, err.Error())
+		default:
+			log.Printf("SetProject internal error: %v", err)
+			writeJSONError(w, http.StatusInternalServerError, "internal error")
+		}
+		return
+	}
+	writeUndoToken(w, token)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	presentation_dto "clean-architecture-golang/presentation/dto"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// SetRecurrence handles PUT /tasks/{id}/recurrence.
+func (c *TaskController) SetRecurrence(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/recurrence")
+	var httpReq presentation_dto.HttpSetRecurrenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	appReq := dto.SetRecurrenceRequest{
+		DueDate:    httpReq.DueDate,
+		Timezone:   httpReq.Timezone,
+		Recurrence: httpReq.Recurrence,
+	}
+	token, err := c.SetRecurrenceUC.ExecuteUndoable(id, appReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidID), errors.Is(err, usecases.ErrInvalidDueDate):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, value_objects.ErrInvalidRecurrence), errors.Is(err, domain_entities.ErrRecurrenceWithoutDueDate):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, repo.ErrNotFound):
+			writeJSONError(w, http.StatusNotFound, err.Error())
+		default:
+			log.Printf("SetRecurrence internal error: %v", err)
+			writeJSONError(w, http.StatusInternalServerError, "internal error")
+		}
+		return
+	}
+	writeUndoToken(w, token)
+	w.WriteHeader(http.StatusNoContent)
+}
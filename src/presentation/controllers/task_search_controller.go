@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+)
+
+// Search handles GET /tasks/search?q={query}&limit={n}.
+func (c *TaskController) Search(w http.ResponseWriter, r *http.Request) {
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 {
+			writeJSONError(w, http.StatusBadRequest, "limit must be a positive number")
+			return
+		}
+		limit = n
+	}
+	responses, err := c.SearchTasksUC.Execute(r.URL.Query().Get("q"), limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrEmptySearchQuery):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		default:
+			log.Printf("Search internal error: %v", err)
+			writeJSONError(w, http.StatusInternalServerError, "internal error")
+		}
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
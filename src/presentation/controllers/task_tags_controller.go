@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	presentation_dto "clean-architecture-golang/presentation/dto"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// AddTags handles POST /tasks/{id}/tags.
+func (c *TaskController) AddTags(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/tags")
+	var httpReq presentation_dto.HttpAddTagsRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, token, err := c.AddTagsUC.ExecuteUndoable(id, httpReq.Tags)
+	if err != nil {
+		writeTagError(w, "AddTags", err)
+		return
+	}
+	writeUndoToken(w, token)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// RemoveTag handles DELETE /tasks/{id}/tags/{tag}.
+func (c *TaskController) RemoveTag(w http.ResponseWriter, r *http.Request) {
+	rest := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id, tag, found := strings.Cut(rest, "/tags/")
+	if !found || tag == "" {
+		writeJSONError(w, http.StatusBadRequest, "invalid tag")
+		return
+	}
+	token, err := c.RemoveTagUC.ExecuteUndoable(id, tag)
+	if err != nil {
+		writeTagError(w, "RemoveTag", err)
+		return
+	}
+	writeUndoToken(w, token)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListByTags handles GET /tasks?tag=a&tag=b&match=all|any[&status=...].
+// Multiple tags are combined with AND semantics unless match=any is given.
+func (c *TaskController) ListByTags(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var matchAll bool
+	switch query.Get("match") {
+	case "", "all":
+		matchAll = true
+	case "any":
+		matchAll = false
+	default:
+		writeJSONError(w, http.StatusBadRequest, "match must be 'all' or 'any'")
+		return
+	}
+	responses, err := c.GetTasksByTagsUC.Execute(query["tag"], matchAll, query.Get("status"))
+	if err != nil {
+		writeTagError(w, "ListByTags", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+// TagStats handles GET /tags.
+func (c *TaskController) TagStats(w http.ResponseWriter, r *http.Request) {
+	responses, err := c.GetTagStatsUC.Execute()
+	if err != nil {
+		log.Printf("TagStats internal error: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+func writeTagError(w http.ResponseWriter, handler string, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidID):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, value_objects.ErrInvalidTag), errors.Is(err, domain_entities.ErrTooManyTags),
+		errors.Is(err, domain_entities.ErrInvalidStatus):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, repo.ErrNotFound):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	default:
+		log.Printf("%s internal error: %v", handler, err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+	}
+}
@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	presentation_dto "clean-architecture-golang/presentation/dto"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// StartTimer handles POST /tasks/{id}/timer/start for the caller identified by the X-User-ID header.
+func (c *TaskController) StartTimer(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/timer/start")
+	response, err := c.StartTimerUC.Execute(id, currentUser(r))
+	if err != nil {
+		writeTimeError(w, "StartTimer", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// StopTimer handles POST /tasks/{id}/timer/stop for the caller identified by the X-User-ID header.
+func (c *TaskController) StopTimer(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/timer/stop")
+	response, err := c.StopTimerUC.Execute(id, currentUser(r))
+	if err != nil {
+		writeTimeError(w, "StopTimer", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// LogWork handles POST /tasks/{id}/worklog.
+func (c *TaskController) LogWork(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/worklog")
+	var httpReq presentation_dto.HttpLogWorkRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, err := c.LogWorkUC.Execute(dto.LogWorkRequest{
+		TaskID: id,
+		User:   currentUser(r),
+		Start:  httpReq.Start,
+		End:    httpReq.End,
+		Note:   httpReq.Note,
+	})
+	if err != nil {
+		writeTimeError(w, "LogWork", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// ListWorkLog handles GET /tasks/{id}/worklog.
+func (c *TaskController) ListWorkLog(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/worklog")
+	responses, err := c.ListWorkLogUC.Execute(id)
+	if err != nil {
+		writeTimeError(w, "ListWorkLog", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+// TimeReport handles GET /reports/time?user=&from=YYYY-MM-DD&to=YYYY-MM-DD&tz=.
+func (c *TaskController) TimeReport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	response, err := c.TimeReportUC.Execute(dto.TimeReportRequest{
+		User:     query.Get("user"),
+		From:     query.Get("from"),
+		To:       query.Get("to"),
+		Timezone: query.Get("tz"),
+	})
+	if err != nil {
+		writeTimeError(w, "TimeReport", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+func writeTimeError(w http.ResponseWriter, handler string, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidID), errors.Is(err, usecases.ErrInvalidWorkTime),
+		errors.Is(err, usecases.ErrInvalidReportRange), errors.Is(err, domain_entities.ErrInvalidWorkInterval):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain_entities.ErrMissingUser):
+		writeJSONError(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, domain_entities.ErrTimerRunning), errors.Is(err, domain_entities.ErrTimerNotRunning),
+		errors.Is(err, domain_entities.ErrOverlappingWork):
+		writeJSONError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, repo.ErrNotFound):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	default:
+		log.Printf("%s internal error: %v", handler, err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+	}
+}
@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// ListTrash handles GET /trash.
+func (c *TaskController) ListTrash(w http.ResponseWriter, r *http.Request) {
+	responses, err := c.ListTrashUC.Execute()
+	if err != nil {
+		log.Printf("ListTrash internal error: %v", err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+// Restore handles POST /tasks/{id}/restore.
+func (c *TaskController) Restore(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/tasks/")
+	id = strings.TrimSuffix(id, "/restore")
+	response, err := c.RestoreTaskUC.Execute(id)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidID):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, repo.ErrNotFound):
+			writeJSONError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, domain_entities.ErrWIPLimitReached):
+			writeJSONError(w, http.StatusConflict, err.Error())
+		default:
+			log.Printf("Restore internal error: %v", err)
+			writeJSONError(w, http.StatusInternalServerError, "internal error")
+		}
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,46 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// UndoController handles reverting operations by their undo token.
+type UndoController struct {
+	UndoUC *usecases.UndoUseCase
+}
+
+// Undo handles POST /undo/{token}. The response carries a token for redoing the operation.
+// An undo that would break a status rule, a WIP limit or put a task back into a deleted project is
+// refused with 409.
+func (c *UndoController) Undo(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.URL.Path, "/undo/")
+	redo, err := c.UndoUC.Execute(token)
+	if err != nil {
+		switch {
+		case errors.Is(err, repo.ErrUndoNotFound):
+			writeJSONError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, repo.ErrUndoConflict), errors.Is(err, repo.ErrProjectNotFound),
+			errors.Is(err, domain_entities.ErrWIPLimitReached), errors.Is(err, domain_entities.ErrInvalidInput):
+			writeJSONError(w, http.StatusConflict, err.Error())
+		default:
+			log.Printf("Undo internal error: %v", err)
+			writeJSONError(w, http.StatusInternalServerError, "internal error")
+		}
+		return
+	}
+	writeUndoToken(w, redo)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeUndoToken sets the Undo-Token header of a response when an operation can be undone.
+func writeUndoToken(w http.ResponseWriter, token string) {
+	if token != "" {
+		w.Header().Set("Undo-Token", token)
+	}
+}
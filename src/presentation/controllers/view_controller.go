@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"clean-architecture-golang/application/dto"
+	"clean-architecture-golang/application/usecases"
+	domain_entities "clean-architecture-golang/domain/entities"
+	"clean-architecture-golang/domain/value_objects"
+	repo "clean-architecture-golang/infrastructure/repositories"
+	presentation_dto "clean-architecture-golang/presentation/dto"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// ViewController handles the saved views of the calling user under /views.
+type ViewController struct {
+	SaveViewUC   *usecases.SaveViewUseCase
+	ListViewsUC  *usecases.ListViewsUseCase
+	GetViewUC    *usecases.GetViewUseCase
+	DeleteViewUC *usecases.DeleteViewUseCase
+	RunViewUC    *usecases.RunViewUseCase
+}
+
+// viewName extracts {name} from "/views/{name}[/tasks]".
+func viewName(r *http.Request) string {
+	name := strings.TrimPrefix(r.URL.Path, "/views/")
+	return strings.TrimSuffix(name, "/tasks")
+}
+
+// Save handles PUT /views/{name}, creating the view (201) or replacing its filter and sort (200).
+func (c *ViewController) Save(w http.ResponseWriter, r *http.Request) {
+	var httpReq presentation_dto.HttpSavedViewRequest
+	if err := json.NewDecoder(r.Body).Decode(&httpReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, created, err := c.SaveViewUC.Execute(dto.SavedViewRequest{
+		Owner:  currentUser(r),
+		Name:   viewName(r),
+		Filter: httpReq.Filter,
+		Sort:   httpReq.Sort,
+	})
+	if err != nil {
+		writeViewError(w, "SaveView", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if created {
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+// List handles GET /views.
+func (c *ViewController) List(w http.ResponseWriter, r *http.Request) {
+	responses, err := c.ListViewsUC.Execute(currentUser(r))
+	if err != nil {
+		writeViewError(w, "ListViews", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+// Get handles GET /views/{name}.
+func (c *ViewController) Get(w http.ResponseWriter, r *http.Request) {
+	response, err := c.GetViewUC.Execute(currentUser(r), viewName(r))
+	if err != nil {
+		writeViewError(w, "GetView", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// Delete handles DELETE /views/{name}.
+func (c *ViewController) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := c.DeleteViewUC.Execute(currentUser(r), viewName(r)); err != nil {
+		writeViewError(w, "DeleteView", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Tasks handles GET /views/{name}/tasks.
+func (c *ViewController) Tasks(w http.ResponseWriter, r *http.Request) {
+	responses, err := c.RunViewUC.Execute(currentUser(r), viewName(r))
+	if err != nil {
+		writeViewError(w, "RunView", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+func writeViewError(w http.ResponseWriter, handler string, err error) {
+	var syntaxErr *value_objects.FilterSyntaxError
+	switch {
+	case errors.As(err, &syntaxErr):
+		writeFilterSyntaxError(w, syntaxErr)
+	case errors.Is(err, domain_entities.ErrMissingViewOwner):
+		writeJSONError(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, domain_entities.ErrInvalidInput):
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, repo.ErrViewNotFound):
+		writeJSONError(w, http.StatusNotFound, err.Error())
+	default:
+		log.Printf("%s internal error: %v", handler, err)
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+	}
+}
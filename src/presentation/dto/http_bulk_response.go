@@ -0,0 +1,17 @@
+package dto
+
+// HttpBulkItemResult is the outcome of one item of a bulk request. Status is the HTTP status the
+// item would have received as a request of its own.
+type HttpBulkItemResult struct {
+	Index  int    `json:"index"`
+	ID     string `json:"id,omitempty"`
+	Status int    `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// HttpBulkResponse represents the JSON response of a bulk request, with one result per item in request order.
+type HttpBulkResponse struct {
+	Succeeded int                  `json:"succeeded"`
+	Failed    int                  `json:"failed"`
+	Results   []HttpBulkItemResult `json:"results"`
+}
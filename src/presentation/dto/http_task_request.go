@@ -6,9 +6,135 @@ package dto
 type HttpCreateTaskRequest struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
+	ProjectID   string `json:"projectId,omitempty"`
+	ParentID    string `json:"parentId,omitempty"`
+	DueDate     string `json:"dueDate,omitempty"`
+	Timezone    string `json:"timezone,omitempty"`
+	Recurrence  string `json:"recurrence,omitempty"`
+	Priority    string `json:"priority,omitempty"`
 }
 
 // HttpUpdateStatusRequest represents the JSON payload for updating task status via HTTP.
+// StartTimer starts a timer for the caller when the task moves to doing.
 type HttpUpdateStatusRequest struct {
-	NewStatus string `json:"newStatus"`
+	NewStatus  string `json:"newStatus"`
+	StartTimer bool   `json:"startTimer,omitempty"`
+}
+
+// HttpAddTagsRequest represents the JSON payload for attaching tags to a task via HTTP.
+type HttpAddTagsRequest struct {
+	Tags []string `json:"tags"`
+}
+
+// HttpSetParentRequest represents the JSON payload for moving a task below another task via HTTP.
+// An empty ParentID moves the task back to the top level.
+type HttpSetParentRequest struct {
+	ParentID string `json:"parentId"`
+}
+
+// HttpAddBlockerRequest represents the JSON payload for declaring a task dependency via HTTP.
+type HttpAddBlockerRequest struct {
+	BlockerID string `json:"blockerId"`
+}
+
+// HttpSetRecurrenceRequest represents the JSON payload for changing the schedule of a task via HTTP.
+type HttpSetRecurrenceRequest struct {
+	DueDate    string `json:"dueDate"`
+	Timezone   string `json:"timezone"`
+	Recurrence string `json:"recurrence"`
+}
+
+// HttpSetPriorityRequest represents the JSON payload for changing the priority of a task via HTTP.
+type HttpSetPriorityRequest struct {
+	Priority string `json:"priority"`
+}
+
+// HttpCommentRequest represents the JSON payload for adding or editing a comment via HTTP.
+type HttpCommentRequest struct {
+	Body string `json:"body"`
+}
+
+// HttpLogWorkRequest represents the JSON payload for logging work without a timer via HTTP.
+type HttpLogWorkRequest struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+	Note  string `json:"note,omitempty"`
+}
+
+// HttpMoveTaskRequest represents the JSON payload for moving a task on the board via HTTP.
+// The task goes between AfterID and BeforeID in the Status column; empty fields keep the
+// current status or place the task at the bottom of the column.
+type HttpMoveTaskRequest struct {
+	Status     string `json:"status,omitempty"`
+	AfterID    string `json:"afterId,omitempty"`
+	BeforeID   string `json:"beforeId,omitempty"`
+	StartTimer bool   `json:"startTimer,omitempty"`
+}
+
+// HttpProjectRequest represents the JSON payload for creating or updating a project via HTTP.
+type HttpProjectRequest struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Settings    HttpProjectSettings `json:"settings"`
+}
+
+// HttpProjectSettings represents the default workflow settings of a project via HTTP.
+type HttpProjectSettings struct {
+	DefaultTags []string `json:"defaultTags,omitempty"`
+	StartTimers bool     `json:"startTimers,omitempty"`
+}
+
+// HttpSetProjectRequest represents the JSON payload for moving a task to another project via HTTP.
+// An empty ProjectID takes the task out of its project.
+type HttpSetProjectRequest struct {
+	ProjectID string `json:"projectId"`
+}
+
+// HttpChecklistItemRequest represents the JSON payload for adding a checklist item via HTTP.
+type HttpChecklistItemRequest struct {
+	Text string `json:"text"`
+}
+
+// HttpCheckChecklistItemRequest represents the JSON payload for checking or unchecking a checklist item via HTTP.
+type HttpCheckChecklistItemRequest struct {
+	Checked bool `json:"checked"`
+}
+
+// HttpMoveChecklistItemRequest represents the JSON payload for reordering a checklist item via HTTP.
+// Position is zero-based.
+type HttpMoveChecklistItemRequest struct {
+	Position int `json:"position"`
+}
+
+// HttpSavedViewRequest represents the JSON payload for saving a view via HTTP.
+// Filter is a filter expression and Sort a sort such as "-created".
+type HttpSavedViewRequest struct {
+	Filter string `json:"filter"`
+	Sort   string `json:"sort,omitempty"`
+}
+
+// HttpBulkCreateRequest represents the JSON payload for creating several tasks via HTTP.
+// Mode is "atomic" (the default) or "best-effort".
+type HttpBulkCreateRequest struct {
+	Mode  string                  `json:"mode,omitempty"`
+	Tasks []HttpCreateTaskRequest `json:"tasks"`
+}
+
+// HttpBulkStatusUpdate is one status change of an HttpBulkUpdateStatusRequest.
+type HttpBulkStatusUpdate struct {
+	ID         string `json:"id"`
+	NewStatus  string `json:"newStatus"`
+	StartTimer bool   `json:"startTimer,omitempty"`
+}
+
+// HttpBulkUpdateStatusRequest represents the JSON payload for changing the status of several tasks via HTTP.
+type HttpBulkUpdateStatusRequest struct {
+	Mode    string                 `json:"mode,omitempty"`
+	Updates []HttpBulkStatusUpdate `json:"updates"`
+}
+
+// HttpBulkDeleteRequest represents the JSON payload for deleting several tasks via HTTP.
+type HttpBulkDeleteRequest struct {
+	Mode string   `json:"mode,omitempty"`
+	IDs  []string `json:"ids"`
 }